@@ -80,41 +80,41 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		}()
 
 		switch typeName {
-		case "Vocabulary":
-			resolverName, err := entityResolverNameForVocabulary(ctx, rep)
+		case "Term":
+			resolverName, err := entityResolverNameForTerm(ctx, rep)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "Vocabulary": %w`, err)
+				return fmt.Errorf(`finding resolver for Entity "Term": %w`, err)
 			}
 			switch resolverName {
 
-			case "findVocabularyByID":
-				id0, err := ec.unmarshalNID2int64(ctx, rep["id"])
+			case "findTermByID":
+				id0, err := ec.unmarshalNID2uint64(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findVocabularyByID(): %w`, err)
+					return fmt.Errorf(`unmarshalling param 0 for findTermByID(): %w`, err)
 				}
-				entity, err := ec.resolvers.Entity().FindVocabularyByID(ctx, id0)
+				entity, err := ec.resolvers.Entity().FindTermByID(ctx, id0)
 				if err != nil {
-					return fmt.Errorf(`resolving Entity "Vocabulary": %w`, err)
+					return fmt.Errorf(`resolving Entity "Term": %w`, err)
 				}
 
 				list[idx[i]] = entity
 				return nil
 			}
-		case "Term":
-			resolverName, err := entityResolverNameForTerm(ctx, rep)
+		case "Vocabulary":
+			resolverName, err := entityResolverNameForVocabulary(ctx, rep)
 			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "Term": %w`, err)
+				return fmt.Errorf(`finding resolver for Entity "Vocabulary": %w`, err)
 			}
 			switch resolverName {
 
-			case "findTermByID":
-				id0, err := ec.unmarshalNID2int64(ctx, rep["id"])
+			case "findVocabularyByID":
+				id0, err := ec.unmarshalNID2uint64(ctx, rep["id"])
 				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findTermByID(): %w`, err)
+					return fmt.Errorf(`unmarshalling param 0 for findVocabularyByID(): %w`, err)
 				}
-				entity, err := ec.resolvers.Entity().FindTermByID(ctx, id0)
+				entity, err := ec.resolvers.Entity().FindVocabularyByID(ctx, id0)
 				if err != nil {
-					return fmt.Errorf(`resolving Entity "Term": %w`, err)
+					return fmt.Errorf(`resolving Entity "Vocabulary": %w`, err)
 				}
 
 				list[idx[i]] = entity
@@ -189,7 +189,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 	}
 }
 
-func entityResolverNameForVocabulary(ctx context.Context, rep map[string]interface{}) (string, error) {
+func entityResolverNameForTerm(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
 			m   map[string]interface{}
@@ -201,12 +201,12 @@ func entityResolverNameForVocabulary(ctx context.Context, rep map[string]interfa
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findVocabularyByID", nil
+		return "findTermByID", nil
 	}
-	return "", fmt.Errorf("%w for Vocabulary", ErrTypeNotFound)
+	return "", fmt.Errorf("%w for Term", ErrTypeNotFound)
 }
 
-func entityResolverNameForTerm(ctx context.Context, rep map[string]interface{}) (string, error) {
+func entityResolverNameForVocabulary(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
 			m   map[string]interface{}
@@ -218,7 +218,7 @@ func entityResolverNameForTerm(ctx context.Context, rep map[string]interface{})
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findTermByID", nil
+		return "findVocabularyByID", nil
 	}
-	return "", fmt.Errorf("%w for Term", ErrTypeNotFound)
+	return "", fmt.Errorf("%w for Vocabulary", ErrTypeNotFound)
 }
@@ -3,39 +3,107 @@
 package genmodel
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"github.com/dmalykh/taxonomy/api/graphql/model"
 )
 
-type VocabularyInput struct {
-	// Vocabulary's name
-	Name string `json:"name"`
-	// Vocabulary's title
-	Title string `json:"title"`
-	// Parent vocabulary
-	ParentID *int64 `json:"parentId"`
-	// Vocabulary's description
-	Description *string `json:"description"`
-}
-
 type EntitiesConnection struct {
 	Edges    []EntitiesEdge `json:"edges"`
 	PageInfo PageInfo       `json:"pageInfo"`
 }
 
 type EntitiesEdge struct {
-	Cursor string      `json:"cursor"`
-	Node   *EntityNode `json:"node"`
+	Cursor string            `json:"cursor"`
+	Node   *model.EntityNode `json:"node,omitempty"`
 }
 
-type EntityNode struct {
-	ID        int64  `json:"id"`
-	Namespace string `json:"namespace"`
+// Counts of entities matching the filter grouped by terms and vocabularies they are related with
+type Facets struct {
+	Terms        []model.TermFacet       `json:"terms"`
+	Vocabularies []model.VocabularyFacet `json:"vocabularies"`
+}
+
+// Translation of title and description
+type Label struct {
+	// Locale like de or pt-BR
+	Locale      string  `json:"locale"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Translation of title and description, labels are merged with existing ones by locales on update.
+// Label without title and description removes translation to the locale
+type LabelInput struct {
+	// Locale like de or pt-BR
+	Locale      string  `json:"locale"`
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type Namespace struct {
+	ID uint64 `json:"id"`
+	// Namespace's name
+	Name string `json:"name"`
+}
+
+type NamespacesConnection struct {
+	Edges    []NamespacesEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+type NamespacesEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *Namespace `json:"node,omitempty"`
 }
 
 type PageInfo struct {
 	StartCursor string `json:"startCursor"`
 	EndCursor   string `json:"endCursor"`
-	HasNextPage *bool  `json:"hasNextPage"`
+	HasNextPage *bool  `json:"hasNextPage,omitempty"`
+}
+
+// Ids of terms related with and unrelated from the entity by replacing its terms
+type ReferenceChanges struct {
+	Added   []uint64 `json:"added"`
+	Removed []uint64 `json:"removed"`
+}
+
+// Alternative name of a term, names and synonyms of terms are unique in every vocabulary
+type Synonym struct {
+	Name string `json:"name"`
+	// Locale like de or pt-BR, synonym of any locale has no locale
+	Locale *string `json:"locale,omitempty"`
+	// Hidden synonyms are used for lookups only, i.e. misspellings
+	Hidden bool `json:"hidden"`
+}
+
+type SynonymInput struct {
+	Name string `json:"name"`
+	// Locale like de or pt-BR, synonym of any locale has no locale
+	Locale *string `json:"locale,omitempty"`
+	// Hidden synonyms are used for lookups only, i.e. misspellings
+	Hidden bool `json:"hidden"`
+}
+
+type TermFilter struct {
+	VocabularyID []uint64 `json:"vocabularyId,omitempty"`
+	Name         *string  `json:"name,omitempty"`
+	// Name matches synonyms too
+	WithSynonyms bool `json:"withSynonyms"`
+	// Terms narrower than any of given terms
+	SuperID []uint64 `json:"superId,omitempty"`
+	// Terms broader than any of given terms
+	SubID []uint64 `json:"subId,omitempty"`
+	// Terms related with entities of the namespace
+	Namespace *string `json:"namespace,omitempty"`
+	// Terms related with the entities of the namespace
+	EntityID []string `json:"entityId,omitempty"`
+	// Entities having any term of excludeTermId groups are skipped, so only terms of other entities of the namespace
+	// are returned. Namespace is required
+	ExcludeTermID [][]uint64 `json:"excludeTermId,omitempty"`
 }
 
 type TermInput struct {
@@ -43,10 +111,34 @@ type TermInput struct {
 	Name string `json:"name"`
 	// Term's title
 	Title string `json:"title"`
-	// Term's vocabulary
-	VocabularyID int64 `json:"vocabularyId"`
+	// Term's vocabularies
+	VocabularyID []uint64 `json:"vocabularyId"`
 	// Description
-	Description *string `json:"description"`
+	Description *string `json:"description,omitempty"`
+	// Translations of title and description
+	Labels []LabelInput `json:"labels,omitempty"`
+	// Alternative names, replace existing synonyms on update when given
+	Synonyms []SynonymInput `json:"synonyms,omitempty"`
+	// Broader terms, keeps existing links on update when omitted
+	SuperID []uint64 `json:"superId,omitempty"`
+	// Narrower terms, keeps existing links on update when omitted
+	SubID []uint64 `json:"subId,omitempty"`
+}
+
+type TermMatch struct {
+	Term model.Term `json:"term"`
+	// Relevance from 0 to 1
+	Score float64     `json:"score"`
+	Field SearchField `json:"field"`
+}
+
+type TermSuggestion struct {
+	Term model.Term `json:"term"`
+	// Name, synonym or title completing the prefix
+	Text  string      `json:"text"`
+	Field SearchField `json:"field"`
+	// Count of entities of the namespace related with the term
+	Popularity int64 `json:"popularity"`
 }
 
 type TermsConnection struct {
@@ -56,5 +148,136 @@ type TermsConnection struct {
 
 type TermsEdge struct {
 	Cursor string      `json:"cursor"`
-	Node   *model.Term `json:"node"`
+	Node   *model.Term `json:"node,omitempty"`
+}
+
+// Mention of the term in the text, start and end count characters of the text, end is exclusive
+type TextTag struct {
+	Term model.Term `json:"term"`
+	// The mention as it's written in the text
+	Text  string `json:"text"`
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+	// NAME or SYNONYM
+	Field SearchField `json:"field"`
+}
+
+type VocabularyConnection struct {
+	Edges    []VocabularyEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+type VocabularyEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *model.Vocabulary `json:"node,omitempty"`
+}
+
+type VocabularyFilter struct {
+	ParentID *uint64 `json:"parentId,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+type VocabularyInput struct {
+	// Vocabulary's name
+	Name string `json:"name"`
+	// Vocabulary's title
+	Title string `json:"title"`
+	// Parent vocabulary
+	ParentID *uint64 `json:"parentId,omitempty"`
+	// Vocabulary's description
+	Description *string `json:"description,omitempty"`
+	// Translations of title and description
+	Labels []LabelInput `json:"labels,omitempty"`
+}
+
+// Field of the term matching the query
+type SearchField string
+
+const (
+	SearchFieldName        SearchField = "NAME"
+	SearchFieldSynonym     SearchField = "SYNONYM"
+	SearchFieldTitle       SearchField = "TITLE"
+	SearchFieldDescription SearchField = "DESCRIPTION"
+)
+
+var AllSearchField = []SearchField{
+	SearchFieldName,
+	SearchFieldSynonym,
+	SearchFieldTitle,
+	SearchFieldDescription,
+}
+
+func (e SearchField) IsValid() bool {
+	switch e {
+	case SearchFieldName, SearchFieldSynonym, SearchFieldTitle, SearchFieldDescription:
+		return true
+	}
+	return false
+}
+
+func (e SearchField) String() string {
+	return string(e)
+}
+
+func (e *SearchField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchField", str)
+	}
+	return nil
+}
+
+func (e SearchField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchMode string
+
+const (
+	// Words of the query are beginnings of words of the term
+	SearchModePrefix SearchMode = "PREFIX"
+	// The query is a part of the term
+	SearchModeSubstring SearchMode = "SUBSTRING"
+	// Like PREFIX, but words of 4 and more letters may have a typo after their first two letters, words of 8 and more letters may have two typos
+	SearchModeFuzzy SearchMode = "FUZZY"
+)
+
+var AllSearchMode = []SearchMode{
+	SearchModePrefix,
+	SearchModeSubstring,
+	SearchModeFuzzy,
+}
+
+func (e SearchMode) IsValid() bool {
+	switch e {
+	case SearchModePrefix, SearchModeSubstring, SearchModeFuzzy:
+		return true
+	}
+	return false
+}
+
+func (e SearchMode) String() string {
+	return string(e)
+}
+
+func (e *SearchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchMode", str)
+	}
+	return nil
+}
+
+func (e SearchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
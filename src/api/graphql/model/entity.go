@@ -0,0 +1,11 @@
+package model
+
+type EntityNode struct {
+	ID        string `json:"id"`
+	Namespace string `json:"namespace"`
+}
+
+type VocabularyTerms struct {
+	VocabularyID uint64 `json:"vocabularyId"`
+	Terms        []Term `json:"terms"`
+}
@@ -0,0 +1,13 @@
+package model
+
+type TermFacet struct {
+	TermID uint64 `json:"termId"`
+	// Count of matched entities related with the term
+	Count int64 `json:"count"`
+}
+
+type VocabularyFacet struct {
+	VocabularyID uint64 `json:"vocabularyId"`
+	// Count of matched entities related with any term of the vocabulary
+	Count int64 `json:"count"`
+}
@@ -1,15 +1,21 @@
 package model
 
+import "github.com/dmalykh/taxonomy/taxonomy/model"
+
 type Term struct {
 	ID uint64 `json:"id"`
 	// Term's name
 	Name string `json:"name"`
 	// Term's title
 	Title *string `json:"title"`
-	// Term's vocabulary
-	VocabularyID uint64 `json:"vocabulary"`
+	// Term's vocabularies
+	VocabularyID []uint64 `json:"vocabularies"`
 	// Description
 	Description *string `json:"description"`
+	// Translations of title and description
+	Labels model.Labels `json:"-"`
+	// Alternative names
+	Synonyms []model.Synonym `json:"-"`
 }
 
 func (t Term) IsEntity() {}
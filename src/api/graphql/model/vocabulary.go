@@ -1,5 +1,7 @@
 package model
 
+import "github.com/dmalykh/taxonomy/taxonomy/model"
+
 type Vocabulary struct {
 	ID uint64 `json:"id"`
 	// Vocabulary's name
@@ -10,6 +12,8 @@ type Vocabulary struct {
 	ParentID *uint64
 	// Vocabulary's description
 	Description *string `json:"description"`
+	// Translations of title and description
+	Labels model.Labels `json:"-"`
 }
 
 func (Vocabulary) IsEntity() {}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"log"
 	"net/http"
 
@@ -19,14 +20,19 @@ type Config struct {
 	TermService       taxonomy.Term
 	VocabularyService taxonomy.Vocabulary
 	NamespaceService  taxonomy.Namespace
-	Verbose           bool
+	ReferenceService  taxonomy.Reference
+	SuggestService    taxonomy.Suggester
+	// Fallback contains locales tried when titles and descriptions aren't translated to requested ones
+	Fallback *model.Fallback
+	Verbose  bool
 }
 
 func Serve(config *Config) error {
 	srv := handler.NewDefaultServer(
 		generated.NewExecutableSchema(
 			generated.Config{
-				Resolvers: service.NewResolver(config.TermService, config.VocabularyService, config.NamespaceService),
+				Resolvers: service.NewResolver(config.TermService, config.VocabularyService, config.NamespaceService,
+					config.ReferenceService, config.SuggestService),
 			},
 		),
 	)
@@ -41,7 +47,7 @@ func Serve(config *Config) error {
 	}
 
 	http.Handle("/", playground.Handler("Taxonomy GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	http.Handle("/query", service.Localize(config.Fallback, srv))
 
 	log.Printf("connect to :%s for GraphQL playground", config.Port)
 
@@ -1,17 +1,12 @@
-//nolint:nilnil
 package service
 
 import (
 	"context"
-	"fmt"
-	"github.com/dmalykh/taxonomy/taxonomy"
-	model2 "github.com/dmalykh/taxonomy/taxonomy/model"
-	"unsafe"
 
 	"github.com/dmalykh/taxonomy/api/graphql/generated/genmodel"
 	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
-	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
-	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 type Vocabulary struct {
@@ -21,12 +16,12 @@ type Vocabulary struct {
 
 func (c *Vocabulary) Parent(ctx context.Context, obj *apimodel.Vocabulary) (*apimodel.Vocabulary, error) {
 	if obj.ParentID == nil {
-		return nil, nil
+		return nil, nil //nolint:nilnil
 	}
 
-	vocabulary, err := c.vocabularyService.GetByID(ctx, *(*uint)(unsafe.Pointer(obj.ParentID)))
+	vocabulary, err := c.vocabularyService.GetByID(ctx, *obj.ParentID)
 	if err != nil {
-		return nil, fmt.Errorf(`error %w to get vocabulary %d: %s`, taxonomy.ErrVocabularyNotFound, *obj.ParentID, err.Error())
+		return nil, toError(err)
 	}
 
 	gen := vocabulary2gen(vocabulary)
@@ -35,46 +30,80 @@ func (c *Vocabulary) Parent(ctx context.Context, obj *apimodel.Vocabulary) (*api
 }
 
 func (c *Vocabulary) Children(ctx context.Context, obj *apimodel.Vocabulary) ([]*apimodel.Vocabulary, error) {
-	if obj.ParentID == nil {
-		return nil, nil
+	vocabularies, err := c.vocabularyService.Get(ctx, &model.VocabularyFilter{
+		ParentID: &obj.ID,
+	})
+	if err != nil {
+		return nil, toError(err)
 	}
 
-	vocabularys, err := c.vocabularyService.Get(ctx, &model2.VocabularyFilter{
-		ParentID: (*uint)(unsafe.Pointer(&obj.ParentID)),
-	})
+	children := make([]*apimodel.Vocabulary, len(vocabularies))
+
+	for i, vocabulary := range vocabularies {
+		vocabulary := vocabulary2gen(vocabulary)
+		children[i] = &vocabulary
+	}
+
+	return children, nil
+}
+
+func (c *Vocabulary) Ancestors(ctx context.Context, obj *apimodel.Vocabulary) ([]apimodel.Vocabulary, error) {
+	vocabularies, err := c.vocabularyService.Ancestors(ctx, obj.ID)
 	if err != nil {
-		return nil, gqlerror.Errorf(`error to get categories by entities %s`, err.Error())
+		return nil, toError(err)
 	}
 
-	return func(vocabularys []model2.Vocabulary) []*apimodel.Vocabulary {
-		apivocabularys := make([]*apimodel.Vocabulary, len(vocabularys))
+	return convert(vocabularies, vocabulary2gen), nil
+}
 
-		for i, vocabulary := range vocabularys {
-			vocabulary := vocabulary2gen(vocabulary)
-			apivocabularys[i] = &vocabulary
-		}
+func (c *Vocabulary) Descendants(ctx context.Context, obj *apimodel.Vocabulary, d *int64) ([]apimodel.Vocabulary, error) { //nolint:lll
+	vocabularies, err := c.vocabularyService.Descendants(ctx, obj.ID, depth(d))
+	if err != nil {
+		return nil, toError(err)
+	}
 
-		return apivocabularys
-	}(vocabularys), nil
+	return convert(vocabularies, vocabulary2gen), nil
 }
 
-func (c *Vocabulary) Terms(ctx context.Context, obj *apimodel.Vocabulary, first int64, after *string) (*genmodel.TermsConnection, error) {
-	var afterID uint
+func (c *Vocabulary) Path(ctx context.Context, obj *apimodel.Vocabulary) ([]apimodel.Vocabulary, error) {
+	vocabularies, err := c.vocabularyService.Path(ctx, obj.ID)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return convert(vocabularies, vocabulary2gen), nil
+}
 
-	if after != nil {
-		if err := cursor.Unmarshal(*after, &afterID); err != nil {
-			return nil, fmt.Errorf(`error to unmarshal %q: %w`, *after, err)
-		}
+func (c *Vocabulary) Terms(ctx context.Context, obj *apimodel.Vocabulary, first int64, after *string) (*genmodel.TermsConnection, error) { //nolint:lll
+	afterID, err := afterID(after)
+	if err != nil {
+		return nil, toError(err)
 	}
 
-	terms, err := c.termService.Get(ctx, &model2.TermFilter{
-		VocabularyID: []uint{uint(obj.ID)},
-		Limit:        uint(first + 1), // dirty hack to obtain HasNextPage
-		AfterID:      &afterID,
+	terms, err := c.termService.Get(ctx, &model.TermFilter{
+		VocabularyID: []uint64{obj.ID},
+		Limit:        limit(first),
+		AfterID:      afterID,
 	})
 	if err != nil {
-		return nil, fmt.Errorf(`error to get list %w`, err)
+		return nil, toError(err)
 	}
 
-	return termsConnection(terms, int(first)), nil
+	return termsConnection(terms, first), nil
+}
+
+func (c *Vocabulary) Title(ctx context.Context, obj *apimodel.Vocabulary, locale *string) (string, error) {
+	return obj.Labels.Title(chain(ctx, locale), obj.Title), nil
+}
+
+func (c *Vocabulary) Description(ctx context.Context, obj *apimodel.Vocabulary, locale *string) (*string, error) {
+	if description := obj.Labels.Description(chain(ctx, locale), ``); description != `` {
+		return &description, nil
+	}
+
+	return obj.Description, nil
+}
+
+func (c *Vocabulary) Labels(_ context.Context, obj *apimodel.Vocabulary) ([]genmodel.Label, error) {
+	return labels2gen(obj.Labels), nil
 }
@@ -2,10 +2,10 @@ package service
 
 import (
 	"context"
-	"fmt"
-	"github.com/dmalykh/taxonomy/taxonomy"
 
 	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 type Entity struct {
@@ -13,20 +13,57 @@ type Entity struct {
 	vocabularyService taxonomy.Vocabulary
 }
 
-func (e *Entity) FindVocabularyByID(ctx context.Context, id int64) (apimodel.Vocabulary, error) {
-	vocabulary, err := e.vocabularyService.GetByID(ctx, uint(id))
+func (e *Entity) FindVocabularyByID(ctx context.Context, id uint64) (apimodel.Vocabulary, error) {
+	vocabulary, err := e.vocabularyService.GetByID(ctx, id)
 	if err != nil {
-		return apimodel.Vocabulary{}, fmt.Errorf(`error %w to get vocabulary %d: %s`, taxonomy.ErrVocabularyNotFound, id, err.Error())
+		return apimodel.Vocabulary{}, toError(err)
 	}
 
 	return vocabulary2gen(vocabulary), nil
 }
 
-func (e *Entity) FindTermByID(ctx context.Context, id int64) (apimodel.Term, error) {
-	term, err := e.termService.GetByID(ctx, uint(id))
+func (e *Entity) FindTermByID(ctx context.Context, id uint64) (apimodel.Term, error) {
+	term, err := e.termService.GetByID(ctx, id)
 	if err != nil {
-		return apimodel.Term{}, fmt.Errorf(`error %w to get term %d: %s`, taxonomy.ErrTermNotFound, id, err.Error())
+		return apimodel.Term{}, toError(err)
 	}
 
 	return term2gen(term), nil
 }
+
+type EntityNode struct {
+	referenceService taxonomy.Reference
+}
+
+func (e *EntityNode) Terms(ctx context.Context, obj *apimodel.EntityNode) ([]apimodel.VocabularyTerms, error) {
+	entities, err := e.referenceService.GetTerms(ctx, obj.Namespace, model.EntityID(obj.ID))
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	var vocabularies = make([]apimodel.VocabularyTerms, 0)
+
+	for _, entity := range entities {
+		for _, group := range entity.Vocabularies {
+			vocabularies = append(vocabularies, apimodel.VocabularyTerms{
+				VocabularyID: group.VocabularyID,
+				Terms:        convert(group.Terms, term2gen),
+			})
+		}
+	}
+
+	return vocabularies, nil
+}
+
+type VocabularyTerms struct {
+	vocabularyService taxonomy.Vocabulary
+}
+
+func (v *VocabularyTerms) Vocabulary(ctx context.Context, obj *apimodel.VocabularyTerms) (apimodel.Vocabulary, error) {
+	vocabulary, err := v.vocabularyService.GetByID(ctx, obj.VocabularyID)
+	if err != nil {
+		return apimodel.Vocabulary{}, toError(err)
+	}
+
+	return vocabulary2gen(vocabulary), nil
+}
@@ -0,0 +1,98 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Entity(t *testing.T) {
+	mock.SetUp(t)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.GetByName(mock.Any[context.Context](), mock.Exact(`products`))).
+		ThenReturn(&model.Namespace{ID: 1, Data: model.NamespaceData{Name: `products`}}, nil)
+	mock.When(namespaceService.GetByName(mock.Any[context.Context](), mock.Exact(`unknown`))).
+		ThenReturn(nil, fmt.Errorf(`%w unknown`, taxonomy.ErrNamespaceNotFound))
+
+	laptop := &model.Term{ID: 2, Data: model.TermData{Name: `laptop`, VocabularyID: []uint64{7}}}
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.GetTerms(mock.Any[context.Context](), mock.Exact(`products`),
+		mock.Any[[]model.EntityID]()...)).
+		ThenReturn([]*model.EntityTerms{{
+			EntityID:     `macbook`,
+			Vocabularies: []*model.VocabularyTerms{{VocabularyID: 7, Terms: []*model.Term{laptop}}},
+		}}, nil)
+
+	vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+	mock.When(vocabularyService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](7))).
+		ThenReturn(&model.Vocabulary{ID: 7, Data: model.VocabularyData{Name: `kind`}}, nil)
+
+	c := newClient(&services{namespace: namespaceService, vocabulary: vocabularyService, reference: referenceService})
+
+	var resp struct {
+		Entity struct {
+			ID    string
+			Terms []struct {
+				Vocabulary struct {
+					Name string
+				}
+				Terms []struct {
+					Name string
+				}
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ entity(namespace: "products", id: "macbook") {
+		id terms { vocabulary { name } terms { name } }
+	} }`, &resp))
+
+	assert.Equal(t, `macbook`, resp.Entity.ID)
+	require.Len(t, resp.Entity.Terms, 1)
+	assert.Equal(t, `kind`, resp.Entity.Terms[0].Vocabulary.Name)
+	require.Len(t, resp.Entity.Terms[0].Terms, 1)
+	assert.Equal(t, `laptop`, resp.Entity.Terms[0].Terms[0].Name)
+
+	errs := gqlErrors(t, c.Post(`{ entity(namespace: "unknown", id: "macbook") { id } }`, &struct{}{}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, `NAMESPACE_NOT_FOUND`, errs[0].Extensions[`code`])
+}
+
+func TestMutation_ReplaceEntityTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Replace(mock.Any[context.Context](), mock.Exact(`products`),
+		mock.Equal[model.EntityID](`macbook`), mock.Equal([]uint64{2, 3}))).
+		ThenReturn(&model.ReferenceChanges{Added: []uint64{3}, Removed: []uint64{1}}, nil)
+	mock.When(referenceService.ReplaceInVocabulary(mock.Any[context.Context](), mock.Exact(`products`),
+		mock.Equal[model.EntityID](`macbook`), mock.Equal[uint64](7), mock.Any[[]uint64]())).
+		ThenReturn(nil, fmt.Errorf(`%w: term 5, vocabulary 7`, taxonomy.ErrTermNotInVocabulary))
+
+	c := newClient(&services{reference: referenceService})
+
+	var resp struct {
+		ReplaceEntityTerms struct {
+			Added   []uint64
+			Removed []uint64
+		}
+	}
+	require.NoError(t, c.Post(`mutation {
+		replaceEntityTerms(namespace: "products", entityId: "macbook", termId: [2, 3]) { added removed }
+	}`, &resp))
+	assert.Equal(t, []uint64{3}, resp.ReplaceEntityTerms.Added)
+	assert.Equal(t, []uint64{1}, resp.ReplaceEntityTerms.Removed)
+
+	errs := gqlErrors(t, c.Post(`mutation {
+		replaceEntityTerms(namespace: "products", entityId: "macbook", termId: [5], vocabularyId: 7) { added }
+	}`, &struct{}{}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, `TERM_NOT_IN_VOCABULARY`, errs[0].Extensions[`code`])
+}
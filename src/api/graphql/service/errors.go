@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var ErrWrongCursor = errors.New(`wrong cursor`)
+
+// codeMap links taxonomy errors with codes in GraphQL error's extensions. Order matters: errors could be joined,
+// so the first matched wins.
+var codeMap = []struct {
+	err  error
+	code string
+}{
+	{context.Canceled, `CANCELED`},
+	{context.DeadlineExceeded, `DEADLINE_EXCEEDED`},
+	{ErrWrongCursor, `BAD_CURSOR`},
+	{taxonomy.ErrTermNotFound, `TERM_NOT_FOUND`},
+	{taxonomy.ErrVocabularyNotFound, `VOCABULARY_NOT_FOUND`},
+	{taxonomy.ErrNamespaceNotFound, `NAMESPACE_NOT_FOUND`},
+	{taxonomy.ErrReferenceExists, `REFERENCE_EXISTS`},
+	{taxonomy.ErrVocabularyHasTerms, `VOCABULARY_HAS_TERMS`},
+	{taxonomy.ErrHierarchyCycle, `HIERARCHY_CYCLE`},
+	{taxonomy.ErrHierarchyTooDeep, `HIERARCHY_TOO_DEEP`},
+	{repository.ErrNotUniqueName, `NOT_UNIQUE_NAME`},
+	{taxonomy.ErrTermNotUnique, `NOT_UNIQUE_NAME`},
+	{taxonomy.ErrSynonymEmpty, `SYNONYM_EMPTY`},
+	{taxonomy.ErrTermNotInVocabulary, `TERM_NOT_IN_VOCABULARY`},
+	{repository.ErrWithoutNamespace, `NAMESPACE_REQUIRED`},
+}
+
+// toError converts error returned by taxonomy services to GraphQL error with code in extensions. When deletion is
+// blocked, count of blocking objects is added to extensions, path of hierarchy cycle is added as well.
+func toError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var bulk *taxonomy.BulkError
+	if errors.As(err, &bulk) {
+		return bulkError(err, bulk)
+	}
+
+	var extensions = map[string]any{
+		`code`: `INTERNAL`,
+	}
+
+	for _, m := range codeMap {
+		if errors.Is(err, m.err) {
+			extensions[`code`] = m.code
+
+			break
+		}
+	}
+
+	var blocked *taxonomy.BlockedError
+	if errors.As(err, &blocked) {
+		extensions[`count`] = blocked.Count
+	}
+
+	var hierarchyErr *taxonomy.HierarchyError
+	if errors.As(err, &hierarchyErr) {
+		extensions[`path`] = hierarchyErr.Path
+	}
+
+	return &gqlerror.Error{
+		Err:        err,
+		Message:    err.Error(),
+		Extensions: extensions,
+	}
+}
+
+// bulkError returns error with BULK_FAILED code, extensions of every failed item are listed in items with item's index.
+func bulkError(err error, bulk *taxonomy.BulkError) error {
+	var items = make([]map[string]any, 0, len(bulk.Errors))
+
+	for _, i := range bulk.Indexes() {
+		var itemErr *gqlerror.Error
+		if errors.As(toError(bulk.Errors[i]), &itemErr) {
+			item := itemErr.Extensions
+			item[`index`] = i
+			item[`message`] = itemErr.Message
+			items = append(items, item)
+		}
+	}
+
+	return &gqlerror.Error{
+		Err:     err,
+		Message: err.Error(),
+		Extensions: map[string]any{
+			`code`:  `BULK_FAILED`,
+			`items`: items,
+		},
+	}
+}
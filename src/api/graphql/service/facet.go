@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
+	"github.com/dmalykh/taxonomy/taxonomy"
+)
+
+type TermFacet struct {
+	termService taxonomy.Term
+}
+
+func (t *TermFacet) Term(ctx context.Context, obj *apimodel.TermFacet) (apimodel.Term, error) {
+	term, err := t.termService.GetByID(ctx, obj.TermID)
+	if err != nil {
+		return apimodel.Term{}, toError(err)
+	}
+
+	return term2gen(term), nil
+}
+
+type VocabularyFacet struct {
+	vocabularyService taxonomy.Vocabulary
+}
+
+func (v *VocabularyFacet) Vocabulary(ctx context.Context, obj *apimodel.VocabularyFacet) (apimodel.Vocabulary, error) {
+	vocabulary, err := v.vocabularyService.GetByID(ctx, obj.VocabularyID)
+	if err != nil {
+		return apimodel.Vocabulary{}, toError(err)
+	}
+
+	return vocabulary2gen(vocabulary), nil
+}
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Facets(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	filter := mock.Captor[*model.ReferenceFilter]()
+	mock.When(referenceService.Facets(mock.Any[context.Context](), filter.Capture())).
+		ThenReturn(&model.Facets{
+			Terms:        []*model.TermFacet{{TermID: 2, Count: 5}},
+			Vocabularies: []*model.VocabularyFacet{{VocabularyID: 7, Count: 9}},
+		}, nil)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](2))).
+		ThenReturn(&model.Term{ID: 2, Data: model.TermData{Name: `apple`}}, nil)
+
+	vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+	mock.When(vocabularyService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](7))).
+		ThenReturn(&model.Vocabulary{ID: 7, Data: model.VocabularyData{Name: `brand`}}, nil)
+
+	c := newClient(&services{term: termService, vocabulary: vocabularyService, reference: referenceService})
+
+	var resp struct {
+		Facets struct {
+			Terms []struct {
+				TermID uint64
+				Count  int
+				Term   struct {
+					Name string
+				}
+			}
+			Vocabularies []struct {
+				Count      int
+				Vocabulary struct {
+					Name string
+				}
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ facets(namespace: "products", termId: [[1, 3], [4]], excludeTermId: [[5, 6]], withSubterms: true) {
+		terms { termId count term { name } }
+		vocabularies { count vocabulary { name } }
+	} }`, &resp))
+
+	require.Len(t, resp.Facets.Terms, 1)
+	assert.Equal(t, uint64(2), resp.Facets.Terms[0].TermID)
+	assert.Equal(t, 5, resp.Facets.Terms[0].Count)
+	assert.Equal(t, `apple`, resp.Facets.Terms[0].Term.Name)
+	require.Len(t, resp.Facets.Vocabularies, 1)
+	assert.Equal(t, 9, resp.Facets.Vocabularies[0].Count)
+	assert.Equal(t, `brand`, resp.Facets.Vocabularies[0].Vocabulary.Name)
+
+	assert.Equal(t, []string{`products`}, filter.Last().Namespace)
+	assert.Equal(t, [][]uint64{{1, 3}, {4}}, filter.Last().TermID)
+	assert.Equal(t, [][]uint64{{5, 6}}, filter.Last().ExcludeTermID)
+	assert.True(t, filter.Last().WithSubterms)
+}
@@ -1,54 +1,121 @@
 package service
 
 import (
-	model2 "github.com/dmalykh/taxonomy/taxonomy/model"
-	"unsafe"
+	"fmt"
 
 	"github.com/AlekSi/pointer"
 	"github.com/dmalykh/taxonomy/api/graphql/generated/genmodel"
 	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
 	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
-func term2gen(term model2.Term) apimodel.Term {
+func term2gen(term *model.Term) apimodel.Term {
 	return apimodel.Term{
-		ID:           int64(term.ID),
+		ID:           term.ID,
 		Name:         term.Data.Name,
 		Title:        &term.Data.Title,
 		Description:  &term.Data.Description,
-		VocabularyID: int64(term.Data.VocabularyID),
+		Labels:       term.Data.Labels,
+		Synonyms:     term.Data.Synonyms,
+		VocabularyID: term.Data.VocabularyID,
 	}
 }
 
-func vocabulary2gen(vocabulary model2.Vocabulary) apimodel.Vocabulary {
+func vocabulary2gen(vocabulary *model.Vocabulary) apimodel.Vocabulary {
 	return apimodel.Vocabulary{
-		ID:          int64(vocabulary.ID),
+		ID:          vocabulary.ID,
 		Name:        vocabulary.Data.Name,
 		Title:       vocabulary.Data.Title,
 		Description: vocabulary.Data.Description,
-		ParentID:    (*int64)(unsafe.Pointer(vocabulary.Data.ParentID)),
+		Labels:      vocabulary.Data.Labels,
+		ParentID:    vocabulary.Data.ParentID,
 	}
 }
 
-func int64stoUints(ints []int64) []uint {
-	uints := make([]uint, 0, len(ints))
-	for _, i := range ints {
-		uints = append(uints, uint(i))
+func namespace2gen(namespace *model.Namespace) genmodel.Namespace {
+	return genmodel.Namespace{
+		ID:   namespace.ID,
+		Name: namespace.Data.Name,
 	}
+}
 
-	return uints
+// convert converts every item of slice, i.e. terms or vocabularies.
+func tag2gen(tag *model.TextTag) genmodel.TextTag {
+	return genmodel.TextTag{
+		Term:  term2gen(tag.Term),
+		Text:  tag.Text,
+		Start: int64(tag.Start),
+		End:   int64(tag.End),
+		Field: searchFields[tag.Field],
+	}
 }
 
-func termsConnection(terms []model2.Term, limit int) *genmodel.TermsConnection {
-	var connection genmodel.TermsConnection
+func convert[T, G any](items []*T, f func(item *T) G) []G {
+	converted := make([]G, len(items))
+	for i, item := range items {
+		converted[i] = f(item)
+	}
+
+	return converted
+}
+
+// depth returns depth of hierarchy to request, zero means maximum depth.
+func depth(d *int64) uint {
+	if d == nil || *d < 0 {
+		return 0
+	}
+
+	return uint(*d)
+}
+
+// afterID decodes cursor to id.
+func afterID(after *string) (*uint64, error) {
+	if after == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	var id uint
+	if err := cursor.Unmarshal(*after, &id); err != nil {
+		return nil, fmt.Errorf(`%w: %w`, ErrWrongCursor, err)
+	}
+
+	return pointer.ToUint64(uint64(id)), nil
+}
+
+// limit returns count of items to request, one extra item is used to obtain HasNextPage.
+func limit(first int64) uint {
+	return uint(first + 1) // dirty hack to obtain HasNextPage
+}
+
+// pageInfo cuts extra item and returns information about page.
+func pageInfo[T any](items []T, first int64, id func(item T) uint64) ([]T, genmodel.PageInfo) {
+	var info = genmodel.PageInfo{
+		HasNextPage: pointer.ToBool(len(items) > int(first)),
+	}
+
+	if len(items) > int(first) {
+		items = items[:first]
+	}
 
+	if len(items) > 0 {
+		info.StartCursor = cursor.Marshal(uint(id(items[0])))
+		info.EndCursor = cursor.Marshal(uint(id(items[len(items)-1])))
+	}
+
+	return items, info
+}
+
+func termsConnection(terms []*model.Term, first int64) *genmodel.TermsConnection {
 	if len(terms) == 0 {
 		return nil
 	}
 
-	if len(terms) > limit {
-		terms = terms[:limit]
-	}
+	var connection genmodel.TermsConnection
+
+	terms, connection.PageInfo = pageInfo(terms, first, func(item *model.Term) uint64 {
+		return item.ID
+	})
 
 	connection.Edges = make([]genmodel.TermsEdge, len(terms))
 
@@ -56,16 +123,69 @@ func termsConnection(terms []model2.Term, limit int) *genmodel.TermsConnection {
 		gen := term2gen(term)
 
 		connection.Edges[i] = genmodel.TermsEdge{
-			Cursor: cursor.Marshal(term.ID),
+			Cursor: cursor.Marshal(uint(term.ID)),
 			Node:   &gen,
 		}
 	}
 
-	connection.PageInfo = genmodel.PageInfo{
-		StartCursor: connection.Edges[0].Cursor,
-		EndCursor:   connection.Edges[len(connection.Edges)-1].Cursor,
-		HasNextPage: pointer.ToBool(len(terms) > limit),
+	return &connection
+}
+
+func vocabulariesConnection(vocabularies []*model.Vocabulary, first int64) *genmodel.VocabularyConnection {
+	if len(vocabularies) == 0 {
+		return nil
+	}
+
+	var connection genmodel.VocabularyConnection
+
+	vocabularies, connection.PageInfo = pageInfo(vocabularies, first, func(item *model.Vocabulary) uint64 {
+		return item.ID
+	})
+
+	connection.Edges = make([]genmodel.VocabularyEdge, len(vocabularies))
+
+	for i, vocabulary := range vocabularies {
+		gen := vocabulary2gen(vocabulary)
+
+		connection.Edges[i] = genmodel.VocabularyEdge{
+			Cursor: cursor.Marshal(uint(vocabulary.ID)),
+			Node:   &gen,
+		}
 	}
 
 	return &connection
 }
+
+func namespacesConnection(namespaces []*model.Namespace, first int64) *genmodel.NamespacesConnection {
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	var connection genmodel.NamespacesConnection
+
+	namespaces, connection.PageInfo = pageInfo(namespaces, first, func(item *model.Namespace) uint64 {
+		return item.ID
+	})
+
+	connection.Edges = make([]genmodel.NamespacesEdge, len(namespaces))
+
+	for i, namespace := range namespaces {
+		gen := namespace2gen(namespace)
+
+		connection.Edges[i] = genmodel.NamespacesEdge{
+			Cursor: cursor.Marshal(uint(namespace.ID)),
+			Node:   &gen,
+		}
+	}
+
+	return &connection
+}
+
+func entities(entityID []string) []model.EntityID {
+	entities := make([]model.EntityID, 0, len(entityID))
+	for _, id := range entityID {
+		entities = append(entities, model.EntityID(id))
+	}
+
+	return entities
+}
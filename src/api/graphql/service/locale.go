@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/dmalykh/taxonomy/api/graphql/generated/genmodel"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"golang.org/x/text/language"
+)
+
+type localeKey struct{}
+
+type preference struct {
+	fallback *model.Fallback
+	accepted []string
+}
+
+// Localize keeps locales of Accept-Language header and fallback chains in context of the request, so titles and
+// descriptions are translated when their locale isn't requested explicitly.
+func Localize(fallback *model.Fallback, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p = &preference{fallback: fallback}
+
+		// Malformed header is ignored like a missing one
+		tags, _, _ := language.ParseAcceptLanguage(r.Header.Get(`Accept-Language`))
+		for _, tag := range tags {
+			if tag != language.Und {
+				p.accepted = append(p.accepted, tag.String())
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), localeKey{}, p)))
+	})
+}
+
+// chain returns locales to look labels up: the requested locale or accepted ones followed by their fallbacks.
+func chain(ctx context.Context, locale *string) []string {
+	p, ok := ctx.Value(localeKey{}).(*preference)
+	if !ok {
+		p = new(preference)
+	}
+
+	if locale != nil {
+		return p.fallback.Chain(*locale)
+	}
+
+	return p.fallback.Chain(p.accepted...)
+}
+
+// gen2synonyms returns nil for omitted synonyms, so current ones are kept on update.
+func gen2synonyms(input []genmodel.SynonymInput) []model.Synonym {
+	if input == nil {
+		return nil
+	}
+
+	var synonyms = make([]model.Synonym, len(input))
+	for i, synonym := range input {
+		synonyms[i] = model.Synonym{
+			Name:   synonym.Name,
+			Locale: model.NormalizeLocale(pointer.GetString(synonym.Locale)),
+			Hidden: synonym.Hidden,
+		}
+	}
+
+	return synonyms
+}
+
+// labels2gen returns labels ordered by locales.
+func labels2gen(labels model.Labels) []genmodel.Label {
+	var converted = make([]genmodel.Label, 0, len(labels))
+
+	for _, locale := range labels.Locales() {
+		converted = append(converted, genmodel.Label{
+			Locale:      locale,
+			Title:       pointer.ToStringOrNil(labels[locale].Title),
+			Description: pointer.ToStringOrNil(labels[locale].Description),
+		})
+	}
+
+	return converted
+}
+
+func gen2labels(input []genmodel.LabelInput) model.Labels {
+	if input == nil {
+		return nil
+	}
+
+	var labels = make(model.Labels, len(input))
+	for _, label := range input {
+		labels[model.NormalizeLocale(label.Locale)] = model.Label{
+			Title:       pointer.GetString(label.Title),
+			Description: pointer.GetString(label.Description),
+		}
+	}
+
+	return labels
+}
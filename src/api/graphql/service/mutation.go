@@ -2,95 +2,223 @@ package service
 
 import (
 	"context"
-	"github.com/dmalykh/taxonomy/taxonomy"
-	model2 "github.com/dmalykh/taxonomy/taxonomy/model"
-	"unsafe"
 
 	"github.com/AlekSi/pointer"
 	"github.com/dmalykh/taxonomy/api/graphql/generated/genmodel"
 	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
-	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 type Mutation struct {
 	termService       taxonomy.Term
 	vocabularyService taxonomy.Vocabulary
+	namespaceService  taxonomy.Namespace
+	referenceService  taxonomy.Reference
+	suggester         taxonomy.Suggester
 }
 
 func (m *Mutation) CreateTerm(ctx context.Context, input genmodel.TermInput) (apimodel.Term, error) {
-	term, err := m.termService.Create(ctx, &model2.TermData{
+	term, err := m.termService.Create(ctx, &model.TermData{
 		Name:         input.Name,
 		Title:        input.Title,
-		VocabularyID: uint(input.VocabularyID),
-		Description:  *(input.Description),
+		VocabularyID: input.VocabularyID,
+		Description:  pointer.GetString(input.Description),
+		Labels:       gen2labels(input.Labels),
+		Synonyms:     gen2synonyms(input.Synonyms),
+		SuperID:      input.SuperID,
+		SubID:        input.SubID,
 	})
 	if err != nil {
-		return apimodel.Term{}, gqlerror.Errorf(`error to create term %s`, err.Error())
+		return apimodel.Term{}, toError(err)
 	}
 
 	return term2gen(term), nil
 }
 
-func (m *Mutation) UpdateTerm(ctx context.Context, id int64, input genmodel.TermInput) (apimodel.Term, error) {
-	term, err := m.termService.Update(ctx, uint(id), &model2.TermData{
+func (m *Mutation) CreateTerms(ctx context.Context, input []genmodel.TermInput) ([]apimodel.Term, error) {
+	var data = make([]*model.TermData, len(input))
+	for i, term := range input {
+		data[i] = &model.TermData{
+			Name:         term.Name,
+			Title:        term.Title,
+			VocabularyID: term.VocabularyID,
+			Description:  pointer.GetString(term.Description),
+			Labels:       gen2labels(term.Labels),
+			Synonyms:     gen2synonyms(term.Synonyms),
+			SuperID:      term.SuperID,
+			SubID:        term.SubID,
+		}
+	}
+
+	terms, err := m.termService.CreateBulk(ctx, data...)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	var created = make([]apimodel.Term, len(terms))
+	for i, term := range terms {
+		created[i] = term2gen(term)
+	}
+
+	return created, nil
+}
+
+func (m *Mutation) UpdateTerm(ctx context.Context, id uint64, input genmodel.TermInput) (apimodel.Term, error) {
+	term, err := m.termService.Update(ctx, id, &model.TermData{
 		Name:         input.Name,
 		Title:        input.Title,
-		VocabularyID: uint(input.VocabularyID),
-		Description:  *(input.Description),
+		VocabularyID: input.VocabularyID,
+		Description:  pointer.GetString(input.Description),
+		Labels:       gen2labels(input.Labels),
+		Synonyms:     gen2synonyms(input.Synonyms),
+		SuperID:      input.SuperID,
+		SubID:        input.SubID,
 	})
 	if err != nil {
-		return apimodel.Term{}, gqlerror.Errorf(`error to update term %s`, err.Error())
+		return apimodel.Term{}, toError(err)
 	}
 
 	return term2gen(term), nil
 }
 
-func (m *Mutation) Set(ctx context.Context, termID []int64, namespace string, entityID []int64) (*bool, error) {
-	entitiesID := int64stoUints(entityID)
+func (m *Mutation) DeleteTerm(ctx context.Context, id uint64) (bool, error) {
+	if err := m.termService.Delete(ctx, id); err != nil {
+		return false, toError(err)
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) LinkTerms(ctx context.Context, superID uint64, subID uint64) (bool, error) {
+	if err := m.termService.Link(ctx, superID, subID); err != nil {
+		return false, toError(err)
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) UnlinkTerms(ctx context.Context, superID uint64, subID uint64) (bool, error) {
+	if err := m.termService.Unlink(ctx, superID, subID); err != nil {
+		return false, toError(err)
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) Set(ctx context.Context, termID []uint64, namespace string, entityID []string) (*bool, error) {
 	for _, id := range termID {
-		if err := m.termService.SetReference(ctx, uint(id), namespace, entitiesID...); err != nil {
-			return nil, gqlerror.Errorf(`error to set reference %d %s %q term %s`, id, namespace, entitiesID, err.Error())
+		if err := m.referenceService.Create(ctx, id, namespace, entities(entityID)...); err != nil {
+			return nil, toError(err)
 		}
 	}
 
 	return pointer.ToBool(true), nil
 }
 
-func (m *Mutation) Unset(ctx context.Context, termID []int64, namespace string, entityID []int64) (*bool, error) {
-	entitiesID := int64stoUints(entityID)
+func (m *Mutation) Unset(ctx context.Context, termID []uint64, namespace string, entityID []string) (*bool, error) {
 	for _, id := range termID {
-		if err := m.termService.SetReference(ctx, uint(id), namespace, entitiesID...); err != nil {
-			return nil, gqlerror.Errorf(`error to unset reference %d %s %q term %s`, id, namespace, entitiesID, err.Error())
+		if err := m.referenceService.Delete(ctx, id, namespace, entities(entityID)...); err != nil {
+			return nil, toError(err)
 		}
 	}
 
 	return pointer.ToBool(true), nil
 }
 
+func (m *Mutation) ReplaceEntityTerms(ctx context.Context, namespace string, entityID string, termID []uint64, vocabularyID *uint64) (genmodel.ReferenceChanges, error) { //nolint:lll
+	var (
+		changes *model.ReferenceChanges
+		err     error
+	)
+
+	if vocabularyID != nil {
+		changes, err = m.referenceService.ReplaceInVocabulary(ctx, namespace, model.EntityID(entityID), *vocabularyID,
+			termID)
+	} else {
+		changes, err = m.referenceService.Replace(ctx, namespace, model.EntityID(entityID), termID)
+	}
+
+	if err != nil {
+		return genmodel.ReferenceChanges{}, toError(err)
+	}
+
+	return genmodel.ReferenceChanges{Added: changes.Added, Removed: changes.Removed}, nil
+}
+
+func (m *Mutation) TagEntity(ctx context.Context, namespace string, entityID string, text string, vocabularyID []uint64) ([]genmodel.TextTag, error) { //nolint:lll
+	tags, err := m.suggester.Tag(ctx, &model.TextTagging{
+		Text:         text,
+		VocabularyID: vocabularyID,
+		Reference:    &model.TagReference{Namespace: namespace, EntityID: model.EntityID(entityID)},
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return convert(tags, tag2gen), nil
+}
+
 func (m *Mutation) CreateVocabulary(ctx context.Context, input genmodel.VocabularyInput) (apimodel.Vocabulary, error) {
-	vocabulary, err := m.vocabularyService.Create(ctx, &model2.VocabularyData{
+	vocabulary, err := m.vocabularyService.Create(ctx, &model.VocabularyData{
 		Name:        input.Name,
 		Title:       input.Title,
-		ParentID:    (*uint)(unsafe.Pointer(input.ParentID)),
+		ParentID:    input.ParentID,
 		Description: input.Description,
+		Labels:      gen2labels(input.Labels),
 	})
 	if err != nil {
-		return apimodel.Vocabulary{}, gqlerror.Errorf(`error to create vocabulary %s`, err.Error())
+		return apimodel.Vocabulary{}, toError(err)
 	}
 
 	return vocabulary2gen(vocabulary), nil
 }
 
-func (m *Mutation) UpdateVocabulary(ctx context.Context, id int64, input genmodel.VocabularyInput) (apimodel.Vocabulary, error) {
-	vocabulary, err := m.vocabularyService.Update(ctx, uint(id), &model2.VocabularyData{
+func (m *Mutation) UpdateVocabulary(ctx context.Context, id uint64, input genmodel.VocabularyInput) (apimodel.Vocabulary, error) { //nolint:lll
+	vocabulary, err := m.vocabularyService.Update(ctx, id, &model.VocabularyData{
 		Name:        input.Name,
 		Title:       input.Title,
-		ParentID:    (*uint)(unsafe.Pointer(input.ParentID)),
+		ParentID:    input.ParentID,
 		Description: input.Description,
+		Labels:      gen2labels(input.Labels),
 	})
 	if err != nil {
-		return apimodel.Vocabulary{}, gqlerror.Errorf(`error to update vocabulary %s`, err.Error())
+		return apimodel.Vocabulary{}, toError(err)
 	}
 
 	return vocabulary2gen(vocabulary), nil
 }
+
+func (m *Mutation) DeleteVocabulary(ctx context.Context, id uint64) (bool, error) {
+	if err := m.vocabularyService.Delete(ctx, id); err != nil {
+		return false, toError(err)
+	}
+
+	return true, nil
+}
+
+func (m *Mutation) CreateNamespace(ctx context.Context, name string) (genmodel.Namespace, error) {
+	namespace, err := m.namespaceService.Create(ctx, name)
+	if err != nil {
+		return genmodel.Namespace{}, toError(err)
+	}
+
+	return namespace2gen(namespace), nil
+}
+
+func (m *Mutation) UpdateNamespace(ctx context.Context, id uint64, name string) (genmodel.Namespace, error) {
+	namespace, err := m.namespaceService.Update(ctx, id, name)
+	if err != nil {
+		return genmodel.Namespace{}, toError(err)
+	}
+
+	return namespace2gen(namespace), nil
+}
+
+func (m *Mutation) DeleteNamespace(ctx context.Context, id uint64) (bool, error) {
+	if err := m.namespaceService.Delete(ctx, id); err != nil {
+		return false, toError(err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Namespaces(t *testing.T) {
+	mock.SetUp(t)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.Get(mock.Any[context.Context](), mock.Equal[uint](3), mock.Any[*uint64]())).
+		ThenReturn([]*model.Namespace{
+			{ID: 5, Data: model.NamespaceData{Name: `products`}},
+			{ID: 6, Data: model.NamespaceData{Name: `articles`}},
+			{ID: 7, Data: model.NamespaceData{Name: `users`}},
+		}, nil)
+
+	c := newClient(&services{namespace: namespaceService})
+
+	var resp struct {
+		Namespaces struct {
+			Edges []struct {
+				Node struct {
+					ID   uint64
+					Name string
+				}
+			}
+			PageInfo struct {
+				EndCursor   string
+				HasNextPage bool
+			}
+		}
+	}
+	c.MustPost(`query($after: Cursor) { namespaces(first: 2, after: $after) { edges { node { id name } } pageInfo { endCursor hasNextPage } } }`, //nolint:lll
+		&resp, client.Var(`after`, cursor.Marshal(uint(4))))
+
+	require.Len(t, resp.Namespaces.Edges, 2)
+	assert.Equal(t, `articles`, resp.Namespaces.Edges[1].Node.Name)
+	assert.True(t, resp.Namespaces.PageInfo.HasNextPage)
+	assert.Equal(t, cursor.Marshal(uint(6)), resp.Namespaces.PageInfo.EndCursor)
+}
+
+func TestQuery_Namespace(t *testing.T) {
+	mock.SetUp(t)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.GetByName(mock.Any[context.Context](), mock.Equal(`unknown`))).
+		ThenReturn(nil, fmt.Errorf(`%w, got %d results`, taxonomy.ErrNamespaceNotFound, 0))
+
+	c := newClient(&services{namespace: namespaceService})
+
+	var resp map[string]any
+	err := c.Post(`{ namespace(name: "unknown") { id } }`, &resp)
+
+	errs := gqlErrors(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, `NAMESPACE_NOT_FOUND`, errs[0].Extensions[`code`])
+}
+
+func TestMutation_UpdateNamespace(t *testing.T) {
+	mock.SetUp(t)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.Update(mock.Any[context.Context](), mock.Equal[uint64](5), mock.Equal(`goods`))).
+		ThenReturn(&model.Namespace{ID: 5, Data: model.NamespaceData{Name: `goods`}}, nil)
+
+	c := newClient(&services{namespace: namespaceService})
+
+	var resp struct {
+		UpdateNamespace struct {
+			ID   uint64
+			Name string
+		}
+	}
+	c.MustPost(`mutation { updateNamespace(id: 5, name: "goods") { id name } }`, &resp)
+
+	assert.Equal(t, uint64(5), resp.UpdateNamespace.ID)
+	assert.Equal(t, `goods`, resp.UpdateNamespace.Name)
+}
+
+func TestMutation_DeleteNamespace(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{
+			name: `ok`,
+		},
+		{
+			name: `references exist`,
+			err:  errors.Join(taxonomy.ErrReferenceExists, fmt.Errorf(`%d has %d references`, 5, 10)),
+			code: `REFERENCE_EXISTS`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			namespaceService := mock.Mock[taxonomy.Namespace]()
+			mock.When(namespaceService.Delete(mock.Any[context.Context](), mock.Equal[uint64](5))).
+				ThenReturn(tt.err)
+
+			c := newClient(&services{namespace: namespaceService})
+
+			var resp struct {
+				DeleteNamespace bool
+			}
+			err := c.Post(`mutation { deleteNamespace(id: 5) }`, &resp)
+
+			if tt.err == nil {
+				require.NoError(t, err)
+				assert.True(t, resp.DeleteNamespace)
+
+				return
+			}
+
+			errs := gqlErrors(t, err)
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.code, errs[0].Extensions[`code`])
+		})
+	}
+}
@@ -1,94 +1,257 @@
 package service
 
 import (
+	"cmp"
 	"context"
-	"fmt"
-	"github.com/dmalykh/taxonomy/taxonomy"
-	model2 "github.com/dmalykh/taxonomy/taxonomy/model"
-	"unsafe"
+	"slices"
 
+	"github.com/AlekSi/pointer"
 	"github.com/dmalykh/taxonomy/api/graphql/generated/genmodel"
 	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
-	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
-	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 type Query struct {
 	termService       taxonomy.Term
 	vocabularyService taxonomy.Vocabulary
+	namespaceService  taxonomy.Namespace
+	referenceService  taxonomy.Reference
+	suggester         taxonomy.Suggester
 }
 
-func (q *Query) Term(ctx context.Context, id int64) (apimodel.Term, error) {
-	term, err := q.termService.GetByID(ctx, uint(id))
+func (q *Query) Term(ctx context.Context, id uint64) (apimodel.Term, error) {
+	term, err := q.termService.GetByID(ctx, id)
 	if err != nil {
-		return apimodel.Term{}, fmt.Errorf(`error %w to get term %d: %s`, taxonomy.ErrTermNotFound, id, err.Error())
+		return apimodel.Term{}, toError(err)
 	}
 
 	return term2gen(term), nil
 }
 
-func (q *Query) Terms(ctx context.Context, vocabularyID int64, name *string, first int64, after *string) (*genmodel.TermsConnection, error) {
-	var afterID uint
-	if after != nil {
-		if err := cursor.Unmarshal(*after, &afterID); err != nil {
-			return nil, fmt.Errorf(`error to unmarshal %q: %w`, *after, err)
+func (q *Query) Terms(ctx context.Context, filter *genmodel.TermFilter, first int64, after *string) (*genmodel.TermsConnection, error) { //nolint:lll
+	afterID, err := afterID(after)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	var termFilter = &model.TermFilter{
+		Limit:   limit(first),
+		AfterID: afterID,
+	}
+
+	if filter != nil {
+		termFilter.VocabularyID = filter.VocabularyID
+		termFilter.Name = filter.Name
+		termFilter.WithSynonyms = filter.WithSynonyms
+		termFilter.SuperID = filter.SuperID
+		termFilter.SubID = filter.SubID
+
+		if filter.Namespace != nil || len(filter.ExcludeTermID) > 0 || len(filter.EntityID) > 0 {
+			if termFilter.ID, err = q.entitiesTerms(ctx, filter); err != nil {
+				return nil, toError(err)
+			}
+
+			// Empty filter would return all terms
+			if len(termFilter.ID) == 0 {
+				return termsConnection(nil, first), nil
+			}
 		}
 	}
 
-	terms, err := q.termService.Get(ctx, &model2.TermFilter{
-		VocabularyID: []uint{uint(vocabularyID)},
-		Limit:        uint(first + 1), // dirty hack to obtain HasNextPage
-		AfterID:      &afterID,
-		Name:         name,
+	terms, err := q.termService.Get(ctx, termFilter)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return termsConnection(terms, first), nil
+}
+
+// entitiesTerms returns ids of terms related with entities of the namespace which have no excluded terms.
+func (q *Query) entitiesTerms(ctx context.Context, filter *genmodel.TermFilter) ([]uint64, error) {
+	var namespace []string
+	if filter.Namespace != nil {
+		namespace = []string{*filter.Namespace}
+	}
+
+	var entityID []model.EntityID
+	for _, id := range filter.EntityID {
+		entityID = append(entityID, model.EntityID(id))
+	}
+
+	facets, err := q.referenceService.Facets(ctx, &model.ReferenceFilter{
+		Namespace:     namespace,
+		EntityID:      entityID,
+		ExcludeTermID: filter.ExcludeTermID,
 	})
 	if err != nil {
-		return nil, fmt.Errorf(`error to get list %w`, err)
+		return nil, err //nolint:wrapcheck
 	}
 
-	return termsConnection(terms, int(first)), nil
+	var ids = make([]uint64, 0, len(facets.Terms))
+
+	for _, facet := range facets.Terms {
+		if facet.Count > 0 {
+			ids = append(ids, facet.TermID)
+		}
+	}
+
+	return ids, nil
 }
 
-func (q *Query) TermsByEntities(ctx context.Context, namespace string, entityID []int64) ([]*apimodel.Term, error) {
-	terms, err := q.termService.GetTermsByEntities(ctx, namespace, int64stoUints(entityID)...)
+var (
+	searchModes = map[genmodel.SearchMode]model.SearchMode{
+		genmodel.SearchModePrefix:    model.SearchPrefix,
+		genmodel.SearchModeSubstring: model.SearchSubstring,
+		genmodel.SearchModeFuzzy:     model.SearchFuzzy,
+	}
+	searchFields = map[model.SearchField]genmodel.SearchField{
+		model.SearchName:        genmodel.SearchFieldName,
+		model.SearchSynonym:     genmodel.SearchFieldSynonym,
+		model.SearchTitle:       genmodel.SearchFieldTitle,
+		model.SearchDescription: genmodel.SearchFieldDescription,
+	}
+)
+
+func (q *Query) SearchTerms(ctx context.Context, query string, mode genmodel.SearchMode, vocabularyID []uint64, first int64) ([]genmodel.TermMatch, error) { //nolint:lll
+	matches, err := q.termService.Search(ctx, &model.TermSearch{
+		Query:        query,
+		Mode:         searchModes[mode],
+		VocabularyID: vocabularyID,
+		Limit:        uint(max(first, 0)),
+	})
 	if err != nil {
-		return nil, gqlerror.Errorf(`error to get terms by entities %s`, err.Error())
+		return nil, toError(err)
 	}
 
-	return func(terms []model2.Term) []*apimodel.Term {
-		apiterms := make([]*apimodel.Term, len(terms))
+	return convert(matches, func(match *model.TermMatch) genmodel.TermMatch {
+		return genmodel.TermMatch{Term: term2gen(match.Term), Score: match.Score, Field: searchFields[match.Field]}
+	}), nil
+}
+
+func (q *Query) SuggestTerms(ctx context.Context, prefix string, vocabularyID []uint64, namespace *string, first int64) ([]genmodel.TermSuggestion, error) { //nolint:lll
+	suggestions, err := q.suggester.Suggest(ctx, &model.TermSuggest{
+		Prefix:       prefix,
+		VocabularyID: vocabularyID,
+		Namespace:    pointer.GetString(namespace),
+		Limit:        uint(max(first, 0)),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
 
-		for i, term := range terms {
-			term := term2gen(term)
-			apiterms[i] = &term
+	return convert(suggestions, func(suggestion *model.TermSuggestion) genmodel.TermSuggestion {
+		return genmodel.TermSuggestion{
+			Term:       term2gen(suggestion.Term),
+			Text:       suggestion.Text,
+			Field:      searchFields[suggestion.Field],
+			Popularity: int64(suggestion.Popularity),
 		}
+	}), nil
+}
 
-		return apiterms
-	}(terms), nil
+func (q *Query) TagText(ctx context.Context, text string, vocabularyID []uint64, overlapping bool) ([]genmodel.TextTag, error) { //nolint:lll
+	tags, err := q.suggester.Tag(ctx, &model.TextTagging{
+		Text:         text,
+		VocabularyID: vocabularyID,
+		Overlapping:  overlapping,
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return convert(tags, tag2gen), nil
+}
+
+func (q *Query) Vocabulary(ctx context.Context, id uint64) (apimodel.Vocabulary, error) {
+	vocabulary, err := q.vocabularyService.GetByID(ctx, id)
+	if err != nil {
+		return apimodel.Vocabulary{}, toError(err)
+	}
+
+	return vocabulary2gen(vocabulary), nil
+}
+
+func (q *Query) Vocabularies(ctx context.Context, filter *genmodel.VocabularyFilter, first int64, after *string) (*genmodel.VocabularyConnection, error) { //nolint:lll
+	afterID, err := afterID(after)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	var vocabularyFilter = new(model.VocabularyFilter)
+	if filter != nil {
+		vocabularyFilter.ParentID = filter.ParentID
+		vocabularyFilter.Name = filter.Name
+	}
+
+	vocabularies, err := q.vocabularyService.Get(ctx, vocabularyFilter)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	// Vocabularies aren't paginated by service, so cut the page here
+	slices.SortFunc(vocabularies, func(a, b *model.Vocabulary) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	if afterID != nil {
+		vocabularies = slices.DeleteFunc(vocabularies, func(vocabulary *model.Vocabulary) bool {
+			return vocabulary.ID <= *afterID
+		})
+	}
+
+	return vocabulariesConnection(vocabularies, first), nil
 }
 
-func (q *Query) Vocabulary(ctx context.Context, id int64) (apimodel.Vocabulary, error) {
-	vocabulary, err := q.vocabularyService.GetByID(ctx, uint(id))
+func (q *Query) Namespaces(ctx context.Context, first int64, after *string) (*genmodel.NamespacesConnection, error) {
+	afterID, err := afterID(after)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	namespaces, err := q.namespaceService.Get(ctx, limit(first), afterID)
+	if err != nil {
+		return nil, toError(err)
+	}
 
-	return vocabulary2gen(vocabulary), err
+	return namespacesConnection(namespaces, first), nil
 }
 
-func (q *Query) Categories(ctx context.Context, parentID *int64, name *string) ([]*apimodel.Vocabulary, error) {
-	vocabularys, err := q.vocabularyService.Get(ctx, &model2.VocabularyFilter{
-		ParentID: (*uint)(unsafe.Pointer(parentID)),
-		Name:     name,
+func (q *Query) Namespace(ctx context.Context, name string) (genmodel.Namespace, error) {
+	namespace, err := q.namespaceService.GetByName(ctx, name)
+	if err != nil {
+		return genmodel.Namespace{}, toError(err)
+	}
+
+	return namespace2gen(namespace), nil
+}
+
+func (q *Query) Facets(ctx context.Context, namespace string, termID [][]uint64, excludeTermID [][]uint64, withSubterms bool, withVocabularyDescendants bool) (genmodel.Facets, error) { //nolint:lll
+	facets, err := q.referenceService.Facets(ctx, &model.ReferenceFilter{
+		TermID:                    termID,
+		ExcludeTermID:             excludeTermID,
+		Namespace:                 []string{namespace},
+		WithSubterms:              withSubterms,
+		WithVocabularyDescendants: withVocabularyDescendants,
 	})
 	if err != nil {
-		return nil, gqlerror.Errorf(`error to get categories by filter %s`, err.Error())
+		return genmodel.Facets{}, toError(err)
 	}
 
-	return func(vocabularys []model2.Vocabulary) []*apimodel.Vocabulary {
-		apivocabularys := make([]*apimodel.Vocabulary, len(vocabularys))
+	return genmodel.Facets{
+		Terms: convert(facets.Terms, func(facet *model.TermFacet) apimodel.TermFacet {
+			return apimodel.TermFacet{TermID: facet.TermID, Count: int64(facet.Count)}
+		}),
+		Vocabularies: convert(facets.Vocabularies, func(facet *model.VocabularyFacet) apimodel.VocabularyFacet {
+			return apimodel.VocabularyFacet{VocabularyID: facet.VocabularyID, Count: int64(facet.Count)}
+		}),
+	}, nil
+}
 
-		for i, vocabulary := range vocabularys {
-			vocabulary := vocabulary2gen(vocabulary)
-			apivocabularys[i] = &vocabulary
-		}
+func (q *Query) Entity(ctx context.Context, namespace string, id string) (apimodel.EntityNode, error) {
+	if _, err := q.namespaceService.GetByName(ctx, namespace); err != nil {
+		return apimodel.EntityNode{}, toError(err)
+	}
 
-		return apivocabularys
-	}(vocabularys), nil
+	return apimodel.EntityNode{ID: id, Namespace: namespace}, nil
 }
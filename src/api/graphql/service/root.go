@@ -6,15 +6,23 @@ import (
 	"github.com/dmalykh/taxonomy/taxonomy"
 )
 
-func NewResolver(termService taxonomy.Term, vocabularyService taxonomy.Vocabulary, namespaceService taxonomy.Namespace) generated.ResolverRoot { //nolint:lll
+func NewResolver(termService taxonomy.Term, vocabularyService taxonomy.Vocabulary, namespaceService taxonomy.Namespace,
+	referenceService taxonomy.Reference, suggester taxonomy.Suggester,
+) generated.ResolverRoot {
 	return &Root{
 		queryResolver: &Query{
 			termService:       termService,
 			vocabularyService: vocabularyService,
+			namespaceService:  namespaceService,
+			referenceService:  referenceService,
+			suggester:         suggester,
 		},
 		mutationResolver: &Mutation{
 			termService:       termService,
 			vocabularyService: vocabularyService,
+			namespaceService:  namespaceService,
+			referenceService:  referenceService,
+			suggester:         suggester,
 		},
 		entityResolver: &Entity{
 			termService:       termService,
@@ -27,17 +35,33 @@ func NewResolver(termService taxonomy.Term, vocabularyService taxonomy.Vocabular
 		termResolver: &Term{
 			termService:       termService,
 			vocabularyService: vocabularyService,
-			namespaceService:  namespaceService,
+			referenceService:  referenceService,
+		},
+		termFacetResolver: &TermFacet{
+			termService: termService,
+		},
+		vocabularyFacetResolver: &VocabularyFacet{
+			vocabularyService: vocabularyService,
+		},
+		entityNodeResolver: &EntityNode{
+			referenceService: referenceService,
+		},
+		vocabularyTermsResolver: &VocabularyTerms{
+			vocabularyService: vocabularyService,
 		},
 	}
 }
 
 type Root struct {
-	queryResolver      generated.QueryResolver
-	mutationResolver   generated.MutationResolver
-	entityResolver     generated.EntityResolver
-	vocabularyResolver generated.VocabularyResolver
-	termResolver       generated.TermResolver
+	queryResolver           generated.QueryResolver
+	mutationResolver        generated.MutationResolver
+	entityResolver          generated.EntityResolver
+	vocabularyResolver      generated.VocabularyResolver
+	termResolver            generated.TermResolver
+	termFacetResolver       generated.TermFacetResolver
+	vocabularyFacetResolver generated.VocabularyFacetResolver
+	entityNodeResolver      generated.EntityNodeResolver
+	vocabularyTermsResolver generated.VocabularyTermsResolver
 }
 
 func (r *Root) Vocabulary() generated.VocabularyResolver {
@@ -59,3 +83,19 @@ func (r *Root) Query() generated.QueryResolver {
 func (r *Root) Term() generated.TermResolver {
 	return r.termResolver
 }
+
+func (r *Root) TermFacet() generated.TermFacetResolver {
+	return r.termFacetResolver
+}
+
+func (r *Root) VocabularyFacet() generated.VocabularyFacetResolver {
+	return r.vocabularyFacetResolver
+}
+
+func (r *Root) EntityNode() generated.EntityNodeResolver {
+	return r.entityNodeResolver
+}
+
+func (r *Root) VocabularyTerms() generated.VocabularyTermsResolver {
+	return r.vocabularyTermsResolver
+}
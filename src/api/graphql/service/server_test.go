@@ -0,0 +1,47 @@
+package service_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/dmalykh/taxonomy/api/graphql/generated"
+	"github.com/dmalykh/taxonomy/api/graphql/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/stretchr/testify/require"
+)
+
+type services struct {
+	term       taxonomy.Term
+	vocabulary taxonomy.Vocabulary
+	namespace  taxonomy.Namespace
+	reference  taxonomy.Reference
+	suggester  taxonomy.Suggester
+}
+
+func newClient(s *services) *client.Client {
+	return client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: service.NewResolver(s.term, s.vocabulary, s.namespace, s.reference, s.suggester),
+	})))
+}
+
+// gqlErrors decodes errors returned by client.
+func gqlErrors(t *testing.T, err error) []struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions"`
+} {
+	t.Helper()
+
+	var raw client.RawJsonError
+
+	require.ErrorAs(t, err, &raw)
+
+	var errs []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(raw.RawMessage, &errs))
+
+	return errs
+}
@@ -2,94 +2,190 @@ package service
 
 import (
 	"context"
-	"github.com/dmalykh/taxonomy/taxonomy"
-	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"slices"
 
 	"github.com/AlekSi/pointer"
 	"github.com/dmalykh/taxonomy/api/graphql/generated/genmodel"
 	apimodel "github.com/dmalykh/taxonomy/api/graphql/model"
 	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
-	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 type Term struct {
 	termService       taxonomy.Term
 	vocabularyService taxonomy.Vocabulary
-	namespaceService  taxonomy.Namespace
+	referenceService  taxonomy.Reference
+}
+
+func (t *Term) Vocabularies(ctx context.Context, obj *apimodel.Term) ([]apimodel.Vocabulary, error) {
+	vocabularies := make([]apimodel.Vocabulary, 0, len(obj.VocabularyID))
+
+	for _, id := range obj.VocabularyID {
+		vocabulary, err := t.vocabularyService.GetByID(ctx, id)
+		if err != nil {
+			return nil, toError(err)
+		}
+
+		vocabularies = append(vocabularies, vocabulary2gen(vocabulary))
+	}
+
+	return vocabularies, nil
 }
 
+// Vocabulary is kept for clients which were written when term had one vocabulary.
 func (t *Term) Vocabulary(ctx context.Context, obj *apimodel.Term) (apimodel.Vocabulary, error) {
-	vocabulary, err := t.vocabularyService.GetByID(ctx, uint(obj.VocabularyID))
+	if len(obj.VocabularyID) == 0 {
+		return apimodel.Vocabulary{}, toError(taxonomy.ErrVocabularyNotFound)
+	}
 
-	return vocabulary2gen(vocabulary), err
+	vocabulary, err := t.vocabularyService.GetByID(ctx, obj.VocabularyID[0])
+	if err != nil {
+		return apimodel.Vocabulary{}, toError(err)
+	}
+
+	return vocabulary2gen(vocabulary), nil
 }
 
-func (t *Term) Entities(ctx context.Context, obj *apimodel.Term, first int64, after *string, namespace []*string) (*genmodel.EntitiesConnection, error) { //nolint:lll
-	var afterID uint
-	if after != nil {
-		if err := cursor.Unmarshal(*after, &afterID); err != nil {
-			return nil, gqlerror.Errorf(`error to unmarshal cursor %s`, err.Error())
-		}
+func (t *Term) Entities(ctx context.Context, obj *apimodel.Term, first int64, after *string, namespace []string, excludeTermID [][]uint64, withSubterms bool, withVocabularyDescendants bool) (*genmodel.EntitiesConnection, error) { //nolint:lll
+	afterID, err := afterID(after)
+	if err != nil {
+		return nil, toError(err)
 	}
 
+	var l = limit(first)
+
 	// Get references
-	references, err := t.termService.GetReferences(ctx, &model.EntityFilter{
-		TermID: [][]uint{{uint(obj.ID)}},
-		Namespace: func() []string {
-			namespaces := make([]string, len(namespace))
-			for i, ns := range namespace {
-				namespaces[i] = *ns
-			}
-
-			return namespaces
-		}(),
-		AfterID: &afterID,
-		Limit:   pointer.ToUint(uint(first + 1)),
+	references, err := t.referenceService.Get(ctx, &model.ReferenceFilter{
+		TermID:                    [][]uint64{{obj.ID}},
+		ExcludeTermID:             excludeTermID,
+		Namespace:                 namespace,
+		AfterID:                   afterID,
+		Limit:                     &l,
+		WithSubterms:              withSubterms,
+		WithVocabularyDescendants: withVocabularyDescendants,
 	})
 	if err != nil {
-		return nil, gqlerror.Errorf(`error to get references %s`, err.Error())
+		return nil, toError(err)
+	}
+
+	if len(references) == 0 {
+		return nil, nil //nolint:nilnil
 	}
 
-	// Get map of namespaces and their ids
-	namespaces := func() map[uint]string {
-		namespaces := make(map[uint]string, len(namespace))
+	var connection genmodel.EntitiesConnection
 
-		for _, ns := range namespace {
-			namespaceModel, _ := t.namespaceService.GetByName(ctx, *ns)
-			namespaces[namespaceModel.ID] = namespaceModel.Name
+	references, connection.PageInfo = pageInfo(references, first, func(item *model.Reference) uint64 {
+		return item.ID
+	})
+
+	connection.Edges = make([]genmodel.EntitiesEdge, len(references))
+	for i, reference := range references {
+		connection.Edges[i] = genmodel.EntitiesEdge{
+			Cursor: cursor.Marshal(uint(reference.ID)),
+			Node: &apimodel.EntityNode{
+				Namespace: reference.Namespace,
+				ID:        string(reference.EntityID),
+			},
 		}
+	}
 
-		return namespaces
-	}()
+	return &connection, nil
+}
 
-	return func(references []model.Reference, limit int) *genmodel.EntitiesConnection {
-		var connection genmodel.EntitiesConnection
+func (t *Term) Superterms(ctx context.Context, obj *apimodel.Term, first int64, after *string) (*genmodel.TermsConnection, error) { //nolint:lll
+	return t.related(ctx, &model.TermFilter{SubID: []uint64{obj.ID}}, first, after)
+}
 
-		if len(references) == 0 {
-			return nil
-		}
+func (t *Term) Subterms(ctx context.Context, obj *apimodel.Term, first int64, after *string) (*genmodel.TermsConnection, error) { //nolint:lll
+	return t.related(ctx, &model.TermFilter{SuperID: []uint64{obj.ID}}, first, after)
+}
 
-		if len(references) > limit {
-			references = references[:limit]
-		}
+func (t *Term) Ancestors(ctx context.Context, obj *apimodel.Term) ([]apimodel.Term, error) {
+	terms, err := t.termService.Ancestors(ctx, obj.ID)
+	if err != nil {
+		return nil, toError(err)
+	}
 
-		connection.Edges = make([]genmodel.EntitiesEdge, len(references))
-		for i, reference := range references {
-			connection.Edges[i] = genmodel.EntitiesEdge{
-				Cursor: cursor.Marshal(reference.ID),
-				Node: &genmodel.EntityNode{
-					Namespace: namespaces[reference.NamespaceID],
-					ID:        int64(reference.EntityID),
-				},
-			}
-		}
+	return convert(terms, term2gen), nil
+}
 
-		connection.PageInfo = genmodel.PageInfo{
-			StartCursor: connection.Edges[0].Cursor,
-			EndCursor:   connection.Edges[len(connection.Edges)-1].Cursor,
-			HasNextPage: pointer.ToBool(len(references) > limit),
+func (t *Term) Descendants(ctx context.Context, obj *apimodel.Term, d *int64) ([]apimodel.Term, error) {
+	terms, err := t.termService.Descendants(ctx, obj.ID, depth(d))
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return convert(terms, term2gen), nil
+}
+
+func (t *Term) Paths(ctx context.Context, obj *apimodel.Term) ([][]apimodel.Term, error) {
+	paths, err := t.termService.Paths(ctx, obj.ID)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	converted := make([][]apimodel.Term, len(paths))
+	for i, path := range paths {
+		converted[i] = convert(path, term2gen)
+	}
+
+	return converted, nil
+}
+
+func (t *Term) related(ctx context.Context, filter *model.TermFilter, first int64, after *string) (*genmodel.TermsConnection, error) { //nolint:lll
+	afterID, err := afterID(after)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	filter.AfterID = afterID
+	filter.Limit = limit(first)
+
+	terms, err := t.termService.Get(ctx, filter)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return termsConnection(terms, first), nil
+}
+
+func (t *Term) Title(ctx context.Context, obj *apimodel.Term, locale *string) (*string, error) {
+	return pointer.ToString(obj.Labels.Title(chain(ctx, locale), pointer.GetString(obj.Title))), nil
+}
+
+func (t *Term) Description(ctx context.Context, obj *apimodel.Term, locale *string) (*string, error) {
+	return pointer.ToString(obj.Labels.Description(chain(ctx, locale), pointer.GetString(obj.Description))), nil
+}
+
+func (t *Term) Labels(_ context.Context, obj *apimodel.Term) ([]genmodel.Label, error) {
+	return labels2gen(obj.Labels), nil
+}
+
+func (t *Term) Synonyms(_ context.Context, obj *apimodel.Term, locale *string, withHidden bool,
+) ([]genmodel.Synonym, error) {
+	var (
+		locales  []string
+		synonyms = make([]genmodel.Synonym, 0, len(obj.Synonyms))
+	)
+
+	// Synonyms of parent locales fit the locale, fallback chains aren't used
+	if locale != nil {
+		locales = (*model.Fallback)(nil).Chain(*locale)
+	}
+
+	for _, synonym := range obj.Synonyms {
+		if synonym.Hidden && !withHidden ||
+			locale != nil && synonym.Locale != `` && !slices.Contains(locales, synonym.Locale) {
+			continue
 		}
 
-		return &connection
-	}(references, int(first)), nil
+		synonyms = append(synonyms, genmodel.Synonym{
+			Name:   synonym.Name,
+			Locale: pointer.ToStringOrNil(synonym.Locale),
+			Hidden: synonym.Hidden,
+		})
+	}
+
+	return synonyms, nil
 }
@@ -0,0 +1,570 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/AlekSi/pointer"
+	"github.com/dmalykh/taxonomy/api/graphql/generated"
+	"github.com/dmalykh/taxonomy/api/graphql/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutation_DeleteTerm(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		code  string
+		count float64
+	}{
+		{
+			name: `ok`,
+		},
+		{
+			name: `references exist`,
+			err: fmt.Errorf(`can't remove term %d: %w`, 42,
+				&taxonomy.BlockedError{Err: taxonomy.ErrReferenceExists, Count: 12}),
+			code:  `REFERENCE_EXISTS`,
+			count: 12,
+		},
+		{
+			name: `not found`,
+			err:  fmt.Errorf(`%w, got %d results`, taxonomy.ErrTermNotFound, 0),
+			code: `TERM_NOT_FOUND`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termService := mock.Mock[taxonomy.Term]()
+			mock.When(termService.Delete(mock.Any[context.Context](), mock.Equal[uint64](42))).
+				ThenReturn(tt.err)
+
+			c := newClient(&services{term: termService})
+
+			var resp struct {
+				DeleteTerm bool
+			}
+			err := c.Post(`mutation { deleteTerm(id: 42) }`, &resp)
+
+			if tt.err == nil {
+				require.NoError(t, err)
+				assert.True(t, resp.DeleteTerm)
+
+				return
+			}
+
+			errs := gqlErrors(t, err)
+			require.Len(t, errs, 1)
+			assert.Equal(t, tt.code, errs[0].Extensions[`code`])
+
+			if tt.count > 0 {
+				assert.Equal(t, tt.count, errs[0].Extensions[`count`])
+			} else {
+				assert.NotContains(t, errs[0].Extensions, `count`)
+			}
+		})
+	}
+}
+
+func TestTerm_Subterms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](1))).
+		ThenReturn(&model.Term{ID: 1, Data: model.TermData{Name: `animal`}}, nil)
+
+	filter := mock.Captor[*model.TermFilter]()
+	mock.When(termService.Get(mock.Any[context.Context](), filter.Capture())).
+		ThenReturn([]*model.Term{
+			{ID: 2, Data: model.TermData{Name: `mammal`}},
+			{ID: 3, Data: model.TermData{Name: `bird`}},
+		}, nil)
+
+	c := newClient(&services{term: termService})
+
+	var resp struct {
+		Term struct {
+			Subterms struct {
+				Edges []struct {
+					Node struct {
+						Name string
+					}
+				}
+				PageInfo struct {
+					HasNextPage bool
+				}
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ term(id: 1) { subterms(first: 1) { edges { node { name } } pageInfo { hasNextPage } } } }`, &resp))
+
+	require.Len(t, resp.Term.Subterms.Edges, 1)
+	assert.Equal(t, `mammal`, resp.Term.Subterms.Edges[0].Node.Name)
+	assert.True(t, resp.Term.Subterms.PageInfo.HasNextPage)
+	assert.Equal(t, []uint64{1}, filter.Last().SuperID)
+	assert.Empty(t, filter.Last().SubID)
+}
+
+func TestTerm_EntitiesWithSubterms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](1))).
+		ThenReturn(&model.Term{ID: 1, Data: model.TermData{Name: `laptop`}}, nil)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	filter := mock.Captor[*model.ReferenceFilter]()
+	mock.When(referenceService.Get(mock.Any[context.Context](), filter.Capture())).
+		ThenReturn([]*model.Reference{
+			{ID: 10, TermID: 1, Namespace: `products`, EntityID: `plain`},
+			{ID: 11, TermID: 2, Namespace: `products`, EntityID: `gaming`},
+		}, nil)
+
+	c := newClient(&services{term: termService, reference: referenceService})
+
+	var resp struct {
+		Term struct {
+			Entities struct {
+				Edges []struct {
+					Node struct {
+						ID string
+					}
+				}
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ term(id: 1) { entities(namespace: ["products"], excludeTermId: [[5]], withSubterms: true) { edges { node { id } } } } }`, &resp)) //nolint:lll
+
+	require.Len(t, resp.Term.Entities.Edges, 2)
+	assert.Equal(t, `gaming`, resp.Term.Entities.Edges[1].Node.ID)
+	assert.Equal(t, [][]uint64{{1}}, filter.Last().TermID)
+	assert.Equal(t, [][]uint64{{5}}, filter.Last().ExcludeTermID)
+	assert.True(t, filter.Last().WithSubterms)
+	assert.False(t, filter.Last().WithVocabularyDescendants)
+}
+
+func TestMutation_LinkTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.Link(mock.Any[context.Context](), mock.Equal[uint64](1), mock.Equal[uint64](2))).
+		ThenReturn(nil)
+	mock.When(termService.Unlink(mock.Any[context.Context](), mock.Equal[uint64](1), mock.Equal[uint64](3))).
+		ThenReturn(fmt.Errorf(`%w 3`, taxonomy.ErrTermNotFound))
+
+	c := newClient(&services{term: termService})
+
+	var resp struct {
+		LinkTerms bool
+	}
+	require.NoError(t, c.Post(`mutation { linkTerms(superId: 1, subId: 2) }`, &resp))
+	assert.True(t, resp.LinkTerms)
+
+	errs := gqlErrors(t, c.Post(`mutation { unlinkTerms(superId: 1, subId: 3) }`, &struct{}{}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, `TERM_NOT_FOUND`, errs[0].Extensions[`code`])
+}
+
+func TestMutation_CreateTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.CreateBulk(mock.Any[context.Context](), mock.Any[[]*model.TermData]()...)).
+		ThenAnswer(func(args []any) []any {
+			data := args[1].([]*model.TermData)
+			if len(data) > 1 {
+				return []any{nil, &taxonomy.BulkError{Errors: map[int]error{
+					1: fmt.Errorf(`%w: %s in vocabulary %d`, taxonomy.ErrTermNotUnique, data[1].Name, 1),
+				}}}
+			}
+
+			return []any{[]*model.Term{{ID: 7, Data: *data[0]}}, nil}
+		})
+
+	c := newClient(&services{term: termService})
+
+	var resp struct {
+		CreateTerms []struct {
+			ID   uint64
+			Name string
+		}
+	}
+	require.NoError(t, c.Post(`mutation { createTerms(input: [{name: "red", title: "", vocabularyId: [1]}]) { id name } }`,
+		&resp))
+	require.Len(t, resp.CreateTerms, 1)
+	assert.Equal(t, uint64(7), resp.CreateTerms[0].ID)
+
+	errs := gqlErrors(t, c.Post(`mutation { createTerms(input: [
+		{name: "red", title: "", vocabularyId: [1]},
+		{name: "green", title: "", vocabularyId: [1]}
+	]) { id } }`, &struct{}{}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, `BULK_FAILED`, errs[0].Extensions[`code`])
+
+	items, ok := errs[0].Extensions[`items`].([]any)
+	require.True(t, ok)
+	require.Len(t, items, 1)
+	assert.Equal(t, 1.0, items[0].(map[string]any)[`index`])
+	assert.Equal(t, `NOT_UNIQUE_NAME`, items[0].(map[string]any)[`code`])
+}
+
+func TestMutation_LinkTermsCycle(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.Link(mock.Any[context.Context](), mock.Equal[uint64](3), mock.Equal[uint64](1))).
+		ThenReturn(&taxonomy.HierarchyError{Err: taxonomy.ErrHierarchyCycle, Path: []uint64{3, 1, 2, 3}})
+
+	c := newClient(&services{term: termService})
+
+	errs := gqlErrors(t, c.Post(`mutation { linkTerms(superId: 3, subId: 1) }`, &struct{}{}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, `HIERARCHY_CYCLE`, errs[0].Extensions[`code`])
+	assert.Equal(t, []any{3.0, 1.0, 2.0, 3.0}, errs[0].Extensions[`path`])
+}
+
+func TestTerm_Paths(t *testing.T) {
+	mock.SetUp(t)
+
+	trm := func(id uint64) *model.Term {
+		return &model.Term{ID: id, Data: model.TermData{Name: fmt.Sprint(`term`, id)}}
+	}
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](4))).
+		ThenReturn(trm(4), nil)
+	mock.When(termService.Paths(mock.Any[context.Context](), mock.Equal[uint64](4))).
+		ThenReturn([][]*model.Term{{trm(1), trm(2), trm(4)}, {trm(1), trm(3), trm(4)}}, nil)
+
+	c := newClient(&services{term: termService})
+
+	var resp struct {
+		Term struct {
+			Paths [][]struct {
+				Name string
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ term(id: 4) { paths { name } } }`, &resp))
+
+	require.Len(t, resp.Term.Paths, 2)
+	assert.Equal(t, `term3`, resp.Term.Paths[1][1].Name)
+}
+
+func TestTerm_Title(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](1))).
+		ThenReturn(&model.Term{ID: 1, Data: model.TermData{Name: `red`, Title: `Red`, Description: `Color`,
+			Labels: model.Labels{`de`: {Title: `Rot`}, `de-CH`: {Description: `Farbe`}, `fr`: {Title: `Rouge`}}}}, nil)
+
+	c := client.New(service.Localize(&model.Fallback{Default: []string{`fr`}},
+		handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+			Resolvers: service.NewResolver(termService, nil, nil, nil, nil),
+		}))))
+
+	var resp struct {
+		Term struct {
+			Title       string
+			Description string
+			German      string
+			Unknown     string
+			Labels      []struct {
+				Locale string
+				Title  *string
+			}
+		}
+	}
+
+	require.NoError(t, c.Post(`{ term(id: 1) {
+		title description german: title(locale: "de") unknown: description(locale: "es") labels { locale title }
+	} }`, &resp, client.AddHeader(`Accept-Language`, `de-CH, en;q=0.8`)))
+
+	assert.Equal(t, `Rot`, resp.Term.Title)
+	assert.Equal(t, `Farbe`, resp.Term.Description)
+	assert.Equal(t, `Rot`, resp.Term.German)
+	assert.Equal(t, `Color`, resp.Term.Unknown)
+	require.Len(t, resp.Term.Labels, 3)
+	assert.Equal(t, `de`, resp.Term.Labels[0].Locale)
+	assert.Nil(t, resp.Term.Labels[1].Title)
+
+	require.NoError(t, c.Post(`{ term(id: 1) { title } }`, &resp))
+	assert.Equal(t, `Rouge`, resp.Term.Title)
+}
+
+func TestTerm_Synonyms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](1))).
+		ThenReturn(&model.Term{ID: 1, Data: model.TermData{Name: `red`, Synonyms: []model.Synonym{
+			{Name: `scarlet`}, {Name: `rot`, Locale: `de`}, {Name: `rouge`, Locale: `fr`}, {Name: `rde`, Hidden: true},
+		}}}, nil)
+
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: service.NewResolver(termService, nil, nil, nil, nil),
+	})))
+
+	type synonym struct {
+		Name   string
+		Locale *string
+	}
+
+	var resp struct {
+		Term struct {
+			Synonyms []synonym
+			Swiss    []synonym
+			All      []synonym
+		}
+	}
+
+	require.NoError(t, c.Post(`{ term(id: 1) {
+		synonyms { name locale } swiss: synonyms(locale: "de-CH") { name locale } all: synonyms(withHidden: true) { name }
+	} }`, &resp))
+
+	assert.Len(t, resp.Term.Synonyms, 3)
+	assert.Equal(t, []synonym{{Name: `scarlet`}, {Name: `rot`, Locale: pointer.ToString(`de`)}}, resp.Term.Swiss)
+	assert.Len(t, resp.Term.All, 4)
+}
+
+func TestQuery_SearchTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	search := mock.Captor[*model.TermSearch]()
+	mock.When(termService.Search(mock.Any[context.Context](), search.Capture())).
+		ThenReturn([]*model.TermMatch{
+			{Term: &model.Term{ID: 3, Data: model.TermData{Name: `reddish`}}, Score: 0.5, Field: model.SearchName},
+		}, nil)
+
+	c := newClient(&services{term: termService})
+
+	var resp struct {
+		SearchTerms []struct {
+			Term struct {
+				Name string
+			}
+			Score float64
+			Field string
+		}
+	}
+	require.NoError(t, c.Post(`{ searchTerms(query: "redish", mode: FUZZY, vocabularyId: [7], first: 5) { term { name } score field } }`, &resp)) //nolint:lll
+
+	require.Len(t, resp.SearchTerms, 1)
+	assert.Equal(t, `reddish`, resp.SearchTerms[0].Term.Name)
+	assert.Equal(t, 0.5, resp.SearchTerms[0].Score)
+	assert.Equal(t, `NAME`, resp.SearchTerms[0].Field)
+	assert.Equal(t, &model.TermSearch{Query: `redish`, Mode: model.SearchFuzzy, VocabularyID: []uint64{7}, Limit: 5},
+		search.Last())
+}
+
+func TestQuery_SuggestTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	suggester := mock.Mock[taxonomy.Suggester]()
+	suggest := mock.Captor[*model.TermSuggest]()
+	mock.When(suggester.Suggest(mock.Any[context.Context](), suggest.Capture())).
+		ThenReturn([]*model.TermSuggestion{
+			{Term: &model.Term{ID: 3, Data: model.TermData{Name: `reddish`}}, Text: `rosy`, Field: model.SearchSynonym,
+				Popularity: 12},
+		}, nil)
+
+	c := newClient(&services{suggester: suggester})
+
+	var resp struct {
+		SuggestTerms []struct {
+			Term struct {
+				Name string
+			}
+			Text       string
+			Field      string
+			Popularity int
+		}
+	}
+	require.NoError(t, c.Post(`{ suggestTerms(prefix: "ro", namespace: "products") { term { name } text field popularity } }`, &resp)) //nolint:lll
+
+	require.Len(t, resp.SuggestTerms, 1)
+	assert.Equal(t, `reddish`, resp.SuggestTerms[0].Term.Name)
+	assert.Equal(t, `rosy`, resp.SuggestTerms[0].Text)
+	assert.Equal(t, `SYNONYM`, resp.SuggestTerms[0].Field)
+	assert.Equal(t, 12, resp.SuggestTerms[0].Popularity)
+	assert.Equal(t, &model.TermSuggest{Prefix: `ro`, Namespace: `products`, Limit: 10}, suggest.Last())
+}
+
+func TestQuery_TagText(t *testing.T) {
+	mock.SetUp(t)
+
+	suggester := mock.Mock[taxonomy.Suggester]()
+	tagging := mock.Captor[*model.TextTagging]()
+	mock.When(suggester.Tag(mock.Any[context.Context](), tagging.Capture())).
+		ThenReturn([]*model.TextTag{
+			{Term: &model.Term{ID: 2, Data: model.TermData{Name: `dark-red`}}, Text: `Dark red`, Start: 4, End: 12,
+				Field: model.SearchName},
+		}, nil)
+
+	c := newClient(&services{suggester: suggester})
+
+	var resp struct {
+		TagText []struct {
+			Term struct {
+				Name string
+			}
+			Text  string
+			Start int
+			End   int
+			Field string
+		}
+	}
+	require.NoError(t, c.Post(`{ tagText(text: "Rug dark red", vocabularyId: [1]) { term { name } text start end field } }`, &resp)) //nolint:lll
+
+	require.Len(t, resp.TagText, 1)
+	assert.Equal(t, `dark-red`, resp.TagText[0].Term.Name)
+	assert.Equal(t, `Dark red`, resp.TagText[0].Text)
+	assert.Equal(t, 4, resp.TagText[0].Start)
+	assert.Equal(t, 12, resp.TagText[0].End)
+	assert.Equal(t, `NAME`, resp.TagText[0].Field)
+	assert.Equal(t, &model.TextTagging{Text: `Rug dark red`, VocabularyID: []uint64{1}}, tagging.Last())
+}
+
+func TestMutation_TagEntity(t *testing.T) {
+	mock.SetUp(t)
+
+	suggester := mock.Mock[taxonomy.Suggester]()
+	tagging := mock.Captor[*model.TextTagging]()
+	mock.When(suggester.Tag(mock.Any[context.Context](), tagging.Capture())).
+		ThenReturn([]*model.TextTag{
+			{Term: &model.Term{ID: 1, Data: model.TermData{Name: `red`}}, Text: `red`, End: 3, Field: model.SearchName},
+		}, nil)
+
+	c := newClient(&services{suggester: suggester})
+
+	var resp struct {
+		TagEntity []struct {
+			Text string
+		}
+	}
+	require.NoError(t, c.Post(`mutation { tagEntity(namespace: "products", entityId: "42", text: "red rug") { text } }`, &resp)) //nolint:lll
+
+	require.Len(t, resp.TagEntity, 1)
+	assert.Equal(t, &model.TextTagging{
+		Text:      `red rug`,
+		Reference: &model.TagReference{Namespace: `products`, EntityID: `42`},
+	}, tagging.Last())
+}
+
+func TestQuery_TermsExcluded(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	facets := mock.Captor[*model.ReferenceFilter]()
+	mock.When(referenceService.Facets(mock.Any[context.Context](), facets.Capture())).
+		ThenReturn(&model.Facets{Terms: []*model.TermFacet{{TermID: 2, Count: 5}, {TermID: 3, Count: 0}}}, nil)
+
+	termService := mock.Mock[taxonomy.Term]()
+	filter := mock.Captor[*model.TermFilter]()
+	mock.When(termService.Get(mock.Any[context.Context](), filter.Capture())).
+		ThenReturn([]*model.Term{{ID: 2, Data: model.TermData{Name: `apple`}}}, nil)
+
+	c := newClient(&services{term: termService, reference: referenceService})
+
+	var resp struct {
+		Terms struct {
+			Edges []struct {
+				Node struct {
+					Name string
+				}
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ terms(filter: {namespace: "laptops", excludeTermId: [[5]], vocabularyId: [1]}) { edges { node { name } } } }`, &resp)) //nolint:lll
+
+	require.Len(t, resp.Terms.Edges, 1)
+	assert.Equal(t, `apple`, resp.Terms.Edges[0].Node.Name)
+	assert.Equal(t, &model.ReferenceFilter{Namespace: []string{`laptops`}, ExcludeTermID: [][]uint64{{5}}},
+		facets.Last())
+	assert.Equal(t, []uint64{2}, filter.Last().ID)
+	assert.Equal(t, []uint64{1}, filter.Last().VocabularyID)
+}
+
+func TestQuery_TermsExcludedAll(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Facets(mock.Any[context.Context](), mock.Any[*model.ReferenceFilter]())).
+		ThenReturn(&model.Facets{}, nil)
+
+	// Terms aren't requested when every entity is excluded
+	c := newClient(&services{term: mock.Mock[taxonomy.Term](), reference: referenceService})
+
+	var resp struct {
+		Terms *struct {
+			Edges []struct{}
+		}
+	}
+	require.NoError(t, c.Post(`{ terms(filter: {namespace: "laptops", excludeTermId: [[5]]}) { edges { cursor } } }`, &resp)) //nolint:lll
+	assert.Nil(t, resp.Terms)
+}
+
+func TestTerm_DeprecatedFields(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	facets := mock.Captor[*model.ReferenceFilter]()
+	mock.When(referenceService.Facets(mock.Any[context.Context](), facets.Capture())).
+		ThenReturn(&model.Facets{Terms: []*model.TermFacet{{TermID: 2, Count: 1}}}, nil)
+
+	termService := mock.Mock[taxonomy.Term]()
+	filter := mock.Captor[*model.TermFilter]()
+	mock.When(termService.Get(mock.Any[context.Context](), filter.Capture())).
+		ThenReturn([]*model.Term{{ID: 2, Data: model.TermData{Name: `apple`, VocabularyID: []uint64{1, 3}}}}, nil)
+	data := mock.Captor[*model.TermData]()
+	mock.When(termService.Create(mock.Any[context.Context](), data.Capture())).
+		ThenReturn(&model.Term{ID: 7, Data: model.TermData{Name: `red`, VocabularyID: []uint64{1}}}, nil)
+
+	vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+	mock.When(vocabularyService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](1))).
+		ThenReturn(&model.Vocabulary{ID: 1, Data: model.VocabularyData{Name: `fruits`}}, nil)
+
+	c := newClient(&services{term: termService, vocabulary: vocabularyService, reference: referenceService})
+
+	// Single vocabularyId is taken as a list of one id, vocabulary returns the first vocabulary
+	var resp struct {
+		Terms struct {
+			Edges []struct {
+				Node struct {
+					Name       string
+					Vocabulary struct {
+						Name string
+					}
+				}
+			}
+		}
+		CreateTerm struct {
+			ID uint64
+		}
+	}
+	require.NoError(t, c.Post(`{ terms(filter: {namespace: "laptops", entityId: ["dell"], vocabularyId: 1}) {
+		edges { node { name vocabulary { name } } } } }`, &resp))
+
+	require.Len(t, resp.Terms.Edges, 1)
+	assert.Equal(t, `fruits`, resp.Terms.Edges[0].Node.Vocabulary.Name)
+	assert.Equal(t, &model.ReferenceFilter{Namespace: []string{`laptops`}, EntityID: []model.EntityID{`dell`}},
+		facets.Last())
+	assert.Equal(t, []uint64{2}, filter.Last().ID)
+	assert.Equal(t, []uint64{1}, filter.Last().VocabularyID)
+
+	require.NoError(t, c.Post(`mutation { createTerm(input: {name: "red", title: "", vocabularyId: 1}) { id } }`, &resp))
+	assert.Equal(t, uint64(7), resp.CreateTerm.ID)
+	assert.Equal(t, []uint64{1}, data.Last().VocabularyID)
+}
@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutation_DeleteVocabulary(t *testing.T) {
+	mock.SetUp(t)
+
+	vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+	mock.When(vocabularyService.Delete(mock.Any[context.Context](), mock.Equal[uint64](3))).
+		ThenReturn(fmt.Errorf(`can't remove vocabulary %d: %w`, 3,
+			&taxonomy.BlockedError{Err: taxonomy.ErrVocabularyHasTerms, Count: 7}))
+
+	c := newClient(&services{vocabulary: vocabularyService})
+
+	var resp map[string]any
+	errs := gqlErrors(t, c.Post(`mutation { deleteVocabulary(id: 3) }`, &resp))
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, `VOCABULARY_HAS_TERMS`, errs[0].Extensions[`code`])
+	assert.Equal(t, float64(7), errs[0].Extensions[`count`])
+}
+
+func TestVocabulary_PathDescendants(t *testing.T) {
+	mock.SetUp(t)
+
+	vocabulary := func(id uint64, name string) *model.Vocabulary {
+		return &model.Vocabulary{ID: id, Data: model.VocabularyData{Name: name}}
+	}
+
+	vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+	mock.When(vocabularyService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](2))).
+		ThenReturn(vocabulary(2, `Computers`), nil)
+	mock.When(vocabularyService.Path(mock.Any[context.Context](), mock.Equal[uint64](2))).
+		ThenReturn([]*model.Vocabulary{vocabulary(1, `Electronics`), vocabulary(2, `Computers`)}, nil)
+	mock.When(vocabularyService.Descendants(mock.Any[context.Context](), mock.Equal[uint64](2), mock.Equal[uint](1))).
+		ThenReturn([]*model.Vocabulary{vocabulary(3, `Laptops`)}, nil)
+
+	c := newClient(&services{vocabulary: vocabularyService})
+
+	var resp struct {
+		Vocabulary struct {
+			Path []struct {
+				Name string
+			}
+			Descendants []struct {
+				ID int
+			}
+		}
+	}
+	require.NoError(t, c.Post(`{ vocabulary(id: 2) { path { name } descendants(depth: 1) { id } } }`, &resp))
+
+	require.Len(t, resp.Vocabulary.Path, 2)
+	assert.Equal(t, `Electronics`, resp.Vocabulary.Path[0].Name)
+	assert.Equal(t, `Computers`, resp.Vocabulary.Path[1].Name)
+	require.Len(t, resp.Vocabulary.Descendants, 1)
+	assert.Equal(t, 3, resp.Vocabulary.Descendants[0].ID)
+}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: namespace.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NamespaceService_Create_FullMethodName    = "/taxonomy.NamespaceService/Create"
+	NamespaceService_Update_FullMethodName    = "/taxonomy.NamespaceService/Update"
+	NamespaceService_Delete_FullMethodName    = "/taxonomy.NamespaceService/Delete"
+	NamespaceService_GetByName_FullMethodName = "/taxonomy.NamespaceService/GetByName"
+	NamespaceService_Get_FullMethodName       = "/taxonomy.NamespaceService/Get"
+)
+
+// NamespaceServiceClient is the client API for NamespaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NamespaceServiceClient interface {
+	// Create creates namespace, name must be unique
+	Create(ctx context.Context, in *NamespaceCreateRequest, opts ...grpc.CallOption) (*Namespace, error)
+	Update(ctx context.Context, in *NamespaceUpdateRequest, opts ...grpc.CallOption) (*Namespace, error)
+	// Delete removes namespace, it fails when namespace has references
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	GetByName(ctx context.Context, in *NamespaceNameRequest, opts ...grpc.CallOption) (*Namespace, error)
+	// Get returns list of namespaces. Only cursor pagination (after_id) is supported, offset is ignored.
+	Get(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*NamespacesResponse, error)
+}
+
+type namespaceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNamespaceServiceClient(cc grpc.ClientConnInterface) NamespaceServiceClient {
+	return &namespaceServiceClient{cc}
+}
+
+func (c *namespaceServiceClient) Create(ctx context.Context, in *NamespaceCreateRequest, opts ...grpc.CallOption) (*Namespace, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Namespace)
+	err := c.cc.Invoke(ctx, NamespaceService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Update(ctx context.Context, in *NamespaceUpdateRequest, opts ...grpc.CallOption) (*Namespace, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Namespace)
+	err := c.cc.Invoke(ctx, NamespaceService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, NamespaceService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) GetByName(ctx context.Context, in *NamespaceNameRequest, opts ...grpc.CallOption) (*Namespace, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Namespace)
+	err := c.cc.Invoke(ctx, NamespaceService_GetByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Get(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*NamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespacesResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NamespaceServiceServer is the server API for NamespaceService service.
+// All implementations must embed UnimplementedNamespaceServiceServer
+// for forward compatibility.
+type NamespaceServiceServer interface {
+	// Create creates namespace, name must be unique
+	Create(context.Context, *NamespaceCreateRequest) (*Namespace, error)
+	Update(context.Context, *NamespaceUpdateRequest) (*Namespace, error)
+	// Delete removes namespace, it fails when namespace has references
+	Delete(context.Context, *IdRequest) (*wrapperspb.BoolValue, error)
+	GetByName(context.Context, *NamespaceNameRequest) (*Namespace, error)
+	// Get returns list of namespaces. Only cursor pagination (after_id) is supported, offset is ignored.
+	Get(context.Context, *Pagination) (*NamespacesResponse, error)
+	mustEmbedUnimplementedNamespaceServiceServer()
+}
+
+// UnimplementedNamespaceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNamespaceServiceServer struct{}
+
+func (UnimplementedNamespaceServiceServer) Create(context.Context, *NamespaceCreateRequest) (*Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedNamespaceServiceServer) Update(context.Context, *NamespaceUpdateRequest) (*Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedNamespaceServiceServer) Delete(context.Context, *IdRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedNamespaceServiceServer) GetByName(context.Context, *NamespaceNameRequest) (*Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByName not implemented")
+}
+func (UnimplementedNamespaceServiceServer) Get(context.Context, *Pagination) (*NamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedNamespaceServiceServer) mustEmbedUnimplementedNamespaceServiceServer() {}
+func (UnimplementedNamespaceServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeNamespaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NamespaceServiceServer will
+// result in compilation errors.
+type UnsafeNamespaceServiceServer interface {
+	mustEmbedUnimplementedNamespaceServiceServer()
+}
+
+func RegisterNamespaceServiceServer(s grpc.ServiceRegistrar, srv NamespaceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNamespaceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NamespaceService_ServiceDesc, srv)
+}
+
+func _NamespaceService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Create(ctx, req.(*NamespaceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Update(ctx, req.(*NamespaceUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_GetByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).GetByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_GetByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).GetByName(ctx, req.(*NamespaceNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Get(ctx, req.(*Pagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NamespaceService_ServiceDesc is the grpc.ServiceDesc for NamespaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NamespaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taxonomy.NamespaceService",
+	HandlerType: (*NamespaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NamespaceService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NamespaceService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NamespaceService_Delete_Handler,
+		},
+		{
+			MethodName: "GetByName",
+			Handler:    _NamespaceService_GetByName_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NamespaceService_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "namespace.proto",
+}
@@ -0,0 +1,822 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.3
+// source: reference.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TermId    int64  `protobuf:"varint,2,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	EntityId  string `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Reference) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reference) GetTermId() int64 {
+	if x != nil {
+		return x.TermId
+	}
+	return 0
+}
+
+func (x *Reference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Reference) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type ReferenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermId    int64    `protobuf:"varint,1,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	Namespace string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	EntityId  []string `protobuf:"bytes,3,rep,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (x *ReferenceRequest) Reset() {
+	*x = ReferenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceRequest) ProtoMessage() {}
+
+func (x *ReferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceRequest.ProtoReflect.Descriptor instead.
+func (*ReferenceRequest) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReferenceRequest) GetTermId() int64 {
+	if x != nil {
+		return x.TermId
+	}
+	return 0
+}
+
+func (x *ReferenceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReferenceRequest) GetEntityId() []string {
+	if x != nil {
+		return x.EntityId
+	}
+	return nil
+}
+
+// TermGroup contains terms joined with "OR" operand.
+type TermGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermId []int64 `protobuf:"varint,1,rep,packed,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+}
+
+func (x *TermGroup) Reset() {
+	*x = TermGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermGroup) ProtoMessage() {}
+
+func (x *TermGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermGroup.ProtoReflect.Descriptor instead.
+func (*TermGroup) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TermGroup) GetTermId() []int64 {
+	if x != nil {
+		return x.TermId
+	}
+	return nil
+}
+
+// ReferenceFilterRequest is used to find references.
+// All terms inside TermGroup use "OR" operand, between groups "AND" operand used. I.e. to receive laptops with
+// 512 or 1024 RAM and OLED or IPS matrix specify groups [{term_id: [512, 1024]}, {term_id: [OLED, IPS]}].
+type ReferenceFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermGroup []*TermGroup `protobuf:"bytes,1,rep,name=term_group,json=termGroup,proto3" json:"term_group,omitempty"`
+	// At least one namespace required
+	Namespace []string `protobuf:"bytes,2,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	EntityId  []string `protobuf:"bytes,3,rep,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Only cursor pagination (after_id) is supported, offset is ignored.
+	Pagination *Pagination `protobuf:"bytes,20,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ReferenceFilterRequest) Reset() {
+	*x = ReferenceFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceFilterRequest) ProtoMessage() {}
+
+func (x *ReferenceFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceFilterRequest.ProtoReflect.Descriptor instead.
+func (*ReferenceFilterRequest) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReferenceFilterRequest) GetTermGroup() []*TermGroup {
+	if x != nil {
+		return x.TermGroup
+	}
+	return nil
+}
+
+func (x *ReferenceFilterRequest) GetNamespace() []string {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *ReferenceFilterRequest) GetEntityId() []string {
+	if x != nil {
+		return x.EntityId
+	}
+	return nil
+}
+
+func (x *ReferenceFilterRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// ReferenceExportRequest is used to stream all references matching the filter.
+type ReferenceExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermGroup []*TermGroup `protobuf:"bytes,1,rep,name=term_group,json=termGroup,proto3" json:"term_group,omitempty"`
+	// At least one namespace required
+	Namespace []string `protobuf:"bytes,2,rep,name=namespace,proto3" json:"namespace,omitempty"`
+	EntityId  []string `protobuf:"bytes,3,rep,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Id of the last received reference, used to resume broken export
+	AfterId *int64 `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
+	// Count of references read from storage at once, 500 by default and 5000 at most
+	BatchSize uint32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *ReferenceExportRequest) Reset() {
+	*x = ReferenceExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceExportRequest) ProtoMessage() {}
+
+func (x *ReferenceExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceExportRequest.ProtoReflect.Descriptor instead.
+func (*ReferenceExportRequest) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReferenceExportRequest) GetTermGroup() []*TermGroup {
+	if x != nil {
+		return x.TermGroup
+	}
+	return nil
+}
+
+func (x *ReferenceExportRequest) GetNamespace() []string {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *ReferenceExportRequest) GetEntityId() []string {
+	if x != nil {
+		return x.EntityId
+	}
+	return nil
+}
+
+func (x *ReferenceExportRequest) GetAfterId() int64 {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return 0
+}
+
+func (x *ReferenceExportRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// ReplaceRequest contains all terms of the entity, terms which aren't listed are unlinked from the entity.
+type ReplaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string  `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	EntityId  string  `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	TermId    []int64 `protobuf:"varint,3,rep,packed,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	// Only terms of the vocabulary are replaced, terms of other vocabularies fail with InvalidArgument code
+	VocabularyId *int64 `protobuf:"varint,4,opt,name=vocabulary_id,json=vocabularyId,proto3,oneof" json:"vocabulary_id,omitempty"`
+}
+
+func (x *ReplaceRequest) Reset() {
+	*x = ReplaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceRequest) ProtoMessage() {}
+
+func (x *ReplaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceRequest) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplaceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReplaceRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ReplaceRequest) GetTermId() []int64 {
+	if x != nil {
+		return x.TermId
+	}
+	return nil
+}
+
+func (x *ReplaceRequest) GetVocabularyId() int64 {
+	if x != nil && x.VocabularyId != nil {
+		return *x.VocabularyId
+	}
+	return 0
+}
+
+// ReplaceResponse contains ids of terms which were linked with and unlinked from the entity.
+type ReplaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added   []int64 `protobuf:"varint,1,rep,packed,name=added,proto3" json:"added,omitempty"`
+	Removed []int64 `protobuf:"varint,2,rep,packed,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *ReplaceResponse) Reset() {
+	*x = ReplaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceResponse) ProtoMessage() {}
+
+func (x *ReplaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceResponse) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReplaceResponse) GetAdded() []int64 {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ReplaceResponse) GetRemoved() []int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+// Response with references and information about pagination
+type ReferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	References []*Reference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	Pagination *PageInfo    `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ReferencesResponse) Reset() {
+	*x = ReferencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_reference_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencesResponse) ProtoMessage() {}
+
+func (x *ReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reference_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencesResponse.ProtoReflect.Descriptor instead.
+func (*ReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_reference_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReferencesResponse) GetReferences() []*Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *ReferencesResponse) GetPagination() *PageInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_reference_proto protoreflect.FileDescriptor
+
+var file_reference_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x08, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x1a, 0x0c, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x09, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x10, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x22, 0x24, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x06, 0x74, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x09, 0x74, 0x65,
+	0x72, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x09, 0x74, 0x65,
+	0x72, 0x6d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x61, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xa0,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x74,
+	0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x22, 0x41, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x32, 0xe0, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x03,
+	0x47, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x2e,
+	0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x30, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6d, 0x61, 0x6c, 0x79, 0x6b, 0x68, 0x2f, 0x74, 0x61, 0x78,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_reference_proto_rawDescOnce sync.Once
+	file_reference_proto_rawDescData = file_reference_proto_rawDesc
+)
+
+func file_reference_proto_rawDescGZIP() []byte {
+	file_reference_proto_rawDescOnce.Do(func() {
+		file_reference_proto_rawDescData = protoimpl.X.CompressGZIP(file_reference_proto_rawDescData)
+	})
+	return file_reference_proto_rawDescData
+}
+
+var file_reference_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_reference_proto_goTypes = []any{
+	(*Reference)(nil),              // 0: taxonomy.Reference
+	(*ReferenceRequest)(nil),       // 1: taxonomy.ReferenceRequest
+	(*TermGroup)(nil),              // 2: taxonomy.TermGroup
+	(*ReferenceFilterRequest)(nil), // 3: taxonomy.ReferenceFilterRequest
+	(*ReferenceExportRequest)(nil), // 4: taxonomy.ReferenceExportRequest
+	(*ReplaceRequest)(nil),         // 5: taxonomy.ReplaceRequest
+	(*ReplaceResponse)(nil),        // 6: taxonomy.ReplaceResponse
+	(*ReferencesResponse)(nil),     // 7: taxonomy.ReferencesResponse
+	(*Pagination)(nil),             // 8: taxonomy.Pagination
+	(*PageInfo)(nil),               // 9: taxonomy.PageInfo
+	(*wrapperspb.BoolValue)(nil),   // 10: google.protobuf.BoolValue
+}
+var file_reference_proto_depIdxs = []int32{
+	2,  // 0: taxonomy.ReferenceFilterRequest.term_group:type_name -> taxonomy.TermGroup
+	8,  // 1: taxonomy.ReferenceFilterRequest.pagination:type_name -> taxonomy.Pagination
+	2,  // 2: taxonomy.ReferenceExportRequest.term_group:type_name -> taxonomy.TermGroup
+	0,  // 3: taxonomy.ReferencesResponse.references:type_name -> taxonomy.Reference
+	9,  // 4: taxonomy.ReferencesResponse.pagination:type_name -> taxonomy.PageInfo
+	1,  // 5: taxonomy.ReferenceService.Create:input_type -> taxonomy.ReferenceRequest
+	1,  // 6: taxonomy.ReferenceService.Delete:input_type -> taxonomy.ReferenceRequest
+	5,  // 7: taxonomy.ReferenceService.Replace:input_type -> taxonomy.ReplaceRequest
+	3,  // 8: taxonomy.ReferenceService.Get:input_type -> taxonomy.ReferenceFilterRequest
+	4,  // 9: taxonomy.ReferenceService.Export:input_type -> taxonomy.ReferenceExportRequest
+	10, // 10: taxonomy.ReferenceService.Create:output_type -> google.protobuf.BoolValue
+	10, // 11: taxonomy.ReferenceService.Delete:output_type -> google.protobuf.BoolValue
+	6,  // 12: taxonomy.ReferenceService.Replace:output_type -> taxonomy.ReplaceResponse
+	7,  // 13: taxonomy.ReferenceService.Get:output_type -> taxonomy.ReferencesResponse
+	0,  // 14: taxonomy.ReferenceService.Export:output_type -> taxonomy.Reference
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_reference_proto_init() }
+func file_reference_proto_init() {
+	if File_reference_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_reference_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Reference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TermGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferenceFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferenceExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_reference_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_reference_proto_msgTypes[4].OneofWrappers = []any{}
+	file_reference_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_reference_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reference_proto_goTypes,
+		DependencyIndexes: file_reference_proto_depIdxs,
+		MessageInfos:      file_reference_proto_msgTypes,
+	}.Build()
+	File_reference_proto = out.File
+	file_reference_proto_rawDesc = nil
+	file_reference_proto_goTypes = nil
+	file_reference_proto_depIdxs = nil
+}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: reference.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReferenceService_Create_FullMethodName  = "/taxonomy.ReferenceService/Create"
+	ReferenceService_Delete_FullMethodName  = "/taxonomy.ReferenceService/Delete"
+	ReferenceService_Replace_FullMethodName = "/taxonomy.ReferenceService/Replace"
+	ReferenceService_Get_FullMethodName     = "/taxonomy.ReferenceService/Get"
+	ReferenceService_Export_FullMethodName  = "/taxonomy.ReferenceService/Export"
+)
+
+// ReferenceServiceClient is the client API for ReferenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReferenceServiceClient interface {
+	// Create creates references between term, namespace and all entities. Existing references are kept.
+	Create(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	// Delete removes references between term, namespace and entities.
+	Delete(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	// Replace replaces all terms of the entity in one transaction, so nothing is changed when any term fails.
+	Replace(ctx context.Context, in *ReplaceRequest, opts ...grpc.CallOption) (*ReplaceResponse, error)
+	// Get returns references for filter.
+	Get(ctx context.Context, in *ReferenceFilterRequest, opts ...grpc.CallOption) (*ReferencesResponse, error)
+	// Export streams all references for filter ordered by id. If stream was broken, it could be continued
+	// by new request with after_id of the last received reference.
+	Export(ctx context.Context, in *ReferenceExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Reference], error)
+}
+
+type referenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReferenceServiceClient(cc grpc.ClientConnInterface) ReferenceServiceClient {
+	return &referenceServiceClient{cc}
+}
+
+func (c *referenceServiceClient) Create(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, ReferenceService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referenceServiceClient) Delete(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, ReferenceService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referenceServiceClient) Replace(ctx context.Context, in *ReplaceRequest, opts ...grpc.CallOption) (*ReplaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceResponse)
+	err := c.cc.Invoke(ctx, ReferenceService_Replace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referenceServiceClient) Get(ctx context.Context, in *ReferenceFilterRequest, opts ...grpc.CallOption) (*ReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReferencesResponse)
+	err := c.cc.Invoke(ctx, ReferenceService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *referenceServiceClient) Export(ctx context.Context, in *ReferenceExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Reference], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReferenceService_ServiceDesc.Streams[0], ReferenceService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReferenceExportRequest, Reference]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReferenceService_ExportClient = grpc.ServerStreamingClient[Reference]
+
+// ReferenceServiceServer is the server API for ReferenceService service.
+// All implementations must embed UnimplementedReferenceServiceServer
+// for forward compatibility.
+type ReferenceServiceServer interface {
+	// Create creates references between term, namespace and all entities. Existing references are kept.
+	Create(context.Context, *ReferenceRequest) (*wrapperspb.BoolValue, error)
+	// Delete removes references between term, namespace and entities.
+	Delete(context.Context, *ReferenceRequest) (*wrapperspb.BoolValue, error)
+	// Replace replaces all terms of the entity in one transaction, so nothing is changed when any term fails.
+	Replace(context.Context, *ReplaceRequest) (*ReplaceResponse, error)
+	// Get returns references for filter.
+	Get(context.Context, *ReferenceFilterRequest) (*ReferencesResponse, error)
+	// Export streams all references for filter ordered by id. If stream was broken, it could be continued
+	// by new request with after_id of the last received reference.
+	Export(*ReferenceExportRequest, grpc.ServerStreamingServer[Reference]) error
+	mustEmbedUnimplementedReferenceServiceServer()
+}
+
+// UnimplementedReferenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReferenceServiceServer struct{}
+
+func (UnimplementedReferenceServiceServer) Create(context.Context, *ReferenceRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedReferenceServiceServer) Delete(context.Context, *ReferenceRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedReferenceServiceServer) Replace(context.Context, *ReplaceRequest) (*ReplaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replace not implemented")
+}
+func (UnimplementedReferenceServiceServer) Get(context.Context, *ReferenceFilterRequest) (*ReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedReferenceServiceServer) Export(*ReferenceExportRequest, grpc.ServerStreamingServer[Reference]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedReferenceServiceServer) mustEmbedUnimplementedReferenceServiceServer() {}
+func (UnimplementedReferenceServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeReferenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReferenceServiceServer will
+// result in compilation errors.
+type UnsafeReferenceServiceServer interface {
+	mustEmbedUnimplementedReferenceServiceServer()
+}
+
+func RegisterReferenceServiceServer(s grpc.ServiceRegistrar, srv ReferenceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReferenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReferenceService_ServiceDesc, srv)
+}
+
+func _ReferenceService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferenceServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReferenceService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferenceServiceServer).Create(ctx, req.(*ReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferenceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferenceServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReferenceService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferenceServiceServer).Delete(ctx, req.(*ReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferenceService_Replace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferenceServiceServer).Replace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReferenceService_Replace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferenceServiceServer).Replace(ctx, req.(*ReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferenceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReferenceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReferenceService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReferenceServiceServer).Get(ctx, req.(*ReferenceFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReferenceService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReferenceExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReferenceServiceServer).Export(m, &grpc.GenericServerStream[ReferenceExportRequest, Reference]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReferenceService_ExportServer = grpc.ServerStreamingServer[Reference]
+
+// ReferenceService_ServiceDesc is the grpc.ServiceDesc for ReferenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReferenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taxonomy.ReferenceService",
+	HandlerType: (*ReferenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ReferenceService_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ReferenceService_Delete_Handler,
+		},
+		{
+			MethodName: "Replace",
+			Handler:    _ReferenceService_Replace_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ReferenceService_Get_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _ReferenceService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "reference.proto",
+}
@@ -0,0 +1,665 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.3
+// source: term.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Term struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title        string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description  *string `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	VocabularyId []int64 `protobuf:"varint,5,rep,packed,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_term_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_term_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Term) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Term) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Term) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Term) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Term) GetVocabularyId() []int64 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return nil
+}
+
+type TermCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title        string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description  *string `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	VocabularyId []int64 `protobuf:"varint,5,rep,packed,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+}
+
+func (x *TermCreateRequest) Reset() {
+	*x = TermCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermCreateRequest) ProtoMessage() {}
+
+func (x *TermCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_term_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermCreateRequest.ProtoReflect.Descriptor instead.
+func (*TermCreateRequest) Descriptor() ([]byte, []int) {
+	return file_term_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TermCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TermCreateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TermCreateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *TermCreateRequest) GetVocabularyId() []int64 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return nil
+}
+
+type TermUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Title        *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description  *string `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	VocabularyId []int64 `protobuf:"varint,5,rep,packed,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+}
+
+func (x *TermUpdateRequest) Reset() {
+	*x = TermUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermUpdateRequest) ProtoMessage() {}
+
+func (x *TermUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_term_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermUpdateRequest.ProtoReflect.Descriptor instead.
+func (*TermUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_term_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TermUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TermUpdateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *TermUpdateRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *TermUpdateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *TermUpdateRequest) GetVocabularyId() []int64 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return nil
+}
+
+type TermNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Term's name is unique within vocabulary only
+	VocabularyId *int64 `protobuf:"varint,2,opt,name=vocabulary_id,json=vocabularyId,proto3,oneof" json:"vocabulary_id,omitempty"`
+}
+
+func (x *TermNameRequest) Reset() {
+	*x = TermNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermNameRequest) ProtoMessage() {}
+
+func (x *TermNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_term_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermNameRequest.ProtoReflect.Descriptor instead.
+func (*TermNameRequest) Descriptor() ([]byte, []int) {
+	return file_term_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TermNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TermNameRequest) GetVocabularyId() int64 {
+	if x != nil && x.VocabularyId != nil {
+		return *x.VocabularyId
+	}
+	return 0
+}
+
+// Response with terms and information about pagination
+type TermsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terms      []*Term   `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	Pagination *PageInfo `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *TermsResponse) Reset() {
+	*x = TermsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermsResponse) ProtoMessage() {}
+
+func (x *TermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_term_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermsResponse.ProtoReflect.Descriptor instead.
+func (*TermsResponse) Descriptor() ([]byte, []int) {
+	return file_term_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TermsResponse) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *TermsResponse) GetPagination() *PageInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetByVocabularyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VocabularyId int64       `protobuf:"varint,1,opt,name=vocabulary_id,json=vocabularyId,proto3" json:"vocabulary_id,omitempty"`
+	Pagination   *Pagination `protobuf:"bytes,20,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetByVocabularyRequest) Reset() {
+	*x = GetByVocabularyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_term_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetByVocabularyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByVocabularyRequest) ProtoMessage() {}
+
+func (x *GetByVocabularyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_term_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByVocabularyRequest.ProtoReflect.Descriptor instead.
+func (*GetByVocabularyRequest) Descriptor() ([]byte, []int) {
+	return file_term_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetByVocabularyRequest) GetVocabularyId() int64 {
+	if x != nil {
+		return x.VocabularyId
+	}
+	return 0
+}
+
+func (x *GetByVocabularyRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_term_proto protoreflect.FileDescriptor
+
+var file_term_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x74, 0x61,
+	0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x23,
+	0x0a, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x54, 0x65,
+	0x72, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x49, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0xcc, 0x01, 0x0a, 0x11,
+	0x54, 0x65, 0x72, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x61, 0x0a, 0x0f, 0x54, 0x65,
+	0x72, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x69, 0x0a,
+	0x0d, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e,
+	0x6f, 0x6d, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42,
+	0x79, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
+	0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xa6, 0x03,
+	0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a,
+	0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e,
+	0x54, 0x65, 0x72, 0x6d, 0x12, 0x35, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b,
+	0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x74, 0x61,
+	0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x39, 0x0a, 0x06, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79,
+	0x2e, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
+	0x64, 0x12, 0x13, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d,
+	0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x36, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x4c,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x79, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x12, 0x20, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54,
+	0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x79, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x2e,
+	0x74, 0x61, 0x78, 0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6d, 0x61, 0x6c, 0x79, 0x6b, 0x68, 0x2f, 0x74, 0x61, 0x78,
+	0x6f, 0x6e, 0x6f, 0x6d, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_term_proto_rawDescOnce sync.Once
+	file_term_proto_rawDescData = file_term_proto_rawDesc
+)
+
+func file_term_proto_rawDescGZIP() []byte {
+	file_term_proto_rawDescOnce.Do(func() {
+		file_term_proto_rawDescData = protoimpl.X.CompressGZIP(file_term_proto_rawDescData)
+	})
+	return file_term_proto_rawDescData
+}
+
+var file_term_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_term_proto_goTypes = []any{
+	(*Term)(nil),                   // 0: taxonomy.Term
+	(*TermCreateRequest)(nil),      // 1: taxonomy.TermCreateRequest
+	(*TermUpdateRequest)(nil),      // 2: taxonomy.TermUpdateRequest
+	(*TermNameRequest)(nil),        // 3: taxonomy.TermNameRequest
+	(*TermsResponse)(nil),          // 4: taxonomy.TermsResponse
+	(*GetByVocabularyRequest)(nil), // 5: taxonomy.GetByVocabularyRequest
+	(*PageInfo)(nil),               // 6: taxonomy.PageInfo
+	(*Pagination)(nil),             // 7: taxonomy.Pagination
+	(*IdRequest)(nil),              // 8: taxonomy.IdRequest
+	(*wrapperspb.BoolValue)(nil),   // 9: google.protobuf.BoolValue
+}
+var file_term_proto_depIdxs = []int32{
+	0,  // 0: taxonomy.TermsResponse.terms:type_name -> taxonomy.Term
+	6,  // 1: taxonomy.TermsResponse.pagination:type_name -> taxonomy.PageInfo
+	7,  // 2: taxonomy.GetByVocabularyRequest.pagination:type_name -> taxonomy.Pagination
+	1,  // 3: taxonomy.TermService.Create:input_type -> taxonomy.TermCreateRequest
+	2,  // 4: taxonomy.TermService.Update:input_type -> taxonomy.TermUpdateRequest
+	8,  // 5: taxonomy.TermService.Delete:input_type -> taxonomy.IdRequest
+	8,  // 6: taxonomy.TermService.GetById:input_type -> taxonomy.IdRequest
+	3,  // 7: taxonomy.TermService.GetByName:input_type -> taxonomy.TermNameRequest
+	5,  // 8: taxonomy.TermService.GetByVocabulary:input_type -> taxonomy.GetByVocabularyRequest
+	7,  // 9: taxonomy.TermService.GetList:input_type -> taxonomy.Pagination
+	0,  // 10: taxonomy.TermService.Create:output_type -> taxonomy.Term
+	0,  // 11: taxonomy.TermService.Update:output_type -> taxonomy.Term
+	9,  // 12: taxonomy.TermService.Delete:output_type -> google.protobuf.BoolValue
+	0,  // 13: taxonomy.TermService.GetById:output_type -> taxonomy.Term
+	0,  // 14: taxonomy.TermService.GetByName:output_type -> taxonomy.Term
+	4,  // 15: taxonomy.TermService.GetByVocabulary:output_type -> taxonomy.TermsResponse
+	4,  // 16: taxonomy.TermService.GetList:output_type -> taxonomy.TermsResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_term_proto_init() }
+func file_term_proto_init() {
+	if File_term_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_term_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Term); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_term_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TermCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_term_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TermUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_term_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*TermNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_term_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*TermsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_term_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetByVocabularyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_term_proto_msgTypes[0].OneofWrappers = []any{}
+	file_term_proto_msgTypes[1].OneofWrappers = []any{}
+	file_term_proto_msgTypes[2].OneofWrappers = []any{}
+	file_term_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_term_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_term_proto_goTypes,
+		DependencyIndexes: file_term_proto_depIdxs,
+		MessageInfos:      file_term_proto_msgTypes,
+	}.Build()
+	File_term_proto = out.File
+	file_term_proto_rawDesc = nil
+	file_term_proto_goTypes = nil
+	file_term_proto_depIdxs = nil
+}
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: term.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TermService_Create_FullMethodName          = "/taxonomy.TermService/Create"
+	TermService_Update_FullMethodName          = "/taxonomy.TermService/Update"
+	TermService_Delete_FullMethodName          = "/taxonomy.TermService/Delete"
+	TermService_GetById_FullMethodName         = "/taxonomy.TermService/GetById"
+	TermService_GetByName_FullMethodName       = "/taxonomy.TermService/GetByName"
+	TermService_GetByVocabulary_FullMethodName = "/taxonomy.TermService/GetByVocabulary"
+	TermService_GetList_FullMethodName         = "/taxonomy.TermService/GetList"
+)
+
+// TermServiceClient is the client API for TermService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TermServiceClient interface {
+	Create(ctx context.Context, in *TermCreateRequest, opts ...grpc.CallOption) (*Term, error)
+	Update(ctx context.Context, in *TermUpdateRequest, opts ...grpc.CallOption) (*Term, error)
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	GetById(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Term, error)
+	// GetByName returns term by it's name
+	GetByName(ctx context.Context, in *TermNameRequest, opts ...grpc.CallOption) (*Term, error)
+	// GetByVocabularyId returns terms by vocabulary id
+	GetByVocabulary(ctx context.Context, in *GetByVocabularyRequest, opts ...grpc.CallOption) (*TermsResponse, error)
+	// GetList returns list of all terms
+	GetList(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*TermsResponse, error)
+}
+
+type termServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTermServiceClient(cc grpc.ClientConnInterface) TermServiceClient {
+	return &termServiceClient{cc}
+}
+
+func (c *termServiceClient) Create(ctx context.Context, in *TermCreateRequest, opts ...grpc.CallOption) (*Term, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Term)
+	err := c.cc.Invoke(ctx, TermService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termServiceClient) Update(ctx context.Context, in *TermUpdateRequest, opts ...grpc.CallOption) (*Term, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Term)
+	err := c.cc.Invoke(ctx, TermService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, TermService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termServiceClient) GetById(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Term, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Term)
+	err := c.cc.Invoke(ctx, TermService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termServiceClient) GetByName(ctx context.Context, in *TermNameRequest, opts ...grpc.CallOption) (*Term, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Term)
+	err := c.cc.Invoke(ctx, TermService_GetByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termServiceClient) GetByVocabulary(ctx context.Context, in *GetByVocabularyRequest, opts ...grpc.CallOption) (*TermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermsResponse)
+	err := c.cc.Invoke(ctx, TermService_GetByVocabulary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *termServiceClient) GetList(ctx context.Context, in *Pagination, opts ...grpc.CallOption) (*TermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermsResponse)
+	err := c.cc.Invoke(ctx, TermService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TermServiceServer is the server API for TermService service.
+// All implementations must embed UnimplementedTermServiceServer
+// for forward compatibility.
+type TermServiceServer interface {
+	Create(context.Context, *TermCreateRequest) (*Term, error)
+	Update(context.Context, *TermUpdateRequest) (*Term, error)
+	Delete(context.Context, *IdRequest) (*wrapperspb.BoolValue, error)
+	GetById(context.Context, *IdRequest) (*Term, error)
+	// GetByName returns term by it's name
+	GetByName(context.Context, *TermNameRequest) (*Term, error)
+	// GetByVocabularyId returns terms by vocabulary id
+	GetByVocabulary(context.Context, *GetByVocabularyRequest) (*TermsResponse, error)
+	// GetList returns list of all terms
+	GetList(context.Context, *Pagination) (*TermsResponse, error)
+	mustEmbedUnimplementedTermServiceServer()
+}
+
+// UnimplementedTermServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTermServiceServer struct{}
+
+func (UnimplementedTermServiceServer) Create(context.Context, *TermCreateRequest) (*Term, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTermServiceServer) Update(context.Context, *TermUpdateRequest) (*Term, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTermServiceServer) Delete(context.Context, *IdRequest) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedTermServiceServer) GetById(context.Context, *IdRequest) (*Term, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedTermServiceServer) GetByName(context.Context, *TermNameRequest) (*Term, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByName not implemented")
+}
+func (UnimplementedTermServiceServer) GetByVocabulary(context.Context, *GetByVocabularyRequest) (*TermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByVocabulary not implemented")
+}
+func (UnimplementedTermServiceServer) GetList(context.Context, *Pagination) (*TermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedTermServiceServer) mustEmbedUnimplementedTermServiceServer() {}
+func (UnimplementedTermServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTermServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TermServiceServer will
+// result in compilation errors.
+type UnsafeTermServiceServer interface {
+	mustEmbedUnimplementedTermServiceServer()
+}
+
+func RegisterTermServiceServer(s grpc.ServiceRegistrar, srv TermServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTermServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TermService_ServiceDesc, srv)
+}
+
+func _TermService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).Create(ctx, req.(*TermCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).Update(ctx, req.(*TermUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).GetById(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermService_GetByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).GetByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_GetByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).GetByName(ctx, req.(*TermNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermService_GetByVocabulary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByVocabularyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).GetByVocabulary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_GetByVocabulary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).GetByVocabulary(ctx, req.(*GetByVocabularyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TermService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TermServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TermService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TermServiceServer).GetList(ctx, req.(*Pagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TermService_ServiceDesc is the grpc.ServiceDesc for TermService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TermService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taxonomy.TermService",
+	HandlerType: (*TermServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TermService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TermService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TermService_Delete_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _TermService_GetById_Handler,
+		},
+		{
+			MethodName: "GetByName",
+			Handler:    _TermService_GetByName_Handler,
+		},
+		{
+			MethodName: "GetByVocabulary",
+			Handler:    _TermService_GetByVocabulary_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _TermService_GetList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "term.proto",
+}
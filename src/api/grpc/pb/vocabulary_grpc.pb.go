@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: vocabulary.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VocabularyService_Create_FullMethodName  = "/taxonomy.VocabularyService/Create"
+	VocabularyService_Update_FullMethodName  = "/taxonomy.VocabularyService/Update"
+	VocabularyService_Delete_FullMethodName  = "/taxonomy.VocabularyService/Delete"
+	VocabularyService_GetById_FullMethodName = "/taxonomy.VocabularyService/GetById"
+	VocabularyService_GetList_FullMethodName = "/taxonomy.VocabularyService/GetList"
+)
+
+// VocabularyServiceClient is the client API for VocabularyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VocabularyServiceClient interface {
+	Create(ctx context.Context, in *VocabularyCreateRequest, opts ...grpc.CallOption) (*Vocabulary, error)
+	Update(ctx context.Context, in *VocabularyUpdateRequest, opts ...grpc.CallOption) (*Vocabulary, error)
+	Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Vocabulary, error)
+	GetById(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Vocabulary, error)
+	GetList(ctx context.Context, in *VocabularyFilterRequest, opts ...grpc.CallOption) (*VocabulariesResponse, error)
+}
+
+type vocabularyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVocabularyServiceClient(cc grpc.ClientConnInterface) VocabularyServiceClient {
+	return &vocabularyServiceClient{cc}
+}
+
+func (c *vocabularyServiceClient) Create(ctx context.Context, in *VocabularyCreateRequest, opts ...grpc.CallOption) (*Vocabulary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vocabulary)
+	err := c.cc.Invoke(ctx, VocabularyService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) Update(ctx context.Context, in *VocabularyUpdateRequest, opts ...grpc.CallOption) (*Vocabulary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vocabulary)
+	err := c.cc.Invoke(ctx, VocabularyService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) Delete(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Vocabulary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vocabulary)
+	err := c.cc.Invoke(ctx, VocabularyService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetById(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Vocabulary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Vocabulary)
+	err := c.cc.Invoke(ctx, VocabularyService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetList(ctx context.Context, in *VocabularyFilterRequest, opts ...grpc.CallOption) (*VocabulariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabulariesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VocabularyServiceServer is the server API for VocabularyService service.
+// All implementations must embed UnimplementedVocabularyServiceServer
+// for forward compatibility.
+type VocabularyServiceServer interface {
+	Create(context.Context, *VocabularyCreateRequest) (*Vocabulary, error)
+	Update(context.Context, *VocabularyUpdateRequest) (*Vocabulary, error)
+	Delete(context.Context, *IdRequest) (*Vocabulary, error)
+	GetById(context.Context, *IdRequest) (*Vocabulary, error)
+	GetList(context.Context, *VocabularyFilterRequest) (*VocabulariesResponse, error)
+	mustEmbedUnimplementedVocabularyServiceServer()
+}
+
+// UnimplementedVocabularyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVocabularyServiceServer struct{}
+
+func (UnimplementedVocabularyServiceServer) Create(context.Context, *VocabularyCreateRequest) (*Vocabulary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedVocabularyServiceServer) Update(context.Context, *VocabularyUpdateRequest) (*Vocabulary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedVocabularyServiceServer) Delete(context.Context, *IdRequest) (*Vocabulary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetById(context.Context, *IdRequest) (*Vocabulary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetList(context.Context, *VocabularyFilterRequest) (*VocabulariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedVocabularyServiceServer) mustEmbedUnimplementedVocabularyServiceServer() {}
+func (UnimplementedVocabularyServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeVocabularyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VocabularyServiceServer will
+// result in compilation errors.
+type UnsafeVocabularyServiceServer interface {
+	mustEmbedUnimplementedVocabularyServiceServer()
+}
+
+func RegisterVocabularyServiceServer(s grpc.ServiceRegistrar, srv VocabularyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVocabularyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VocabularyService_ServiceDesc, srv)
+}
+
+func _VocabularyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Create(ctx, req.(*VocabularyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Update(ctx, req.(*VocabularyUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Delete(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetById(ctx, req.(*IdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetList(ctx, req.(*VocabularyFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VocabularyService_ServiceDesc is the grpc.ServiceDesc for VocabularyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VocabularyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taxonomy.VocabularyService",
+	HandlerType: (*VocabularyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _VocabularyService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _VocabularyService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _VocabularyService_Delete_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _VocabularyService_GetById_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _VocabularyService_GetList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vocabulary.proto",
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/api/grpc/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type Config struct {
+	Port              string
+	TermService       taxonomy.Term
+	VocabularyService taxonomy.Vocabulary
+	NamespaceService  taxonomy.Namespace
+	ReferenceService  taxonomy.Reference
+	Verbose           bool
+}
+
+// NewServer returns gRPC server with registered taxonomy services.
+func NewServer(config *Config) *grpc.Server {
+	var options []grpc.ServerOption
+
+	if config.Verbose {
+		options = append(options, grpc.UnaryInterceptor(
+			func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				log.Println(info.FullMethod, req)
+
+				return handler(ctx, req)
+			}),
+		)
+	}
+
+	srv := grpc.NewServer(options...)
+
+	pb.RegisterTermServiceServer(srv, service.NewTerm(config.TermService))
+	pb.RegisterVocabularyServiceServer(srv, service.NewVocabulary(config.VocabularyService))
+	pb.RegisterNamespaceServiceServer(srv, service.NewNamespace(config.NamespaceService))
+	pb.RegisterReferenceServiceServer(srv, service.NewReference(config.ReferenceService))
+	reflection.Register(srv)
+
+	return srv
+}
+
+func Serve(config *Config) error {
+	listener, err := net.Listen(`tcp`, `:`+config.Port)
+	if err != nil {
+		return fmt.Errorf(`listen error: %w`, err)
+	}
+
+	log.Printf("gRPC server listens :%s", config.Port)
+
+	return fmt.Errorf(`server error: %w`, NewServer(config).Serve(listener))
+}
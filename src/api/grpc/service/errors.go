@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// codeMap links taxonomy errors with gRPC codes. Order matters: errors could be joined, so the first matched wins.
+var codeMap = []struct {
+	err  error
+	code codes.Code
+}{
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+	{taxonomy.ErrTermNotFound, codes.NotFound},
+	{taxonomy.ErrVocabularyNotFound, codes.NotFound},
+	{taxonomy.ErrNamespaceNotFound, codes.NotFound},
+	{taxonomy.ErrReferenceExists, codes.FailedPrecondition},
+	{taxonomy.ErrVocabularyHasTerms, codes.FailedPrecondition},
+	{taxonomy.ErrHierarchyCycle, codes.FailedPrecondition},
+	{taxonomy.ErrHierarchyTooDeep, codes.FailedPrecondition},
+	{repository.ErrNotUniqueName, codes.AlreadyExists},
+	{taxonomy.ErrTermNotUnique, codes.AlreadyExists},
+	{taxonomy.ErrSynonymEmpty, codes.InvalidArgument},
+	{taxonomy.ErrTermNotInVocabulary, codes.InvalidArgument},
+	{repository.ErrWithoutNamespace, codes.InvalidArgument},
+	{taxonomy.ErrTermNotCreated, codes.Internal},
+	{taxonomy.ErrTermNotUpdated, codes.Internal},
+	{taxonomy.ErrVocabularyNotCreated, codes.Internal},
+	{taxonomy.ErrVocabularyNotUpdated, codes.Internal},
+	{taxonomy.ErrNamespaceNotCreated, codes.Internal},
+	{taxonomy.ErrNamespaceNotUpdated, codes.Internal},
+	{taxonomy.ErrNamespaceNotDeleted, codes.Internal},
+	{taxonomy.ErrReferenceNotCreated, codes.Internal},
+	{taxonomy.ErrReferenceNotRemoved, codes.Internal},
+}
+
+// toStatus converts error returned by taxonomy services to gRPC status error.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	for _, m := range codeMap {
+		if errors.Is(err, m.err) {
+			return status.Error(m.code, err.Error())
+		}
+	}
+
+	return status.Error(codes.Unknown, err.Error())
+}
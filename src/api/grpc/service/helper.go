@@ -0,0 +1,142 @@
+package service
+
+import (
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+// defaultLimit used when limit isn't specified in pagination.
+const defaultLimit = 20
+
+func term2pb(term *model.Term) *pb.Term {
+	return &pb.Term{
+		Id:           int64(term.ID),
+		Name:         term.Data.Name,
+		Title:        term.Data.Title,
+		Description:  &term.Data.Description,
+		VocabularyId: uint64sToInt64s(term.Data.VocabularyID),
+	}
+}
+
+func namespace2pb(namespace *model.Namespace) *pb.Namespace {
+	return &pb.Namespace{
+		Id:   int64(namespace.ID),
+		Name: namespace.Data.Name,
+	}
+}
+
+func reference2pb(reference *model.Reference) *pb.Reference {
+	return &pb.Reference{
+		Id:        int64(reference.ID),
+		TermId:    int64(reference.TermID),
+		Namespace: reference.Namespace,
+		EntityId:  string(reference.EntityID),
+	}
+}
+
+func vocabulary2pb(vocabulary *model.Vocabulary) *pb.Vocabulary {
+	return &pb.Vocabulary{
+		Id:          int64(vocabulary.ID),
+		Name:        vocabulary.Data.Name,
+		Title:       vocabulary.Data.Title,
+		Description: vocabulary.Data.Description,
+		ParentId:    uint64pToInt64p(vocabulary.Data.ParentID),
+	}
+}
+
+func int64sToUint64s(ints []int64) []uint64 {
+	uints := make([]uint64, 0, len(ints))
+	for _, i := range ints {
+		uints = append(uints, uint64(i))
+	}
+
+	return uints
+}
+
+func uint64sToInt64s(uints []uint64) []int64 {
+	ints := make([]int64, 0, len(uints))
+	for _, i := range uints {
+		ints = append(ints, int64(i))
+	}
+
+	return ints
+}
+
+func stringsToEntities(strs []string) []model.EntityID {
+	entities := make([]model.EntityID, 0, len(strs))
+	for _, s := range strs {
+		entities = append(entities, model.EntityID(s))
+	}
+
+	return entities
+}
+
+func int64pToUint64p(i *int64) *uint64 {
+	if i == nil {
+		return nil
+	}
+
+	u := uint64(*i)
+
+	return &u
+}
+
+func uint64pToInt64p(u *uint64) *int64 {
+	if u == nil {
+		return nil
+	}
+
+	i := int64(*u)
+
+	return &i
+}
+
+// limit returns limit from pagination or default one.
+func limit(pagination *pb.Pagination) uint {
+	if pagination.GetLimit() <= 0 {
+		return defaultLimit
+	}
+
+	return uint(pagination.GetLimit())
+}
+
+// afterID returns cursor from pagination.
+func afterID(pagination *pb.Pagination) *uint64 {
+	if pagination == nil {
+		return nil
+	}
+
+	return int64pToUint64p(pagination.AfterId)
+}
+
+// pageInfo cuts the extra item requested to obtain HasNextPage and returns information about the page.
+func pageInfo[T any](items []T, pagination *pb.Pagination, id func(item T) uint64) ([]T, *pb.PageInfo) {
+	var (
+		lim  = limit(pagination)
+		info = &pb.PageInfo{
+			Offset: pagination.GetOffset(),
+			Limit:  int32(lim),
+		}
+	)
+
+	if uint(len(items)) > lim {
+		items = items[:lim]
+		info.HasNextPage = true
+	}
+
+	if len(items) > 0 {
+		endID := int64(id(items[len(items)-1]))
+		info.EndId = &endID
+	}
+
+	return items, info
+}
+
+func termGroups(groups []*pb.TermGroup) [][]uint64 {
+	var termID = make([][]uint64, 0, len(groups))
+	for _, group := range groups {
+		termID = append(termID, int64sToUint64s(group.GetTermId()))
+	}
+
+	return termID
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func NewNamespace(namespaceService taxonomy.Namespace) pb.NamespaceServiceServer {
+	return &Namespace{
+		namespaceService: namespaceService,
+	}
+}
+
+type Namespace struct {
+	pb.UnimplementedNamespaceServiceServer
+	namespaceService taxonomy.Namespace
+}
+
+func (n *Namespace) Create(ctx context.Context, request *pb.NamespaceCreateRequest) (*pb.Namespace, error) {
+	namespace, err := n.namespaceService.Create(ctx, request.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return namespace2pb(namespace), nil
+}
+
+func (n *Namespace) Update(ctx context.Context, request *pb.NamespaceUpdateRequest) (*pb.Namespace, error) {
+	namespace, err := n.namespaceService.Update(ctx, uint64(request.GetId()), request.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return namespace2pb(namespace), nil
+}
+
+func (n *Namespace) Delete(ctx context.Context, request *pb.IdRequest) (*wrapperspb.BoolValue, error) {
+	if err := n.namespaceService.Delete(ctx, uint64(request.GetId())); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return wrapperspb.Bool(true), nil
+}
+
+func (n *Namespace) GetByName(ctx context.Context, request *pb.NamespaceNameRequest) (*pb.Namespace, error) {
+	namespace, err := n.namespaceService.GetByName(ctx, request.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return namespace2pb(namespace), nil
+}
+
+func (n *Namespace) Get(ctx context.Context, request *pb.Pagination) (*pb.NamespacesResponse, error) {
+	namespaces, err := n.namespaceService.Get(ctx, limit(request)+1, afterID(request)) // dirty hack to obtain HasNextPage
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	namespaces, info := pageInfo(namespaces, request, func(item *model.Namespace) uint64 {
+		return item.ID
+	})
+
+	var response = &pb.NamespacesResponse{
+		Namespaces: make([]*pb.Namespace, 0, len(namespaces)),
+		Pagination: info,
+	}
+
+	for _, namespace := range namespaces {
+		response.Namespaces = append(response.Namespaces, namespace2pb(namespace))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,86 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/api/grpc/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func namespaceClient(t *testing.T, namespaceService taxonomy.Namespace) pb.NamespaceServiceClient {
+	t.Helper()
+
+	return pb.NewNamespaceServiceClient(dial(t, func(srv *grpc.Server) {
+		pb.RegisterNamespaceServiceServer(srv, service.NewNamespace(namespaceService))
+	}))
+}
+
+func TestNamespace_Delete(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: `ok`,
+			code: codes.OK,
+		},
+		{
+			name: `references exist`,
+			err:  errors.Join(taxonomy.ErrReferenceExists, fmt.Errorf(`%d has %d references`, 3, 10)),
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: `not found`,
+			err:  fmt.Errorf(`%w, got %d results`, taxonomy.ErrNamespaceNotFound, 0),
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			namespaceService := mock.Mock[taxonomy.Namespace]()
+			mock.When(namespaceService.Delete(mock.Any[context.Context](), mock.Equal[uint64](3))).
+				ThenReturn(tt.err)
+
+			client := namespaceClient(t, namespaceService)
+
+			_, err := client.Delete(context.Background(), &pb.IdRequest{Id: 3})
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestNamespace_Get(t *testing.T) {
+	mock.SetUp(t)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.Get(mock.Any[context.Context](), mock.Equal[uint](21), mock.Any[*uint64]())).
+		ThenReturn([]*model.Namespace{
+			{ID: 1, Data: model.NamespaceData{Name: `products`}},
+			{ID: 2, Data: model.NamespaceData{Name: `articles`}},
+		}, nil)
+
+	client := namespaceClient(t, namespaceService)
+
+	got, err := client.Get(context.Background(), &pb.Pagination{})
+	require.NoError(t, err)
+
+	require.Len(t, got.GetNamespaces(), 2)
+	assert.Equal(t, `articles`, got.GetNamespaces()[1].GetName())
+	assert.False(t, got.GetPagination().GetHasNextPage())
+	assert.Equal(t, int64(2), got.GetPagination().GetEndId())
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	defaultBatchSize = 500
+	maxBatchSize     = 5000
+)
+
+func NewReference(referenceService taxonomy.Reference) pb.ReferenceServiceServer {
+	return &Reference{
+		referenceService: referenceService,
+	}
+}
+
+type Reference struct {
+	pb.UnimplementedReferenceServiceServer
+	referenceService taxonomy.Reference
+}
+
+func (r *Reference) Create(ctx context.Context, request *pb.ReferenceRequest) (*wrapperspb.BoolValue, error) {
+	if err := r.referenceService.Create(ctx, uint64(request.GetTermId()), request.GetNamespace(),
+		stringsToEntities(request.GetEntityId())...); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return wrapperspb.Bool(true), nil
+}
+
+func (r *Reference) Delete(ctx context.Context, request *pb.ReferenceRequest) (*wrapperspb.BoolValue, error) {
+	if err := r.referenceService.Delete(ctx, uint64(request.GetTermId()), request.GetNamespace(),
+		stringsToEntities(request.GetEntityId())...); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return wrapperspb.Bool(true), nil
+}
+
+func (r *Reference) Replace(ctx context.Context, request *pb.ReplaceRequest) (*pb.ReplaceResponse, error) {
+	var (
+		entityID = model.EntityID(request.GetEntityId())
+		termID   = int64sToUint64s(request.GetTermId())
+		changes  *model.ReferenceChanges
+		err      error
+	)
+
+	if request.VocabularyId != nil {
+		changes, err = r.referenceService.ReplaceInVocabulary(ctx, request.GetNamespace(), entityID,
+			uint64(request.GetVocabularyId()), termID)
+	} else {
+		changes, err = r.referenceService.Replace(ctx, request.GetNamespace(), entityID, termID)
+	}
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ReplaceResponse{
+		Added:   uint64sToInt64s(changes.Added),
+		Removed: uint64sToInt64s(changes.Removed),
+	}, nil
+}
+
+func (r *Reference) Get(ctx context.Context, request *pb.ReferenceFilterRequest) (*pb.ReferencesResponse, error) {
+	var pagination = request.GetPagination()
+
+	references, err := r.referenceService.Get(ctx, &model.ReferenceFilter{
+		TermID:    termGroups(request.GetTermGroup()),
+		Namespace: request.GetNamespace(),
+		EntityID:  stringsToEntities(request.GetEntityId()),
+		AfterID:   afterID(pagination),
+		Limit: func() *uint {
+			l := limit(pagination) + 1 // dirty hack to obtain HasNextPage
+
+			return &l
+		}(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	references, info := pageInfo(references, pagination, func(item *model.Reference) uint64 {
+		return item.ID
+	})
+
+	var response = &pb.ReferencesResponse{
+		References: make([]*pb.Reference, 0, len(references)),
+		Pagination: info,
+	}
+
+	for _, reference := range references {
+		response.References = append(response.References, reference2pb(reference))
+	}
+
+	return response, nil
+}
+
+// Export streams references by batches. Send blocks while client doesn't read the stream, so next batch isn't
+// requested from storage until the previous one is consumed.
+func (r *Reference) Export(request *pb.ReferenceExportRequest, stream grpc.ServerStreamingServer[pb.Reference]) error {
+	var batchSize uint = defaultBatchSize
+	if size := request.GetBatchSize(); size > 0 {
+		batchSize = uint(min(size, maxBatchSize))
+	}
+
+	if err := r.referenceService.Iterate(stream.Context(), &model.ReferenceFilter{
+		TermID:    termGroups(request.GetTermGroup()),
+		Namespace: request.GetNamespace(),
+		EntityID:  stringsToEntities(request.GetEntityId()),
+		AfterID:   int64pToUint64p(request.AfterId),
+	}, batchSize, func(reference *model.Reference) error {
+		return stream.Send(reference2pb(reference))
+	}); err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/api/grpc/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func referenceClient(t *testing.T, referenceService taxonomy.Reference) pb.ReferenceServiceClient {
+	t.Helper()
+
+	return pb.NewReferenceServiceClient(dial(t, func(srv *grpc.Server) {
+		pb.RegisterReferenceServiceServer(srv, service.NewReference(referenceService))
+	}))
+}
+
+func TestReference_Create(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: `ok`,
+			code: codes.OK,
+		},
+		{
+			name: `namespace not found`,
+			err:  fmt.Errorf(`namespace %s get error: %w: %w`, `products`, taxonomy.ErrNamespaceNotFound, io.EOF),
+			code: codes.NotFound,
+		},
+		{
+			name: `not created`,
+			err:  fmt.Errorf(`can't create reference %w: %w`, taxonomy.ErrReferenceNotCreated, io.EOF),
+			code: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			referenceService := mock.Mock[taxonomy.Reference]()
+			mock.When(referenceService.Create(mock.Any[context.Context](), mock.Equal[uint64](1), mock.Equal(`products`),
+				mock.Equal([]model.EntityID{`sku-100`, `sku-200`})...)).
+				ThenReturn(tt.err)
+
+			client := referenceClient(t, referenceService)
+
+			got, err := client.Create(context.Background(), &pb.ReferenceRequest{
+				TermId:    1,
+				Namespace: `products`,
+				EntityId:  []string{`sku-100`, `sku-200`},
+			})
+			require.Equal(t, tt.code, status.Code(err))
+
+			if tt.err == nil {
+				assert.True(t, got.GetValue())
+			}
+		})
+	}
+}
+
+func TestReference_Replace(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Replace(mock.Any[context.Context](), mock.Equal(`products`),
+		mock.Equal[model.EntityID](`sku-100`), mock.Equal([]uint64{2, 3}))).
+		ThenReturn(&model.ReferenceChanges{Added: []uint64{3}, Removed: []uint64{1}}, nil)
+	mock.When(referenceService.ReplaceInVocabulary(mock.Any[context.Context](), mock.Equal(`products`),
+		mock.Equal[model.EntityID](`sku-100`), mock.Equal[uint64](7), mock.Equal([]uint64{5}))).
+		ThenReturn(nil, fmt.Errorf(`%w: term 5, vocabulary 7`, taxonomy.ErrTermNotInVocabulary))
+
+	client := referenceClient(t, referenceService)
+
+	got, err := client.Replace(context.Background(), &pb.ReplaceRequest{
+		Namespace: `products`,
+		EntityId:  `sku-100`,
+		TermId:    []int64{2, 3},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, got.GetAdded())
+	assert.Equal(t, []int64{1}, got.GetRemoved())
+
+	_, err = client.Replace(context.Background(), &pb.ReplaceRequest{
+		Namespace:    `products`,
+		EntityId:     `sku-100`,
+		TermId:       []int64{5},
+		VocabularyId: proto.Int64(7),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReference_Get(t *testing.T) {
+	t.Run(`namespace required`, func(t *testing.T) {
+		mock.SetUp(t)
+
+		referenceService := mock.Mock[taxonomy.Reference]()
+		mock.When(referenceService.Get(mock.Any[context.Context](), mock.Any[*model.ReferenceFilter]())).
+			ThenReturn(nil, fmt.Errorf(`unknown error %w`, repository.ErrWithoutNamespace))
+
+		client := referenceClient(t, referenceService)
+
+		_, err := client.Get(context.Background(), &pb.ReferenceFilterRequest{EntityId: []string{`sku-100`}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run(`term groups`, func(t *testing.T) {
+		mock.SetUp(t)
+
+		referenceService := mock.Mock[taxonomy.Reference]()
+		captor := mock.Captor[*model.ReferenceFilter]()
+		mock.When(referenceService.Get(mock.Any[context.Context](), captor.Capture())).
+			ThenReturn([]*model.Reference{
+				{ID: 5, TermID: 92, Namespace: `laptops`, EntityID: `dell-xps`},
+				{ID: 6, TermID: 43, Namespace: `laptops`, EntityID: `dell-xps`},
+			}, nil)
+
+		client := referenceClient(t, referenceService)
+
+		got, err := client.Get(context.Background(), &pb.ReferenceFilterRequest{
+			TermGroup: []*pb.TermGroup{
+				{TermId: []int64{92, 23}},
+				{TermId: []int64{43, 58}},
+			},
+			Namespace:  []string{`laptops`},
+			Pagination: &pb.Pagination{Limit: 1, AfterId: proto.Int64(4)},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, [][]uint64{{92, 23}, {43, 58}}, captor.Last().TermID)
+		assert.Equal(t, []string{`laptops`}, captor.Last().Namespace)
+		assert.Equal(t, uint64(4), *captor.Last().AfterID)
+		assert.Equal(t, uint(2), *captor.Last().Limit)
+
+		require.Len(t, got.GetReferences(), 1)
+		assert.Equal(t, `dell-xps`, got.GetReferences()[0].GetEntityId())
+		assert.Equal(t, int64(92), got.GetReferences()[0].GetTermId())
+		assert.True(t, got.GetPagination().GetHasNextPage())
+		assert.Equal(t, int64(5), got.GetPagination().GetEndId())
+	})
+}
+
+func TestReference_Export(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	captor := mock.Captor[*model.ReferenceFilter]()
+	mock.When(referenceService.Iterate(mock.Any[context.Context](), captor.Capture(), mock.Equal[uint](500),
+		mock.Any[func(reference *model.Reference) error]())).
+		ThenAnswer(func(args []any) []any {
+			var fn = args[3].(func(reference *model.Reference) error)
+			for id := uint64(8); id <= 10; id++ {
+				if err := fn(&model.Reference{ID: id, TermID: 92, Namespace: `laptops`, EntityID: `dell-xps`}); err != nil {
+					return []any{err}
+				}
+			}
+
+			return []any{nil}
+		})
+
+	client := referenceClient(t, referenceService)
+
+	stream, err := client.Export(context.Background(), &pb.ReferenceExportRequest{
+		Namespace: []string{`laptops`},
+		AfterId:   proto.Int64(7),
+	})
+	require.NoError(t, err)
+
+	var got []int64
+
+	for {
+		reference, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+		got = append(got, reference.GetId())
+	}
+
+	assert.Equal(t, []int64{8, 9, 10}, got)
+	assert.Equal(t, uint64(7), *captor.Last().AfterID)
+	assert.Equal(t, []string{`laptops`}, captor.Last().Namespace)
+}
@@ -0,0 +1,47 @@
+package service_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+// dial runs in-process gRPC server with registered services and returns connection to it.
+func dial(t *testing.T, register func(srv *grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	var (
+		listener = bufconn.Listen(bufSize)
+		srv      = grpc.NewServer()
+	)
+
+	register(srv)
+
+	go func() {
+		if err := srv.Serve(listener); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	conn, err := grpc.NewClient(`passthrough:///bufnet`,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+		srv.Stop()
+	})
+
+	return conn
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func NewTerm(termService taxonomy.Term) pb.TermServiceServer {
+	return &Term{
+		termService: termService,
+	}
+}
+
+type Term struct {
+	pb.UnimplementedTermServiceServer
+	termService taxonomy.Term
+}
+
+func (t *Term) Create(ctx context.Context, request *pb.TermCreateRequest) (*pb.Term, error) {
+	term, err := t.termService.Create(ctx, &model.TermData{
+		Name:         request.GetName(),
+		Title:        request.GetTitle(),
+		Description:  request.GetDescription(),
+		VocabularyID: int64sToUint64s(request.GetVocabularyId()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return term2pb(term), nil
+}
+
+func (t *Term) Update(ctx context.Context, request *pb.TermUpdateRequest) (*pb.Term, error) {
+	term, err := t.termService.Update(ctx, uint64(request.GetId()), &model.TermData{
+		Name:         request.GetName(),
+		Title:        request.GetTitle(),
+		Description:  request.GetDescription(),
+		VocabularyID: int64sToUint64s(request.GetVocabularyId()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return term2pb(term), nil
+}
+
+func (t *Term) Delete(ctx context.Context, request *pb.IdRequest) (*wrapperspb.BoolValue, error) {
+	if err := t.termService.Delete(ctx, uint64(request.GetId())); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return wrapperspb.Bool(true), nil
+}
+
+func (t *Term) GetById(ctx context.Context, request *pb.IdRequest) (*pb.Term, error) { //nolint:revive,stylecheck
+	term, err := t.termService.GetByID(ctx, uint64(request.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return term2pb(term), nil
+}
+
+func (t *Term) GetByName(ctx context.Context, request *pb.TermNameRequest) (*pb.Term, error) {
+	var name = request.GetName()
+
+	terms, err := t.termService.Get(ctx, &model.TermFilter{
+		Name: &name,
+		VocabularyID: func() []uint64 {
+			if request.VocabularyId == nil {
+				return nil
+			}
+
+			return []uint64{uint64(request.GetVocabularyId())}
+		}(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	switch len(terms) {
+	case 0:
+		return nil, toStatus(fmt.Errorf(`%w %q`, taxonomy.ErrTermNotFound, name))
+	case 1:
+		return term2pb(terms[0]), nil
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition,
+			`%d terms found by name %q, specify vocabulary`, len(terms), name)
+	}
+}
+
+func (t *Term) GetByVocabulary(ctx context.Context, request *pb.GetByVocabularyRequest) (*pb.TermsResponse, error) {
+	return t.list(ctx, &model.TermFilter{
+		VocabularyID: []uint64{uint64(request.GetVocabularyId())},
+	}, request.GetPagination())
+}
+
+func (t *Term) GetList(ctx context.Context, request *pb.Pagination) (*pb.TermsResponse, error) {
+	return t.list(ctx, &model.TermFilter{}, request)
+}
+
+func (t *Term) list(ctx context.Context, filter *model.TermFilter, pagination *pb.Pagination) (*pb.TermsResponse, error) {
+	filter.Limit = limit(pagination) + 1 // dirty hack to obtain HasNextPage
+	filter.Offset = uint(pagination.GetOffset())
+	filter.AfterID = afterID(pagination)
+
+	terms, err := t.termService.Get(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	terms, info := pageInfo(terms, pagination, func(item *model.Term) uint64 {
+		return item.ID
+	})
+
+	var response = &pb.TermsResponse{
+		Terms:      make([]*pb.Term, 0, len(terms)),
+		Pagination: info,
+	}
+
+	for _, term := range terms {
+		response.Terms = append(response.Terms, term2pb(term))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/api/grpc/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func termClient(t *testing.T, termService taxonomy.Term) pb.TermServiceClient {
+	t.Helper()
+
+	return pb.NewTermServiceClient(dial(t, func(srv *grpc.Server) {
+		pb.RegisterTermServiceServer(srv, service.NewTerm(termService))
+	}))
+}
+
+func TestTerm_GetById(t *testing.T) {
+	tests := []struct {
+		name        string
+		termService func() taxonomy.Term
+		want        *pb.Term
+		code        codes.Code
+	}{
+		{
+			name: `ok`,
+			termService: func() taxonomy.Term {
+				termService := mock.Mock[taxonomy.Term]()
+				mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](42))).
+					ThenReturn(&model.Term{ID: 42, Data: model.TermData{
+						Name:         `laptop`,
+						Title:        `Laptop`,
+						VocabularyID: []uint64{4, 8},
+					}}, nil)
+
+				return termService
+			},
+			want: &pb.Term{
+				Id:           42,
+				Name:         `laptop`,
+				Title:        `Laptop`,
+				Description:  proto.String(``),
+				VocabularyId: []int64{4, 8},
+			},
+			code: codes.OK,
+		},
+		{
+			name: `not found`,
+			termService: func() taxonomy.Term {
+				termService := mock.Mock[taxonomy.Term]()
+				mock.When(termService.GetByID(mock.Any[context.Context](), mock.Any[uint64]())).
+					ThenReturn(nil, fmt.Errorf(`%w %d`, taxonomy.ErrTermNotFound, 42))
+
+				return termService
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: `unknown error`,
+			termService: func() taxonomy.Term {
+				termService := mock.Mock[taxonomy.Term]()
+				mock.When(termService.GetByID(mock.Any[context.Context](), mock.Any[uint64]())).
+					ThenReturn(nil, io.EOF)
+
+				return termService
+			},
+			code: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			client := termClient(t, tt.termService())
+
+			got, err := client.GetById(context.Background(), &pb.IdRequest{Id: 42})
+			assert.Equal(t, tt.code, status.Code(err))
+
+			if tt.want != nil {
+				assert.True(t, proto.Equal(tt.want, got), `want %v, got %v`, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTerm_Delete(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: `ok`,
+			err:  nil,
+			code: codes.OK,
+		},
+		{
+			name: `references exist`,
+			err:  fmt.Errorf(`can't remove term %d: %d %w`, 42, 2, taxonomy.ErrReferenceExists),
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: `not found`,
+			err:  errors.Join(taxonomy.ErrTermNotFound, io.EOF),
+			code: codes.NotFound,
+		},
+		{
+			name: `deadline exceeded`,
+			err:  fmt.Errorf(`unknown error %w`, context.DeadlineExceeded),
+			code: codes.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termService := mock.Mock[taxonomy.Term]()
+			mock.When(termService.Delete(mock.Any[context.Context](), mock.Equal[uint64](42))).
+				ThenReturn(tt.err)
+
+			client := termClient(t, termService)
+
+			got, err := client.Delete(context.Background(), &pb.IdRequest{Id: 42})
+			assert.Equal(t, tt.code, status.Code(err))
+
+			if tt.err == nil {
+				assert.True(t, got.GetValue())
+			}
+		})
+	}
+}
+
+func TestTerm_GetList(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	captor := mock.Captor[*model.TermFilter]()
+	mock.When(termService.Get(mock.Any[context.Context](), captor.Capture())).
+		ThenReturn([]*model.Term{{ID: 11}, {ID: 12}, {ID: 13}}, nil)
+
+	client := termClient(t, termService)
+
+	got, err := client.GetList(context.Background(), &pb.Pagination{Limit: 2, AfterId: proto.Int64(10)})
+	require.NoError(t, err)
+
+	// One extra term requested to know about next page
+	require.NotNil(t, captor.Last().AfterID)
+	assert.Equal(t, uint64(10), *captor.Last().AfterID)
+	assert.Equal(t, uint(3), captor.Last().Limit)
+
+	assert.Len(t, got.GetTerms(), 2)
+	assert.True(t, got.GetPagination().GetHasNextPage())
+	assert.Equal(t, int64(12), got.GetPagination().GetEndId())
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+func NewVocabulary(vocabularyService taxonomy.Vocabulary) pb.VocabularyServiceServer {
+	return &Vocabulary{
+		vocabularyService: vocabularyService,
+	}
+}
+
+type Vocabulary struct {
+	pb.UnimplementedVocabularyServiceServer
+	vocabularyService taxonomy.Vocabulary
+}
+
+func (v *Vocabulary) Create(ctx context.Context, request *pb.VocabularyCreateRequest) (*pb.Vocabulary, error) {
+	vocabulary, err := v.vocabularyService.Create(ctx, &model.VocabularyData{
+		Name:        request.GetName(),
+		Title:       request.GetTitle(),
+		Description: request.Description,
+		ParentID:    int64pToUint64p(request.ParentId),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return vocabulary2pb(vocabulary), nil
+}
+
+func (v *Vocabulary) Update(ctx context.Context, request *pb.VocabularyUpdateRequest) (*pb.Vocabulary, error) {
+	vocabulary, err := v.vocabularyService.Update(ctx, uint64(request.GetId()), &model.VocabularyData{
+		Name:        request.GetName(),
+		Title:       request.GetTitle(),
+		Description: request.Description,
+		ParentID:    int64pToUint64p(request.ParentId),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return vocabulary2pb(vocabulary), nil
+}
+
+// Delete removes vocabulary and returns it.
+func (v *Vocabulary) Delete(ctx context.Context, request *pb.IdRequest) (*pb.Vocabulary, error) {
+	vocabulary, err := v.vocabularyService.GetByID(ctx, uint64(request.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := v.vocabularyService.Delete(ctx, vocabulary.ID); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return vocabulary2pb(vocabulary), nil
+}
+
+func (v *Vocabulary) GetById(ctx context.Context, request *pb.IdRequest) (*pb.Vocabulary, error) { //nolint:revive,stylecheck
+	vocabulary, err := v.vocabularyService.GetByID(ctx, uint64(request.GetId()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return vocabulary2pb(vocabulary), nil
+}
+
+func (v *Vocabulary) GetList(ctx context.Context, request *pb.VocabularyFilterRequest) (*pb.VocabulariesResponse, error) {
+	vocabularies, err := v.vocabularyService.Get(ctx, &model.VocabularyFilter{
+		ParentID: int64pToUint64p(request.ParentId),
+		Name:     request.Name,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	var response = &pb.VocabulariesResponse{
+		Vocabularies: make([]*pb.Vocabulary, 0, len(vocabularies)),
+	}
+
+	for _, vocabulary := range vocabularies {
+		response.Vocabularies = append(response.Vocabularies, vocabulary2pb(vocabulary))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,123 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/grpc/pb"
+	"github.com/dmalykh/taxonomy/api/grpc/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func vocabularyClient(t *testing.T, vocabularyService taxonomy.Vocabulary) pb.VocabularyServiceClient {
+	t.Helper()
+
+	return pb.NewVocabularyServiceClient(dial(t, func(srv *grpc.Server) {
+		pb.RegisterVocabularyServiceServer(srv, service.NewVocabulary(vocabularyService))
+	}))
+}
+
+func TestVocabulary_Create(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: `ok`,
+			code: codes.OK,
+		},
+		{
+			name: `not unique name`,
+			err:  fmt.Errorf(`%w %w`, taxonomy.ErrVocabularyNotCreated, repository.ErrNotUniqueName),
+			code: codes.AlreadyExists,
+		},
+		{
+			name: `parent not found`,
+			err:  fmt.Errorf(`id %d %w`, 7, taxonomy.ErrVocabularyNotFound),
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+			captor := mock.Captor[*model.VocabularyData]()
+			mock.When(vocabularyService.Create(mock.Any[context.Context](), captor.Capture())).
+				ThenAnswer(func(args []any) []any {
+					if tt.err != nil {
+						return []any{nil, tt.err}
+					}
+
+					return []any{&model.Vocabulary{ID: 3, Data: *args[1].(*model.VocabularyData)}, nil}
+				})
+
+			client := vocabularyClient(t, vocabularyService)
+
+			got, err := client.Create(context.Background(), &pb.VocabularyCreateRequest{
+				Name:     `ram`,
+				Title:    `RAM`,
+				ParentId: proto.Int64(7),
+			})
+			require.Equal(t, tt.code, status.Code(err))
+
+			if tt.err == nil {
+				assert.Equal(t, int64(3), got.GetId())
+				assert.Equal(t, `ram`, got.GetName())
+				assert.Equal(t, int64(7), got.GetParentId())
+				assert.Nil(t, captor.Last().Description)
+			}
+		})
+	}
+}
+
+func TestVocabulary_Delete(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: `ok`,
+			code: codes.OK,
+		},
+		{
+			name: `vocabulary has terms`,
+			err:  taxonomy.ErrVocabularyHasTerms,
+			code: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+			mock.When(vocabularyService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](3))).
+				ThenReturn(&model.Vocabulary{ID: 3, Data: model.VocabularyData{Name: `ram`}}, nil)
+			mock.When(vocabularyService.Delete(mock.Any[context.Context](), mock.Equal[uint64](3))).
+				ThenReturn(tt.err)
+
+			client := vocabularyClient(t, vocabularyService)
+
+			got, err := client.Delete(context.Background(), &pb.IdRequest{Id: 3})
+			require.Equal(t, tt.code, status.Code(err))
+
+			if tt.err == nil {
+				assert.Equal(t, `ram`, got.GetName())
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/dmalykh/taxonomy/api/rest/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+)
+
+type Config struct {
+	Port              string
+	TermService       taxonomy.Term
+	VocabularyService taxonomy.Vocabulary
+	NamespaceService  taxonomy.Namespace
+	ReferenceService  taxonomy.Reference
+	SuggestService    taxonomy.Suggester
+	Verbose           bool
+}
+
+// NewHandler returns http.Handler with REST API.
+func NewHandler(config *Config) http.Handler {
+	handler := service.New(&service.Config{
+		TermService:       config.TermService,
+		VocabularyService: config.VocabularyService,
+		NamespaceService:  config.NamespaceService,
+		ReferenceService:  config.ReferenceService,
+		SuggestService:    config.SuggestService,
+	}).Handler()
+
+	if !config.Verbose {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Println(r.Method, r.URL.String())
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func Serve(config *Config) error {
+	log.Printf("REST API listens :%s, OpenAPI document is available on /openapi.json", config.Port)
+
+	return fmt.Errorf(`server error: %w`, http.ListenAndServe(":"+config.Port, NewHandler(config))) //nolint:gosec
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+)
+
+var ErrBadRequest = errors.New(`bad request`)
+
+// statusMap links taxonomy errors with HTTP statuses and error codes. Order matters: errors could be joined,
+// so the first matched wins.
+var statusMap = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{ErrBadRequest, http.StatusBadRequest, `bad_request`},
+	{context.Canceled, 499, `canceled`}, //nolint:gomnd
+	{context.DeadlineExceeded, http.StatusGatewayTimeout, `deadline_exceeded`},
+	{taxonomy.ErrTermNotFound, http.StatusNotFound, `term_not_found`},
+	{taxonomy.ErrVocabularyNotFound, http.StatusNotFound, `vocabulary_not_found`},
+	{taxonomy.ErrNamespaceNotFound, http.StatusNotFound, `namespace_not_found`},
+	{taxonomy.ErrReferenceExists, http.StatusConflict, `reference_exists`},
+	{taxonomy.ErrVocabularyHasTerms, http.StatusConflict, `vocabulary_has_terms`},
+	{taxonomy.ErrHierarchyCycle, http.StatusConflict, `hierarchy_cycle`},
+	{taxonomy.ErrHierarchyTooDeep, http.StatusConflict, `hierarchy_too_deep`},
+	{repository.ErrNotUniqueName, http.StatusConflict, `not_unique_name`},
+	{taxonomy.ErrTermNotUnique, http.StatusConflict, `not_unique_name`},
+	{taxonomy.ErrSynonymEmpty, http.StatusBadRequest, `synonym_empty`},
+	{taxonomy.ErrTermNotInVocabulary, http.StatusUnprocessableEntity, `term_not_in_vocabulary`},
+	{repository.ErrWithoutNamespace, http.StatusBadRequest, `namespace_required`},
+}
+
+// Error is the body of unsuccessful response.
+type Error struct {
+	Error ErrorDetail `json:"error"`
+}
+
+type ErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Path of hierarchy cycle
+	Path []uint64 `json:"path,omitempty"`
+}
+
+// toError converts error returned by taxonomy services to HTTP status and response body.
+func toError(err error) (int, *Error) {
+	for _, m := range statusMap {
+		if errors.Is(err, m.err) {
+			body := &Error{Error: ErrorDetail{Code: m.code, Message: err.Error()}}
+
+			var hierarchyErr *taxonomy.HierarchyError
+			if errors.As(err, &hierarchyErr) {
+				body.Error.Path = hierarchyErr.Path
+			}
+
+			return m.status, body
+		}
+	}
+
+	return http.StatusInternalServerError, &Error{Error: ErrorDetail{Code: `internal`, Message: err.Error()}}
+}
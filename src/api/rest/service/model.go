@@ -0,0 +1,156 @@
+package service
+
+import (
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+type Term struct {
+	ID           uint64   `json:"id"`
+	Name         string   `json:"name"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	VocabularyID []uint64 `json:"vocabulary_id"`
+}
+
+type TermInput struct {
+	Name         string   `json:"name"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	VocabularyID []uint64 `json:"vocabulary_id"`
+}
+
+type Vocabulary struct {
+	ID          uint64  `json:"id"`
+	Name        string  `json:"name"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	ParentID    *uint64 `json:"parent_id"`
+}
+
+type VocabularyInput struct {
+	Name        string  `json:"name"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	ParentID    *uint64 `json:"parent_id"`
+}
+
+type Namespace struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+type NamespaceInput struct {
+	Name string `json:"name"`
+}
+
+type Reference struct {
+	ID        uint64 `json:"id"`
+	TermID    uint64 `json:"term_id"`
+	Namespace string `json:"namespace"`
+	EntityID  string `json:"entity_id"`
+}
+
+// EntityTermsInput contains all terms of entity, terms which aren't listed will be unlinked from entity.
+// With VocabularyID only terms of the vocabulary are replaced. TermID is required, empty list unlinks all terms.
+type EntityTermsInput struct {
+	TermID       *[]uint64 `json:"term_id" required:"true"`
+	VocabularyID *uint64   `json:"vocabulary_id"`
+}
+
+// ReferenceChanges contains ids of terms related with and unrelated from entity by replacing its terms.
+type ReferenceChanges struct {
+	Added   []uint64 `json:"added"`
+	Removed []uint64 `json:"removed"`
+}
+
+// PageInfo contains cursors compatible with GraphQL API.
+type PageInfo struct {
+	StartCursor string `json:"start_cursor"`
+	EndCursor   string `json:"end_cursor"`
+	HasNextPage bool   `json:"has_next_page"`
+}
+
+type TermsPage struct {
+	Items    []*Term   `json:"items"`
+	PageInfo *PageInfo `json:"page_info"`
+}
+
+type TermMatch struct {
+	Term  *Term   `json:"term"`
+	Score float64 `json:"score"`
+	// name, synonym, title or description
+	Field string `json:"field"`
+}
+
+type TermMatches struct {
+	Items []*TermMatch `json:"items"`
+}
+
+// TermSuggestion is light to be requested on every keystroke, the term could be requested by its id.
+type TermSuggestion struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+	// Name, synonym or title completing the prefix
+	Text string `json:"text"`
+	// name, synonym or title
+	Field      string `json:"field"`
+	Popularity uint64 `json:"popularity"`
+}
+
+type TermSuggestions struct {
+	Items []*TermSuggestion `json:"items"`
+}
+
+type NamespacesPage struct {
+	Items    []*Namespace `json:"items"`
+	PageInfo *PageInfo    `json:"page_info"`
+}
+
+type ReferencesPage struct {
+	Items    []*Reference `json:"items"`
+	PageInfo *PageInfo    `json:"page_info"`
+}
+
+func term2rest(term *model.Term) *Term {
+	return &Term{
+		ID:           term.ID,
+		Name:         term.Data.Name,
+		Title:        term.Data.Title,
+		Description:  term.Data.Description,
+		VocabularyID: term.Data.VocabularyID,
+	}
+}
+
+func vocabulary2rest(vocabulary *model.Vocabulary) *Vocabulary {
+	return &Vocabulary{
+		ID:          vocabulary.ID,
+		Name:        vocabulary.Data.Name,
+		Title:       vocabulary.Data.Title,
+		Description: vocabulary.Data.Description,
+		ParentID:    vocabulary.Data.ParentID,
+	}
+}
+
+func namespace2rest(namespace *model.Namespace) *Namespace {
+	return &Namespace{
+		ID:   namespace.ID,
+		Name: namespace.Data.Name,
+	}
+}
+
+func reference2rest(reference *model.Reference) *Reference {
+	return &Reference{
+		ID:        reference.ID,
+		TermID:    reference.TermID,
+		Namespace: reference.Namespace,
+		EntityID:  string(reference.EntityID),
+	}
+}
+
+// changes2rest returns changes with empty lists instead of absent ones.
+func changes2rest(changes *model.ReferenceChanges) *ReferenceChanges {
+	return &ReferenceChanges{
+		Added:   append(make([]uint64, 0, len(changes.Added)), changes.Added...),
+		Removed: append(make([]uint64, 0, len(changes.Removed)), changes.Removed...),
+	}
+}
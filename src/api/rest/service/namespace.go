@@ -0,0 +1,129 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+var nsParam = Param{Name: `ns`, In: `path`, Type: `string`, Description: `namespace's name`, Required: true}
+
+func (s *Service) namespaceRoutes() []*Route {
+	return []*Route{
+		{
+			Method:   http.MethodGet,
+			Path:     `/namespaces`,
+			Summary:  `List of namespaces`,
+			Params:   paginationParams,
+			Response: NamespacesPage{},
+			Status:   http.StatusOK,
+			Handler:  s.getNamespaces,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     `/namespaces`,
+			Summary:  `Create namespace, name must be unique`,
+			Body:     NamespaceInput{},
+			Response: Namespace{},
+			Status:   http.StatusCreated,
+			Handler:  s.createNamespace,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     `/namespaces/{ns}`,
+			Summary:  `Get namespace`,
+			Params:   []Param{nsParam},
+			Response: Namespace{},
+			Status:   http.StatusOK,
+			Handler:  s.getNamespace,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     `/namespaces/{ns}`,
+			Summary:  `Rename namespace`,
+			Params:   []Param{nsParam},
+			Body:     NamespaceInput{},
+			Response: Namespace{},
+			Status:   http.StatusOK,
+			Handler:  s.updateNamespace,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    `/namespaces/{ns}`,
+			Summary: `Delete namespace, it fails when namespace has references`,
+			Params:  []Param{nsParam},
+			Status:  http.StatusNoContent,
+			Handler: s.deleteNamespace,
+		},
+	}
+}
+
+func (s *Service) getNamespaces(r *http.Request) (any, error) {
+	p, err := paginate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := s.namespaceService.Get(r.Context(), p.limit(), p.after)
+	if err != nil {
+		return nil, err
+	}
+
+	var response = new(NamespacesPage)
+	response.Items, response.PageInfo = page(namespaces, p, func(item *model.Namespace) uint64 {
+		return item.ID
+	}, namespace2rest)
+
+	return response, nil
+}
+
+func (s *Service) createNamespace(r *http.Request) (any, error) {
+	var input NamespaceInput
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	namespace, err := s.namespaceService.Create(r.Context(), input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return namespace2rest(namespace), nil
+}
+
+func (s *Service) getNamespace(r *http.Request) (any, error) {
+	namespace, err := s.namespaceService.GetByName(r.Context(), r.PathValue(`ns`))
+	if err != nil {
+		return nil, err
+	}
+
+	return namespace2rest(namespace), nil
+}
+
+func (s *Service) updateNamespace(r *http.Request) (any, error) {
+	var input NamespaceInput
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	namespace, err := s.namespaceService.GetByName(r.Context(), r.PathValue(`ns`))
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err = s.namespaceService.Update(r.Context(), namespace.ID, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return namespace2rest(namespace), nil
+}
+
+func (s *Service) deleteNamespace(r *http.Request) (any, error) {
+	namespace, err := s.namespaceService.GetByName(r.Context(), r.PathValue(`ns`))
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, s.namespaceService.Delete(r.Context(), namespace.ID)
+}
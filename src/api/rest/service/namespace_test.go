@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/rest/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespace_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{
+			name:   `ok`,
+			status: http.StatusNoContent,
+		},
+		{
+			name:   `references exist`,
+			err:    errors.Join(taxonomy.ErrReferenceExists, fmt.Errorf(`%d has %d references`, 3, 10)),
+			status: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			namespaceService := mock.Mock[taxonomy.Namespace]()
+			mock.When(namespaceService.GetByName(mock.Any[context.Context](), mock.Equal(`products`))).
+				ThenReturn(&model.Namespace{ID: 3, Data: model.NamespaceData{Name: `products`}}, nil)
+			mock.When(namespaceService.Delete(mock.Any[context.Context](), mock.Equal[uint64](3))).
+				ThenReturn(tt.err)
+
+			srv := server(t, &service.Config{NamespaceService: namespaceService})
+
+			assert.Equal(t, tt.status, request(t, srv, http.MethodDelete, `/namespaces/products`, ``, nil))
+		})
+	}
+}
+
+func TestNamespace_Update(t *testing.T) {
+	mock.SetUp(t)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.GetByName(mock.Any[context.Context](), mock.Equal(`products`))).
+		ThenReturn(&model.Namespace{ID: 3, Data: model.NamespaceData{Name: `products`}}, nil)
+	mock.When(namespaceService.Update(mock.Any[context.Context](), mock.Equal[uint64](3), mock.Equal(`goods`))).
+		ThenReturn(&model.Namespace{ID: 3, Data: model.NamespaceData{Name: `goods`}}, nil)
+
+	srv := server(t, &service.Config{NamespaceService: namespaceService})
+
+	var got service.Namespace
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodPut, `/namespaces/products`, `{"name": "goods"}`, &got))
+	assert.Equal(t, `goods`, got.Name)
+	assert.Equal(t, uint64(3), got.ID)
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// OpenAPI returns OpenAPI 3 document which describes routes. Schemas of request and response bodies are built
+// from Body and Response types of routes with their json tags.
+func OpenAPI(routes []*Route) map[string]any {
+	var (
+		schemas = make(map[string]any)
+		paths   = make(map[string]map[string]any)
+	)
+
+	for _, route := range routes {
+		if _, ok := paths[route.Path]; !ok {
+			paths[route.Path] = make(map[string]any)
+		}
+
+		paths[route.Path][strings.ToLower(route.Method)] = operation(route, schemas)
+	}
+
+	return map[string]any{
+		`openapi`: `3.0.3`,
+		`info`: map[string]any{
+			`title`:   `Taxonomy REST API`,
+			`version`: `1.0.0`,
+		},
+		`paths`: paths,
+		`components`: map[string]any{
+			`schemas`: schemas,
+		},
+	}
+}
+
+func operation(route *Route, schemas map[string]any) map[string]any {
+	var (
+		op = map[string]any{
+			`summary`: route.Summary,
+		}
+		response = map[string]any{
+			`description`: http.StatusText(route.Status),
+		}
+	)
+
+	if len(route.Params) > 0 {
+		var parameters = make([]map[string]any, 0, len(route.Params))
+		for _, param := range route.Params {
+			parameters = append(parameters, parameter(param))
+		}
+
+		op[`parameters`] = parameters
+	}
+
+	if route.Body != nil {
+		op[`requestBody`] = map[string]any{
+			`required`: true,
+			`content`:  content(reflect.TypeOf(route.Body), schemas),
+		}
+	}
+
+	if route.Response != nil {
+		response[`content`] = content(reflect.TypeOf(route.Response), schemas)
+	}
+
+	op[`responses`] = map[string]any{
+		strconv.Itoa(route.Status): response,
+		`default`: map[string]any{
+			`description`: `Error`,
+			`content`:     content(reflect.TypeOf(Error{}), schemas),
+		},
+	}
+
+	return op
+}
+
+func parameter(param Param) map[string]any {
+	var p = map[string]any{
+		`name`:     param.Name,
+		`in`:       param.In,
+		`required`: param.Required,
+		`schema`:   map[string]any{`type`: param.Type},
+	}
+
+	if param.Type == `array` {
+		p[`schema`] = map[string]any{`type`: `array`, `items`: map[string]any{`type`: `string`}}
+		p[`explode`] = true
+	}
+
+	if param.Description != `` {
+		p[`description`] = param.Description
+	}
+
+	return p
+}
+
+func content(t reflect.Type, schemas map[string]any) map[string]any {
+	return map[string]any{
+		`application/json`: map[string]any{
+			`schema`: schema(t, schemas),
+		},
+	}
+}
+
+// schema returns JSON schema of type, structs are added to schemas and referenced by name.
+func schema(t reflect.Type, schemas map[string]any) map[string]any {
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Pointer:
+		s := schema(t.Elem(), schemas)
+		if t.Elem().Kind() != reflect.Struct {
+			s[`nullable`] = true
+		}
+
+		return s
+	case reflect.Slice:
+		return map[string]any{`type`: `array`, `items`: schema(t.Elem(), schemas)}
+	case reflect.Struct:
+		if _, ok := schemas[t.Name()]; !ok {
+			// Register name before fields to support recursive types
+			schemas[t.Name()] = nil
+
+			var (
+				properties = make(map[string]any, t.NumField())
+				required   = make([]string, 0)
+			)
+
+			for i := 0; i < t.NumField(); i++ {
+				name, _, _ := strings.Cut(t.Field(i).Tag.Get(`json`), `,`)
+				if name == `` || name == `-` {
+					continue
+				}
+
+				property := schema(t.Field(i).Type, schemas)
+
+				// Required field is a pointer to tell absent field from empty one, but it can't be null
+				if t.Field(i).Tag.Get(`required`) == `true` {
+					delete(property, `nullable`)
+					required = append(required, name)
+				}
+
+				properties[name] = property
+			}
+
+			var object = map[string]any{`type`: `object`, `properties`: properties}
+			if len(required) > 0 {
+				object[`required`] = required
+			}
+
+			schemas[t.Name()] = object
+		}
+
+		return map[string]any{`$ref`: `#/components/schemas/` + t.Name()}
+	case reflect.Bool:
+		return map[string]any{`type`: `boolean`}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{`type`: `integer`, `format`: `int64`}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{`type`: `number`}
+	default:
+		return map[string]any{`type`: `string`}
+	}
+}
@@ -0,0 +1,45 @@
+package service_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/rest/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPI(t *testing.T) {
+	srv := server(t, &service.Config{})
+
+	var document struct {
+		OpenAPI    string                               `json:"openapi"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]any `json:"properties"`
+				Required   []string                  `json:"required"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodGet, `/openapi.json`, ``, &document))
+
+	assert.Equal(t, `3.0.3`, document.OpenAPI)
+
+	// Every route is described
+	for _, route := range service.New(&service.Config{}).Routes() {
+		assert.Contains(t, document.Paths[route.Path], map[string]string{
+			http.MethodGet: `get`, http.MethodPost: `post`, http.MethodPut: `put`,
+			http.MethodPatch: `patch`, http.MethodDelete: `delete`,
+		}[route.Method], route.Pattern())
+	}
+
+	assert.Contains(t, document.Paths[`/namespaces/{ns}/entities/{id}/terms`], `put`)
+	assert.Equal(t, `array`, document.Components.Schemas[`Term`].Properties[`vocabulary_id`][`type`])
+	// Replacing terms responds with changes of entity's terms
+	assert.Equal(t, `array`, document.Components.Schemas[`ReferenceChanges`].Properties[`added`][`type`])
+	assert.Equal(t, `array`, document.Components.Schemas[`ReferenceChanges`].Properties[`removed`][`type`])
+	assert.Equal(t, []string{`term_id`}, document.Components.Schemas[`EntityTermsInput`].Required)
+	assert.NotContains(t, document.Components.Schemas[`EntityTermsInput`].Properties[`term_id`], `nullable`)
+	assert.Equal(t, `#/components/schemas/PageInfo`, document.Components.Schemas[`TermsPage`].Properties[`page_info`][`$ref`])
+}
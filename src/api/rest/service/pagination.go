@@ -0,0 +1,77 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
+)
+
+const (
+	defaultFirst = 20
+	suggestFirst = 10
+	maxFirst     = 100
+)
+
+var paginationParams = []Param{
+	{Name: `first`, In: `query`, Type: `integer`, Description: `count of items, 20 by default and 100 at most`},
+	{Name: `after`, In: `query`, Type: `string`, Description: `end_cursor of the previous page`},
+}
+
+type pagination struct {
+	first uint
+	after *uint64
+}
+
+// paginate parses first and after query parameters. Cursors are the same as in GraphQL API.
+func paginate(r *http.Request) (*pagination, error) {
+	var p = &pagination{first: defaultFirst}
+
+	if first := r.URL.Query().Get(`first`); first != `` {
+		value, err := strconv.ParseUint(first, 10, 32)
+		if err != nil || value == 0 {
+			return nil, fmt.Errorf(`%w: wrong first %q`, ErrBadRequest, first)
+		}
+
+		p.first = min(uint(value), maxFirst)
+	}
+
+	if after := r.URL.Query().Get(`after`); after != `` {
+		var id uint
+		if err := cursor.Unmarshal(after, &id); err != nil {
+			return nil, fmt.Errorf(`%w: %w`, ErrBadRequest, err)
+		}
+
+		afterID := uint64(id)
+		p.after = &afterID
+	}
+
+	return p, nil
+}
+
+// limit returns count of items to request, one extra item is used to obtain HasNextPage.
+func (p *pagination) limit() uint {
+	return p.first + 1
+}
+
+// page cuts the extra item and converts items.
+func page[T any, R any](items []T, p *pagination, id func(item T) uint64, convert func(item T) R) ([]R, *PageInfo) {
+	var info = &PageInfo{HasNextPage: uint(len(items)) > p.first}
+
+	if info.HasNextPage {
+		items = items[:p.first]
+	}
+
+	var converted = make([]R, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, convert(item))
+	}
+
+	if len(items) > 0 {
+		info.StartCursor = cursor.Marshal(uint(id(items[0])))
+		info.EndCursor = cursor.Marshal(uint(id(items[len(items)-1])))
+	}
+
+	return converted, info
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+func (s *Service) referenceRoutes() []*Route {
+	var entityParams = []Param{nsParam, {Name: `id`, In: `path`, Type: `string`, Description: `entity's id`, Required: true}}
+
+	return []*Route{
+		{
+			Method:  http.MethodGet,
+			Path:    `/references`,
+			Summary: `List of references`,
+			Params: append([]Param{
+				{Name: `namespace`, In: `query`, Type: `array`, Description: `at least one namespace required`, Required: true},
+				{
+					Name: `term_id`, In: `query`, Type: `array`,
+					Description: `group of comma separated terms joined with "OR", between groups "AND" used, ` +
+						`i.e. term_id=92,23&term_id=43,58`,
+				},
+				{Name: `entity_id`, In: `query`, Type: `array`},
+			}, paginationParams...),
+			Response: ReferencesPage{},
+			Status:   http.StatusOK,
+			Handler:  s.getReferences,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     `/namespaces/{ns}/entities/{id}/terms`,
+			Summary:  `List of entity's terms`,
+			Params:   entityParams,
+			Response: []Term{},
+			Status:   http.StatusOK,
+			Handler:  s.getEntityTerms,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     `/namespaces/{ns}/entities/{id}/terms`,
+			Summary:  `Set entity's terms or terms of the vocabulary, terms which aren't listed are unlinked`,
+			Params:   entityParams,
+			Body:     EntityTermsInput{},
+			Response: ReferenceChanges{},
+			Status:   http.StatusOK,
+			Handler:  s.setEntityTerms,
+		},
+	}
+}
+
+func (s *Service) getReferences(r *http.Request) (any, error) {
+	p, err := paginate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	termID, err := queryTermGroups(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit = p.limit()
+
+	references, err := s.referenceService.Get(r.Context(), &model.ReferenceFilter{
+		TermID:    termID,
+		Namespace: r.URL.Query()[`namespace`],
+		EntityID: func(values []string) []model.EntityID {
+			var entities = make([]model.EntityID, 0, len(values))
+			for _, value := range values {
+				entities = append(entities, model.EntityID(value))
+			}
+
+			return entities
+		}(r.URL.Query()[`entity_id`]),
+		AfterID: p.after,
+		Limit:   &limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response = new(ReferencesPage)
+	response.Items, response.PageInfo = page(references, p, func(item *model.Reference) uint64 {
+		return item.ID
+	}, reference2rest)
+
+	return response, nil
+}
+
+// queryTermGroups parses term_id query parameters, every parameter contains comma separated group of terms.
+func queryTermGroups(r *http.Request) ([][]uint64, error) {
+	var groups = make([][]uint64, 0)
+
+	for _, value := range r.URL.Query()[`term_id`] {
+		var group = make([]uint64, 0)
+
+		for _, term := range strings.Split(value, `,`) {
+			id, err := strconv.ParseUint(strings.TrimSpace(term), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf(`%w: wrong term_id %q`, ErrBadRequest, value)
+			}
+
+			group = append(group, id)
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+func (s *Service) getEntityTerms(r *http.Request) (any, error) {
+	return s.entityTerms(r, r.PathValue(`ns`), model.EntityID(r.PathValue(`id`)))
+}
+
+func (s *Service) setEntityTerms(r *http.Request) (any, error) {
+	var (
+		namespace = r.PathValue(`ns`)
+		entityID  = model.EntityID(r.PathValue(`id`))
+		input     EntityTermsInput
+	)
+
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	if input.TermID == nil {
+		return nil, fmt.Errorf(`%w: term_id is required`, ErrBadRequest)
+	}
+
+	// Terms are replaced in one transaction, so the entity keeps its terms when any of them fails
+	var (
+		changes *model.ReferenceChanges
+		err     error
+	)
+
+	if input.VocabularyID != nil {
+		changes, err = s.referenceService.ReplaceInVocabulary(r.Context(), namespace, entityID, *input.VocabularyID,
+			*input.TermID)
+	} else {
+		changes, err = s.referenceService.Replace(r.Context(), namespace, entityID, *input.TermID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return changes2rest(changes), nil
+}
+
+func (s *Service) entityTerms(r *http.Request, namespace string, entityID model.EntityID) ([]*Term, error) {
+	entities, err := s.referenceService.GetTerms(r.Context(), namespace, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		terms = make([]*Term, 0)
+		seen  = make(map[uint64]bool)
+	)
+
+	// Term of several vocabularies is in every group, so it's taken once
+	for _, entity := range entities {
+		for _, group := range entity.Vocabularies {
+			for _, term := range group.Terms {
+				if seen[term.ID] {
+					continue
+				}
+
+				seen[term.ID] = true
+				terms = append(terms, term2rest(term))
+			}
+		}
+	}
+
+	slices.SortFunc(terms, func(a, b *Term) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return terms, nil
+}
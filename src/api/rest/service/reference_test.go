@@ -0,0 +1,130 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/rest/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReference_Get(t *testing.T) {
+	t.Run(`namespace required`, func(t *testing.T) {
+		mock.SetUp(t)
+
+		referenceService := mock.Mock[taxonomy.Reference]()
+		mock.When(referenceService.Get(mock.Any[context.Context](), mock.Any[*model.ReferenceFilter]())).
+			ThenReturn(nil, repository.ErrWithoutNamespace)
+
+		srv := server(t, &service.Config{ReferenceService: referenceService})
+
+		var e service.Error
+		require.Equal(t, http.StatusBadRequest, request(t, srv, http.MethodGet, `/references?entity_id=1`, ``, &e))
+		assert.Equal(t, `namespace_required`, e.Error.Code)
+	})
+
+	t.Run(`term groups`, func(t *testing.T) {
+		mock.SetUp(t)
+
+		referenceService := mock.Mock[taxonomy.Reference]()
+		captor := mock.Captor[*model.ReferenceFilter]()
+		mock.When(referenceService.Get(mock.Any[context.Context](), captor.Capture())).
+			ThenReturn([]*model.Reference{{ID: 5, TermID: 92, Namespace: `laptops`, EntityID: `dell-xps`}}, nil)
+
+		srv := server(t, &service.Config{ReferenceService: referenceService})
+
+		var got service.ReferencesPage
+		require.Equal(t, http.StatusOK, request(t, srv, http.MethodGet,
+			`/references?namespace=laptops&term_id=92,23&term_id=43`, ``, &got))
+
+		assert.Equal(t, [][]uint64{{92, 23}, {43}}, captor.Last().TermID)
+		assert.Equal(t, []string{`laptops`}, captor.Last().Namespace)
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, `dell-xps`, got.Items[0].EntityID)
+		assert.False(t, got.PageInfo.HasNextPage)
+	})
+}
+
+func TestReference_SetEntityTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Replace(mock.Any[context.Context](), mock.Equal(`laptops`),
+		mock.Equal[model.EntityID](`dell`), mock.Equal([]uint64{5, 6}))).
+		ThenReturn(&model.ReferenceChanges{Added: []uint64{6}, Removed: []uint64{3, 4}}, nil)
+
+	srv := server(t, &service.Config{ReferenceService: referenceService})
+
+	var got service.ReferenceChanges
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodPut, `/namespaces/laptops/entities/dell/terms`,
+		`{"term_id": [5, 6]}`, &got))
+
+	assert.Equal(t, []uint64{6}, got.Added)
+	assert.Equal(t, []uint64{3, 4}, got.Removed)
+}
+
+func TestReference_SetEntityTermsWithoutTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	srv := server(t, &service.Config{ReferenceService: referenceService})
+
+	// Absent or misspelled term_id doesn't unlink all terms
+	for _, body := range []string{`{}`, `{"termId": [1]}`, `{"term_id": null}`} {
+		var e service.Error
+		require.Equal(t, http.StatusBadRequest, request(t, srv, http.MethodPut, `/namespaces/laptops/entities/dell/terms`,
+			body, &e), body)
+		assert.Equal(t, `bad_request`, e.Error.Code)
+	}
+
+	mock.Verify(referenceService, mock.Never()).Replace(mock.Any[context.Context](), mock.Any[string](),
+		mock.Any[model.EntityID](), mock.Any[[]uint64]())
+
+	// Empty list unlinks all terms
+	mock.When(referenceService.Replace(mock.Any[context.Context](), mock.Equal(`laptops`),
+		mock.Equal[model.EntityID](`dell`), mock.Equal([]uint64{}))).
+		ThenReturn(&model.ReferenceChanges{Removed: []uint64{4}}, nil)
+
+	var got map[string][]uint64
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodPut, `/namespaces/laptops/entities/dell/terms`,
+		`{"term_id": []}`, &got))
+	assert.Equal(t, map[string][]uint64{`added`: {}, `removed`: {4}}, got)
+}
+
+func TestReference_SetEntityTermsNotFound(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Replace(mock.Any[context.Context](), mock.Any[string](),
+		mock.Any[model.EntityID](), mock.Any[[]uint64]())).
+		ThenReturn(nil, taxonomy.ErrTermNotFound)
+
+	srv := server(t, &service.Config{ReferenceService: referenceService})
+
+	// Nothing is changed when any term fails
+	var e service.Error
+	require.Equal(t, http.StatusNotFound, request(t, srv, http.MethodPut, `/namespaces/laptops/entities/dell/terms`,
+		`{"term_id": [5, 404]}`, &e))
+}
+
+func TestReference_SetEntityTermsNotInVocabulary(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.ReplaceInVocabulary(mock.Any[context.Context](), mock.Equal(`laptops`),
+		mock.Equal[model.EntityID](`dell`), mock.Equal[uint64](7), mock.Equal([]uint64{5}))).
+		ThenReturn(nil, taxonomy.ErrTermNotInVocabulary)
+
+	srv := server(t, &service.Config{ReferenceService: referenceService})
+
+	var e service.Error
+	require.Equal(t, http.StatusUnprocessableEntity, request(t, srv, http.MethodPut,
+		`/namespaces/laptops/entities/dell/terms`, `{"term_id": [5], "vocabulary_id": 7}`, &e))
+	assert.Equal(t, `term_not_in_vocabulary`, e.Error.Code)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Param describes path or query parameter of route.
+type Param struct {
+	Name        string
+	In          string // path or query
+	Type        string // integer, string or array
+	Description string
+	Required    bool
+}
+
+// Route links HTTP method and path with handler. Body and Response contain zero values of types which are used to
+// describe request and response in OpenAPI document.
+type Route struct {
+	Method   string
+	Path     string
+	Summary  string
+	Params   []Param
+	Body     any
+	Response any
+	Status   int
+	Handler  func(r *http.Request) (any, error)
+}
+
+// Pattern returns pattern for http.ServeMux.
+func (r *Route) Pattern() string {
+	return r.Method + ` ` + r.Path
+}
+
+// ServeHTTP calls handler and writes its result or error as JSON.
+func (r *Route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	response, err := r.Handler(req)
+	if err != nil {
+		status, body := toError(err)
+		writeJSON(w, status, body)
+
+		return
+	}
+
+	if r.Status == http.StatusNoContent {
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	writeJSON(w, r.Status, response)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set(`Content-Type`, `application/json`)
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decode reads body to v, unknown fields are rejected so misspelled fields aren't taken as absent ones.
+func decode(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf(`%w: wrong body %w`, ErrBadRequest, err)
+	}
+
+	return nil
+}
+
+func pathID(r *http.Request, name string) (uint64, error) {
+	id, err := strconv.ParseUint(r.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf(`%w: wrong %s %q`, ErrBadRequest, name, r.PathValue(name))
+	}
+
+	return id, nil
+}
+
+func queryIDs(r *http.Request, name string) ([]uint64, error) {
+	var ids = make([]uint64, 0)
+
+	for _, value := range r.URL.Query()[name] {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf(`%w: wrong %s %q`, ErrBadRequest, name, value), err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
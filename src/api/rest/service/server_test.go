@@ -0,0 +1,46 @@
+package service_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/rest/service"
+	"github.com/stretchr/testify/require"
+)
+
+func server(t *testing.T, config *service.Config) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(service.New(config).Handler())
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// request sends request with JSON body and decodes response to v, returns status code.
+func request(t *testing.T, srv *httptest.Server, method, path, body string, v any) int {
+	t.Helper()
+
+	var reader io.Reader
+	if body != `` {
+		reader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, srv.URL+path, reader)
+	require.NoError(t, err)
+
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	if v != nil && resp.StatusCode != http.StatusNoContent {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+	}
+
+	return resp.StatusCode
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+)
+
+type Config struct {
+	TermService       taxonomy.Term
+	VocabularyService taxonomy.Vocabulary
+	NamespaceService  taxonomy.Namespace
+	ReferenceService  taxonomy.Reference
+	SuggestService    taxonomy.Suggester
+}
+
+type Service struct {
+	termService       taxonomy.Term
+	vocabularyService taxonomy.Vocabulary
+	namespaceService  taxonomy.Namespace
+	referenceService  taxonomy.Reference
+	suggestService    taxonomy.Suggester
+}
+
+func New(config *Config) *Service {
+	return &Service{
+		termService:       config.TermService,
+		vocabularyService: config.VocabularyService,
+		namespaceService:  config.NamespaceService,
+		referenceService:  config.ReferenceService,
+		suggestService:    config.SuggestService,
+	}
+}
+
+// Routes returns all routes of REST API.
+func (s *Service) Routes() []*Route {
+	var routes = make([]*Route, 0)
+
+	routes = append(routes, s.termRoutes()...)
+	routes = append(routes, s.vocabularyRoutes()...)
+	routes = append(routes, s.namespaceRoutes()...)
+	routes = append(routes, s.referenceRoutes()...)
+
+	return routes
+}
+
+// Handler returns http.Handler with all routes and OpenAPI document on /openapi.json.
+func (s *Service) Handler() http.Handler {
+	var (
+		mux    = http.NewServeMux()
+		routes = s.Routes()
+	)
+
+	for _, route := range routes {
+		mux.Handle(route.Pattern(), route)
+	}
+
+	document := OpenAPI(routes)
+	mux.HandleFunc(`GET /openapi.json`, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, document)
+	})
+
+	return mux
+}
+
+var idParam = Param{Name: `id`, In: `path`, Type: `integer`, Required: true}
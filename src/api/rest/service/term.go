@@ -0,0 +1,294 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+func (s *Service) termRoutes() []*Route {
+	return []*Route{
+		{
+			Method:  http.MethodGet,
+			Path:    `/terms`,
+			Summary: `List of terms`,
+			Params: append([]Param{
+				{Name: `vocabulary_id`, In: `query`, Type: `array`, Description: `terms of any of vocabularies`},
+				{Name: `name`, In: `query`, Type: `string`},
+			}, paginationParams...),
+			Response: TermsPage{},
+			Status:   http.StatusOK,
+			Handler:  s.getTerms,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    `/terms/search`,
+			Summary: `Search terms by names, synonyms, titles and descriptions, the most relevant terms go first`,
+			Params: []Param{
+				{Name: `q`, In: `query`, Type: `string`, Required: true},
+				{Name: `mode`, In: `query`, Type: `string`, Description: `prefix by default, substring or fuzzy`},
+				{Name: `vocabulary_id`, In: `query`, Type: `array`, Description: `terms of any of vocabularies`},
+				{Name: `first`, In: `query`, Type: `integer`, Description: `count of items, 20 by default and 100 at most`},
+			},
+			Response: TermMatches{},
+			Status:   http.StatusOK,
+			Handler:  s.searchTerms,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    `/terms/suggest`,
+			Summary: `Complete the prefix with names, synonyms and titles of terms for typeahead`,
+			Params: []Param{
+				{Name: `prefix`, In: `query`, Type: `string`, Required: true},
+				{Name: `vocabulary_id`, In: `query`, Type: `array`, Description: `terms of any of vocabularies`},
+				{Name: `namespace`, In: `query`, Type: `string`,
+					Description: `terms used by more entities of the namespace go first`},
+				{Name: `first`, In: `query`, Type: `integer`, Description: `count of items, 10 by default and 100 at most`},
+			},
+			Response: TermSuggestions{},
+			Status:   http.StatusOK,
+			Handler:  s.suggestTerms,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     `/terms`,
+			Summary:  `Create term`,
+			Body:     TermInput{},
+			Response: Term{},
+			Status:   http.StatusCreated,
+			Handler:  s.createTerm,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     `/terms/{id}`,
+			Summary:  `Get term`,
+			Params:   []Param{idParam},
+			Response: Term{},
+			Status:   http.StatusOK,
+			Handler:  s.getTerm,
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     `/terms/{id}`,
+			Summary:  `Update term, empty fields keep their values`,
+			Params:   []Param{idParam},
+			Body:     TermInput{},
+			Response: Term{},
+			Status:   http.StatusOK,
+			Handler:  s.updateTerm,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    `/terms/{id}`,
+			Summary: `Delete term, it fails when term has references`,
+			Params:  []Param{idParam},
+			Status:  http.StatusNoContent,
+			Handler: s.deleteTerm,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     `/vocabularies/{id}/terms`,
+			Summary:  `List of vocabulary's terms`,
+			Params:   append([]Param{idParam}, paginationParams...),
+			Response: TermsPage{},
+			Status:   http.StatusOK,
+			Handler:  s.getVocabularyTerms,
+		},
+	}
+}
+
+func (s *Service) getTerms(r *http.Request) (any, error) {
+	vocabularyID, err := queryIDs(r, `vocabulary_id`)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter = &model.TermFilter{VocabularyID: vocabularyID}
+	if name := r.URL.Query().Get(`name`); name != `` {
+		filter.Name = &name
+	}
+
+	return s.terms(r, filter)
+}
+
+var searchModes = map[string]model.SearchMode{
+	``:          model.SearchPrefix,
+	`prefix`:    model.SearchPrefix,
+	`substring`: model.SearchSubstring,
+	`fuzzy`:     model.SearchFuzzy,
+}
+
+func (s *Service) searchTerms(r *http.Request) (any, error) {
+	vocabularyID, err := queryIDs(r, `vocabulary_id`)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, ok := searchModes[r.URL.Query().Get(`mode`)]
+	if !ok {
+		return nil, fmt.Errorf(`%w: wrong mode %q`, ErrBadRequest, r.URL.Query().Get(`mode`))
+	}
+
+	// Search results aren't paginated, so after is ignored
+	p, err := paginate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.termService.Search(r.Context(), &model.TermSearch{
+		Query:        r.URL.Query().Get(`q`),
+		Mode:         mode,
+		VocabularyID: vocabularyID,
+		Limit:        p.first,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response = &TermMatches{Items: make([]*TermMatch, len(matches))}
+	for i, match := range matches {
+		response.Items[i] = &TermMatch{Term: term2rest(match.Term), Score: match.Score, Field: string(match.Field)}
+	}
+
+	return response, nil
+}
+
+func (s *Service) suggestTerms(r *http.Request) (any, error) {
+	vocabularyID, err := queryIDs(r, `vocabulary_id`)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit uint = suggestFirst
+
+	if first := r.URL.Query().Get(`first`); first != `` {
+		value, err := strconv.ParseUint(first, 10, 32)
+		if err != nil || value == 0 {
+			return nil, fmt.Errorf(`%w: wrong first %q`, ErrBadRequest, first)
+		}
+
+		limit = min(uint(value), maxFirst)
+	}
+
+	suggestions, err := s.suggestService.Suggest(r.Context(), &model.TermSuggest{
+		Prefix:       r.URL.Query().Get(`prefix`),
+		VocabularyID: vocabularyID,
+		Namespace:    r.URL.Query().Get(`namespace`),
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response = &TermSuggestions{Items: make([]*TermSuggestion, len(suggestions))}
+	for i, suggestion := range suggestions {
+		response.Items[i] = &TermSuggestion{
+			ID:         suggestion.Term.ID,
+			Name:       suggestion.Term.Data.Name,
+			Text:       suggestion.Text,
+			Field:      string(suggestion.Field),
+			Popularity: suggestion.Popularity,
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Service) getVocabularyTerms(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.terms(r, &model.TermFilter{VocabularyID: []uint64{id}})
+}
+
+func (s *Service) terms(r *http.Request, filter *model.TermFilter) (*TermsPage, error) {
+	p, err := paginate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Limit = p.limit()
+	filter.AfterID = p.after
+
+	terms, err := s.termService.Get(r.Context(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var response = new(TermsPage)
+	response.Items, response.PageInfo = page(terms, p, func(item *model.Term) uint64 {
+		return item.ID
+	}, term2rest)
+
+	return response, nil
+}
+
+func (s *Service) createTerm(r *http.Request) (any, error) {
+	var input TermInput
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	term, err := s.termService.Create(r.Context(), &model.TermData{
+		Name:         input.Name,
+		Title:        input.Title,
+		Description:  input.Description,
+		VocabularyID: input.VocabularyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return term2rest(term), nil
+}
+
+func (s *Service) getTerm(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := s.termService.GetByID(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return term2rest(term), nil
+}
+
+func (s *Service) updateTerm(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	var input TermInput
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	term, err := s.termService.Update(r.Context(), id, &model.TermData{
+		Name:         input.Name,
+		Title:        input.Title,
+		Description:  input.Description,
+		VocabularyID: input.VocabularyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return term2rest(term), nil
+}
+
+func (s *Service) deleteTerm(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, s.termService.Delete(r.Context(), id)
+}
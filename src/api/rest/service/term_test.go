@@ -0,0 +1,199 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/api/graphql/service/cursor"
+	"github.com/dmalykh/taxonomy/api/rest/service"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerm_Get(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{
+			name:   `ok`,
+			status: http.StatusOK,
+		},
+		{
+			name:   `not found`,
+			err:    fmt.Errorf(`%w %d`, taxonomy.ErrTermNotFound, 42),
+			status: http.StatusNotFound,
+			code:   `term_not_found`,
+		},
+		{
+			name:   `unknown error`,
+			err:    io.EOF,
+			status: http.StatusInternalServerError,
+			code:   `internal`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termService := mock.Mock[taxonomy.Term]()
+			mock.When(termService.GetByID(mock.Any[context.Context](), mock.Equal[uint64](42))).
+				ThenAnswer(func(args []any) []any {
+					if tt.err != nil {
+						return []any{nil, tt.err}
+					}
+
+					return []any{&model.Term{ID: 42, Data: model.TermData{Name: `laptop`, VocabularyID: []uint64{4}}}, nil}
+				})
+
+			srv := server(t, &service.Config{TermService: termService})
+
+			if tt.err == nil {
+				var term service.Term
+				require.Equal(t, tt.status, request(t, srv, http.MethodGet, `/terms/42`, ``, &term))
+				assert.Equal(t, `laptop`, term.Name)
+				assert.Equal(t, []uint64{4}, term.VocabularyID)
+
+				return
+			}
+
+			var e service.Error
+			require.Equal(t, tt.status, request(t, srv, http.MethodGet, `/terms/42`, ``, &e))
+			assert.Equal(t, tt.code, e.Error.Code)
+		})
+	}
+}
+
+func TestTerm_GetWrongID(t *testing.T) {
+	srv := server(t, &service.Config{})
+
+	var e service.Error
+	require.Equal(t, http.StatusBadRequest, request(t, srv, http.MethodGet, `/terms/laptop`, ``, &e))
+	assert.Equal(t, `bad_request`, e.Error.Code)
+}
+
+func TestTerm_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{
+			name:   `ok`,
+			status: http.StatusNoContent,
+		},
+		{
+			name:   `references exist`,
+			err:    fmt.Errorf(`can't remove term %d: %d %w`, 42, 2, taxonomy.ErrReferenceExists),
+			status: http.StatusConflict,
+		},
+		{
+			name:   `not found`,
+			err:    errors.Join(taxonomy.ErrTermNotFound, io.EOF),
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termService := mock.Mock[taxonomy.Term]()
+			mock.When(termService.Delete(mock.Any[context.Context](), mock.Equal[uint64](42))).
+				ThenReturn(tt.err)
+
+			srv := server(t, &service.Config{TermService: termService})
+
+			assert.Equal(t, tt.status, request(t, srv, http.MethodDelete, `/terms/42`, ``, nil))
+		})
+	}
+}
+
+func TestTerm_VocabularyTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	captor := mock.Captor[*model.TermFilter]()
+	mock.When(termService.Get(mock.Any[context.Context](), captor.Capture())).
+		ThenReturn([]*model.Term{{ID: 11}, {ID: 12}, {ID: 13}}, nil)
+
+	srv := server(t, &service.Config{TermService: termService})
+
+	var got service.TermsPage
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodGet,
+		`/vocabularies/4/terms?first=2&after=`+cursor.Marshal(uint(10)), ``, &got))
+
+	// One extra term requested to know about next page
+	assert.Equal(t, uint(3), captor.Last().Limit)
+	assert.Equal(t, uint64(10), *captor.Last().AfterID)
+	assert.Equal(t, []uint64{4}, captor.Last().VocabularyID)
+
+	require.Len(t, got.Items, 2)
+	assert.True(t, got.PageInfo.HasNextPage)
+
+	// Cursors are compatible with GraphQL API
+	var end uint
+	require.NoError(t, cursor.Unmarshal(got.PageInfo.EndCursor, &end))
+	assert.Equal(t, uint(12), end)
+}
+
+func TestTerm_Search(t *testing.T) {
+	mock.SetUp(t)
+
+	termService := mock.Mock[taxonomy.Term]()
+	captor := mock.Captor[*model.TermSearch]()
+	mock.When(termService.Search(mock.Any[context.Context](), captor.Capture())).
+		ThenReturn([]*model.TermMatch{
+			{Term: &model.Term{ID: 3, Data: model.TermData{Name: `reddish`}}, Score: 0.5, Field: model.SearchName},
+		}, nil)
+
+	srv := server(t, &service.Config{TermService: termService})
+
+	var got service.TermMatches
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodGet,
+		`/terms/search?q=redish&mode=fuzzy&vocabulary_id=4&first=5`, ``, &got))
+
+	assert.Equal(t, &model.TermSearch{Query: `redish`, Mode: model.SearchFuzzy, VocabularyID: []uint64{4}, Limit: 5},
+		captor.Last())
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, `reddish`, got.Items[0].Term.Name)
+	assert.Equal(t, `name`, got.Items[0].Field)
+
+	var e service.Error
+	require.Equal(t, http.StatusBadRequest, request(t, srv, http.MethodGet, `/terms/search?q=red&mode=exact`, ``, &e))
+	assert.Equal(t, `bad_request`, e.Error.Code)
+}
+
+func TestTerm_Suggest(t *testing.T) {
+	mock.SetUp(t)
+
+	suggestService := mock.Mock[taxonomy.Suggester]()
+	captor := mock.Captor[*model.TermSuggest]()
+	mock.When(suggestService.Suggest(mock.Any[context.Context](), captor.Capture())).
+		ThenReturn([]*model.TermSuggestion{
+			{Term: &model.Term{ID: 3, Data: model.TermData{Name: `reddish`}}, Text: `rosy`, Field: model.SearchSynonym,
+				Popularity: 12},
+		}, nil)
+
+	srv := server(t, &service.Config{SuggestService: suggestService})
+
+	var got service.TermSuggestions
+	require.Equal(t, http.StatusOK, request(t, srv, http.MethodGet,
+		`/terms/suggest?prefix=ro&vocabulary_id=4&namespace=products`, ``, &got))
+
+	assert.Equal(t, &model.TermSuggest{Prefix: `ro`, VocabularyID: []uint64{4}, Namespace: `products`, Limit: 10},
+		captor.Last())
+	assert.Equal(t, []*service.TermSuggestion{
+		{ID: 3, Name: `reddish`, Text: `rosy`, Field: `synonym`, Popularity: 12},
+	}, got.Items)
+}
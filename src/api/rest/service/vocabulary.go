@@ -0,0 +1,157 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+func (s *Service) vocabularyRoutes() []*Route {
+	return []*Route{
+		{
+			Method:  http.MethodGet,
+			Path:    `/vocabularies`,
+			Summary: `List of vocabularies`,
+			Params: []Param{
+				{Name: `parent_id`, In: `query`, Type: `integer`},
+				{Name: `name`, In: `query`, Type: `string`},
+			},
+			Response: []Vocabulary{},
+			Status:   http.StatusOK,
+			Handler:  s.getVocabularies,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     `/vocabularies`,
+			Summary:  `Create vocabulary`,
+			Body:     VocabularyInput{},
+			Response: Vocabulary{},
+			Status:   http.StatusCreated,
+			Handler:  s.createVocabulary,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     `/vocabularies/{id}`,
+			Summary:  `Get vocabulary`,
+			Params:   []Param{idParam},
+			Response: Vocabulary{},
+			Status:   http.StatusOK,
+			Handler:  s.getVocabulary,
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     `/vocabularies/{id}`,
+			Summary:  `Update vocabulary, empty fields keep their values, parent_id 0 removes parent`,
+			Params:   []Param{idParam},
+			Body:     VocabularyInput{},
+			Response: Vocabulary{},
+			Status:   http.StatusOK,
+			Handler:  s.updateVocabulary,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    `/vocabularies/{id}`,
+			Summary: `Delete vocabulary, it fails when vocabulary has terms`,
+			Params:  []Param{idParam},
+			Status:  http.StatusNoContent,
+			Handler: s.deleteVocabulary,
+		},
+	}
+}
+
+func (s *Service) getVocabularies(r *http.Request) (any, error) {
+	var filter = new(model.VocabularyFilter)
+
+	if parent := r.URL.Query().Get(`parent_id`); parent != `` {
+		id, err := strconv.ParseUint(parent, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(`%w: wrong parent_id %q`, ErrBadRequest, parent)
+		}
+
+		filter.ParentID = &id
+	}
+
+	if name := r.URL.Query().Get(`name`); name != `` {
+		filter.Name = &name
+	}
+
+	vocabularies, err := s.vocabularyService.Get(r.Context(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var response = make([]*Vocabulary, 0, len(vocabularies))
+	for _, vocabulary := range vocabularies {
+		response = append(response, vocabulary2rest(vocabulary))
+	}
+
+	return response, nil
+}
+
+func (s *Service) createVocabulary(r *http.Request) (any, error) {
+	var input VocabularyInput
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	vocabulary, err := s.vocabularyService.Create(r.Context(), &model.VocabularyData{
+		Name:        input.Name,
+		Title:       input.Title,
+		Description: input.Description,
+		ParentID:    input.ParentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vocabulary2rest(vocabulary), nil
+}
+
+func (s *Service) getVocabulary(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	vocabulary, err := s.vocabularyService.GetByID(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return vocabulary2rest(vocabulary), nil
+}
+
+func (s *Service) updateVocabulary(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	var input VocabularyInput
+	if err := decode(r, &input); err != nil {
+		return nil, err
+	}
+
+	vocabulary, err := s.vocabularyService.Update(r.Context(), id, &model.VocabularyData{
+		Name:        input.Name,
+		Title:       input.Title,
+		Description: input.Description,
+		ParentID:    input.ParentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vocabulary2rest(vocabulary), nil
+}
+
+func (s *Service) deleteVocabulary(r *http.Request) (any, error) {
+	id, err := pathID(r, `id`)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, s.vocabularyService.Delete(r.Context(), id)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/dump"
+	"github.com/spf13/cobra"
+)
+
+func dumpCommand() *cobra.Command {
+	dumpCmd := &cobra.Command{
+		Use:   `dump`,
+		Args:  cobra.NoArgs,
+		Short: `Write namespaces, vocabularies, terms and references as NDJSON`,
+		Long: `Dump keeps ids and could be restored into a database of any supported dialect, ` +
+			`e.g. to move from SQLite to PostgreSQL.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			output, err := cmd.Flags().GetString(`output`)
+			CheckErr(err)
+
+			var w = cmd.OutOrStdout()
+			if output != `` {
+				f, err := os.Create(output)
+				CheckErr(err)
+				defer f.Close()
+				w = f
+			}
+
+			CheckErr(dump.Dump(cmd.Context(), driver(cmd), w))
+		},
+	}
+
+	dumpCmd.Flags().StringP(`output`, `o`, ``, `file for the dump, stdout is used by default`)
+
+	return dumpCmd
+}
+
+func restoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   `restore [file]`,
+		Args:  cobra.MaximumNArgs(1),
+		Short: `Restore dump from the file or stdin into empty database`,
+		Long:  `Create tables with init command before restore. Nothing is restored when any record fails.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			var r = cmd.InOrStdin()
+			if len(args) > 0 {
+				f, err := os.Open(args[0])
+				CheckErr(err)
+				defer f.Close()
+				r = f
+			}
+
+			CheckErr(dump.Restore(cmd.Context(), driver(cmd), r))
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/internal/service/exchange/csv"
+	"github.com/dmalykh/taxonomy/internal/service/exchange/skos"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+func importCommand() *cobra.Command {
+	importCmd := &cobra.Command{
+		Use:   `import`,
+		Short: `Import vocabularies and terms`,
+		Run: func(cmd *cobra.Command, args []string) {
+			CheckErr(cmd.Help())
+		},
+	}
+
+	csvCmd := &cobra.Command{
+		Use:   `csv [file]`,
+		Args:  cobra.MaximumNArgs(1),
+		Short: `Import vocabularies and terms from CSV file or stdin`,
+		Long: `Every row is a term of vocabulary, parent is a path of parent vocabularies like catalog/clothes, ` +
+			`broader terms are names of terms of the same vocabulary separated by "|". Vocabularies and terms are ` +
+			`found by names: existing ones are updated, missing ones are created. Empty cells keep current values. ` +
+			`Nothing is changed when any row is invalid.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			importTaxonomy(cmd, args, func(r io.Reader) (*exchange.Taxonomy, error) {
+				return csv.Read(r, csvColumns(cmd))
+			})
+		},
+	}
+
+	csvCmd.Flags().Bool(`dry-run`, false, `show what would be created, updated or skipped without changes`)
+	csvColumnsFlag(csvCmd)
+
+	skosCmd := &cobra.Command{
+		Use:   `skos [file]`,
+		Args:  cobra.MaximumNArgs(1),
+		Short: `Import concept schemes and concepts from SKOS file or stdin`,
+		Long: `Concept schemes become vocabularies, dct:isPartOf links a scheme with its parent. Concepts become terms ` +
+			`named by skos:notation or by local names of their IRIs, skos:prefLabel is a title, skos:definition is ` +
+			`a description and skos:broader or skos:narrower within a scheme are broader terms. Vocabularies and ` +
+			`terms are found by names like in CSV import.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			importTaxonomy(cmd, args, func(r io.Reader) (*exchange.Taxonomy, error) {
+				return skos.Read(r, skosFormat(cmd))
+			})
+		},
+	}
+
+	skosCmd.Flags().Bool(`dry-run`, false, `show what would be created, updated or skipped without changes`)
+	skosFormatFlag(skosCmd)
+
+	importCmd.AddCommand(csvCmd, skosCmd)
+
+	return importCmd
+}
+
+func exportCommand() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   `export`,
+		Short: `Export vocabularies and terms`,
+		Run: func(cmd *cobra.Command, args []string) {
+			CheckErr(cmd.Help())
+		},
+	}
+
+	csvCmd := &cobra.Command{
+		Use:   `csv`,
+		Args:  cobra.NoArgs,
+		Short: `Export all vocabularies and terms to stdout as CSV`,
+		Run: func(cmd *cobra.Command, args []string) {
+			taxonomy, err := service(cmd).Exchange.Export(cmd.Context())
+			CheckErr(err)
+			CheckErr(csv.Write(cmd.OutOrStdout(), taxonomy, csvColumns(cmd)))
+		},
+	}
+
+	csvColumnsFlag(csvCmd)
+
+	skosCmd := &cobra.Command{
+		Use:   `skos`,
+		Args:  cobra.NoArgs,
+		Short: `Export all vocabularies and terms to stdout as SKOS concept schemes`,
+		Run: func(cmd *cobra.Command, args []string) {
+			base, err := cmd.Flags().GetString(`base`)
+			CheckErr(err)
+			taxonomy, err := service(cmd).Exchange.Export(cmd.Context())
+			CheckErr(err)
+			CheckErr(skos.Write(cmd.OutOrStdout(), taxonomy, skosFormat(cmd), base))
+		},
+	}
+
+	skosCmd.Flags().String(`base`, skos.DefaultBase, `base of IRIs of concept schemes and concepts`)
+	skosFormatFlag(skosCmd)
+
+	exportCmd.AddCommand(csvCmd, skosCmd)
+
+	return exportCmd
+}
+
+// importTaxonomy reads taxonomy from the file or stdin and applies it or shows the plan in dry-run mode.
+func importTaxonomy(cmd *cobra.Command, args []string, read func(r io.Reader) (*exchange.Taxonomy, error)) {
+	var r = cmd.InOrStdin()
+	if len(args) > 0 {
+		f, err := os.Open(args[0])
+		CheckErr(err)
+		defer f.Close()
+		r = f
+	}
+
+	taxonomy, err := read(r)
+	CheckErr(err)
+
+	s := service(cmd).Exchange
+	plan, err := s.Plan(cmd.Context(), taxonomy)
+	CheckErr(err)
+
+	dryRun, err := cmd.Flags().GetBool(`dry-run`)
+	CheckErr(err)
+	if dryRun {
+		renderChanges(cmd.OutOrStdout(), plan.Changes)
+
+		return
+	}
+
+	CheckErr(s.Apply(cmd.Context(), plan))
+}
+
+func skosFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String(`format`, string(skos.Turtle), `format of SKOS: turtle or rdfxml`)
+}
+
+func skosFormat(cmd *cobra.Command) skos.Format {
+	format, err := cmd.Flags().GetString(`format`)
+	CheckErr(err)
+
+	return skos.Format(format)
+}
+
+func csvColumnsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringToString(`columns`, nil,
+		`names of CSV columns, e.g. term=Name,broader=Parent term. `+
+			`Keys are vocabulary, parent, term, title, description and broader`)
+}
+
+// csvColumns returns default columns renamed by columns flag.
+func csvColumns(cmd *cobra.Command) csv.Columns {
+	names, err := cmd.Flags().GetStringToString(`columns`)
+	CheckErr(err)
+
+	var (
+		columns = csv.DefaultColumns
+		fields  = map[string]*string{
+			`vocabulary`:  &columns.Vocabulary,
+			`parent`:      &columns.Parent,
+			`term`:        &columns.Term,
+			`title`:       &columns.Title,
+			`description`: &columns.Description,
+			`broader`:     &columns.Broader,
+		}
+	)
+
+	for key, name := range names {
+		field, ok := fields[key]
+		if !ok {
+			CheckErr(fmt.Errorf(`unknown column %s`, key))
+		}
+		*field = name
+	}
+
+	return columns
+}
+
+func renderChanges(w io.Writer, changes []*exchange.Change) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{`Action`, `Namespace`, `Vocabulary`, `Term`})
+
+	for _, change := range changes {
+		table.Append([]string{string(change.Action), change.Namespace, exchange.JoinPath(change.Vocabulary),
+			change.Term})
+	}
+	table.Render()
+}
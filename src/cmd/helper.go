@@ -1,7 +1,14 @@
 package cmd
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/dmalykh/taxonomy/cmd/loader"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +26,179 @@ func service(cmd *cobra.Command) *loader.Service {
 	return service
 }
 
+// driver returns database driver for commands working with database directly.
+func driver(cmd *cobra.Command) *entsql.Driver {
+	dsn, err := cmd.Flags().GetString(`dsn`)
+	CheckErr(err)
+	drv, err := entgo.Open(cmd.Context(), dsn)
+	CheckErr(err)
+
+	return drv
+}
+
+// uint64Slice returns values of uint slice flag as ids.
+func uint64Slice(cmd *cobra.Command, name string) []uint64 {
+	values, err := cmd.Flags().GetUintSlice(name)
+	CheckErr(err)
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, len(values))
+	for i, v := range values {
+		ids[i] = uint64(v)
+	}
+
+	return ids
+}
+
+// termGroups parses every value of string array flag as a group of comma separated term ids.
+func termGroups(cmd *cobra.Command, name string) [][]uint64 {
+	values, err := cmd.Flags().GetStringArray(name)
+	CheckErr(err)
+
+	groups := make([][]uint64, 0, len(values))
+
+	for _, value := range values {
+		var group []uint64
+
+		for _, s := range strings.Split(value, `,`) {
+			id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+			CheckErr(err)
+			group = append(group, id)
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// entities returns values of string slice flag as entities' ids.
+func entities(cmd *cobra.Command, name string) []model.EntityID {
+	values, err := cmd.Flags().GetStringSlice(name)
+	CheckErr(err)
+
+	ids := make([]model.EntityID, len(values))
+	for i, v := range values {
+		ids[i] = model.EntityID(v)
+	}
+
+	return ids
+}
+
+// fallback returns fallback chains of locales from default-locale and locale-fallback flags.
+func fallback(cmd *cobra.Command) *model.Fallback {
+	defaults, err := cmd.Flags().GetStringSlice(`default-locale`)
+	CheckErr(err)
+
+	values, err := cmd.Flags().GetStringArray(`locale-fallback`)
+	CheckErr(err)
+
+	var f = &model.Fallback{Default: defaults, Chains: make(map[string][]string, len(values))}
+
+	for _, value := range values {
+		locale, chain, ok := strings.Cut(value, `=`)
+		if !ok {
+			CheckErr(fmt.Errorf(`locale fallback %q should be like de-AT=de-DE,de`, value))
+		}
+
+		f.Chains[strings.TrimSpace(locale)] = strings.Split(chain, `,`)
+	}
+
+	return f
+}
+
+// localeChain returns locales to look titles up for the locale flag, if the command has it.
+func localeChain(cmd *cobra.Command) []string {
+	if cmd.Flags().Lookup(`locale`) == nil {
+		return nil
+	}
+
+	locale, err := cmd.Flags().GetString(`locale`)
+	CheckErr(err)
+
+	if locale == `` {
+		return nil
+	}
+
+	return new(model.Fallback).Chain(locale)
+}
+
+// labels returns labels of the locale-title and locale-description flags, i.e. --locale-title de=Rot.
+func labels(cmd *cobra.Command) model.Labels {
+	titles, err := cmd.Flags().GetStringToString(`locale-title`)
+	CheckErr(err)
+
+	descriptions, err := cmd.Flags().GetStringToString(`locale-description`)
+	CheckErr(err)
+
+	if len(titles) == 0 && len(descriptions) == 0 {
+		return nil
+	}
+
+	var labels = make(model.Labels)
+
+	for locale, title := range titles {
+		label := labels[model.NormalizeLocale(locale)]
+		label.Title = title
+		labels[model.NormalizeLocale(locale)] = label
+	}
+
+	for locale, description := range descriptions {
+		label := labels[model.NormalizeLocale(locale)]
+		label.Description = description
+		labels[model.NormalizeLocale(locale)] = label
+	}
+
+	return labels
+}
+
+// labelsFlags adds flags of translations read by labels.
+func labelsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringToString(`locale-title`, nil, `translated titles by locales, i.e. de=Rot`)
+	cmd.Flags().StringToString(`locale-description`, nil,
+		`translated descriptions by locales, empty title and description remove the translation on update`)
+}
+
+// synonyms returns synonyms of the synonym and hidden-synonym flags like name@locale, nil is returned when flags
+// aren't set. Set flags with empty values return empty slice, so all synonyms are removed on update.
+func synonyms(cmd *cobra.Command) []model.Synonym {
+	if !cmd.Flags().Changed(`synonym`) && !cmd.Flags().Changed(`hidden-synonym`) {
+		return nil
+	}
+
+	var synonyms = make([]model.Synonym, 0)
+
+	for _, flag := range []string{`synonym`, `hidden-synonym`} {
+		values, err := cmd.Flags().GetStringArray(flag)
+		CheckErr(err)
+
+		for _, value := range values {
+			name, locale, _ := strings.Cut(value, `@`)
+			if name = strings.TrimSpace(name); name == `` {
+				continue
+			}
+
+			synonyms = append(synonyms, model.Synonym{
+				Name:   name,
+				Locale: model.NormalizeLocale(locale),
+				Hidden: flag == `hidden-synonym`,
+			})
+		}
+	}
+
+	return synonyms
+}
+
+// synonymsFlags adds flags of synonyms read by synonyms.
+func synonymsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray(`synonym`, nil, `alternative name of the term with optional locale, i.e. rot@de`)
+	cmd.Flags().StringArray(`hidden-synonym`, nil,
+		`alternative name used for lookups only, i.e. misspelling, given synonyms replace existing ones on update`)
+}
+
 // CheckErr check error and panics if error exists  https://github.com/spf13/cobra/pull/1568
 func CheckErr(msg interface{}) {
 	if msg != nil {
@@ -7,7 +7,10 @@ import (
 	repository2 "github.com/dmalykh/taxonomy/internal/repository/entgo/repository"
 	"github.com/dmalykh/taxonomy/taxonomy"
 
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
 	"github.com/dmalykh/taxonomy/internal/service/namespace"
+	"github.com/dmalykh/taxonomy/internal/service/reference"
+	"github.com/dmalykh/taxonomy/internal/service/suggest"
 	"github.com/dmalykh/taxonomy/internal/service/term"
 	"github.com/dmalykh/taxonomy/internal/service/vocabulary"
 	"go.uber.org/zap"
@@ -17,14 +20,24 @@ type Service struct {
 	Namespace  taxonomy.Namespace
 	Term       taxonomy.Term
 	Vocabulary taxonomy.Vocabulary
+	Reference  taxonomy.Reference
+	Suggest    *suggest.Service
+	Exchange   *exchange.Service
 }
 
 func Load(ctx context.Context, dsn string, verbose bool) (*Service, error) {
-	client, err := entgo.Connect(ctx, dsn, verbose)
+	drv, err := entgo.Open(ctx, dsn)
 	if err != nil {
 		return nil, fmt.Errorf(`error connect to database: %w`, err)
 	}
 
+	fullText, err := repository2.DetectFullText(ctx, drv)
+	if err != nil {
+		return nil, fmt.Errorf(`error connect to database: %w`, err)
+	}
+
+	client := entgo.NewClient(drv, verbose)
+
 	// Init zap logger
 	logger, err := func() (*zap.Logger, error) {
 		if verbose == true {
@@ -61,7 +74,10 @@ func Load(ctx context.Context, dsn string, verbose bool) (*Service, error) {
 	}()
 
 	// Construct service
-	var service Service
+	var (
+		service     Service
+		transaction = repository2.NewTransaction(client)
+	)
 
 	service.Namespace = namespace.New(&namespace.Config{
 		Transaction:         transaction,
@@ -70,20 +86,45 @@ func Load(ctx context.Context, dsn string, verbose bool) (*Service, error) {
 		Logger:              logger,
 	})
 
-	service.Term = term.New(&term.Config{
+	termService := term.New(&term.Config{
+		Transaction:          transaction,
+		TermRepository:       repository2.NewTerm(client.Term, client.Synonym, fullText),
+		VocabularyRepository: repository2.NewVocabulary(client.Vocabulary),
+		ReferenceRepository:  repository2.NewReference(client.Reference),
+		Logger:               logger,
+	})
+
+	// Reference service only reads terms, so it gets terms service which doesn't update suggestions
+	service.Reference = reference.New(&reference.Config{
 		Transaction:         transaction,
-		TermRepository:      repository2.NewTerm(client.Term),
-		VocabularyService:   repository2.NewVocabulary(client.Vocabulary),
-		ReferenceRepository: repository2.NewReference(client.Reference),
 		NamespaceService:    service.Namespace,
+		ReferenceRepository: repository2.NewReference(client.Reference),
+		TermService:         termService,
 		Logger:              logger,
 	})
 
+	service.Suggest = suggest.New(&suggest.Config{
+		TermService:      termService,
+		ReferenceService: service.Reference,
+		Logger:           logger,
+	})
+	service.Term = service.Suggest.Watch(termService)
+
 	service.Vocabulary = vocabulary.New(&vocabulary.Config{
+		Transaction:          transaction,
 		VocabularyRepository: repository2.NewVocabulary(client.Vocabulary),
 		TermService:          service.Term,
 		Logger:               logger,
 	})
 
+	service.Exchange = exchange.New(&exchange.Config{
+		Transaction:       transaction,
+		NamespaceService:  service.Namespace,
+		VocabularyService: service.Vocabulary,
+		TermService:       service.Term,
+		ReferenceService:  service.Reference,
+		Logger:            logger,
+	})
+
 	return &service, nil
 }
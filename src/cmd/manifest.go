@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/internal/service/exchange/manifest"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+func planCommand() *cobra.Command {
+	planCmd := &cobra.Command{
+		Use:   `plan`,
+		Args:  cobra.NoArgs,
+		Short: `Show changes which would make the database match the manifest`,
+		Run: func(cmd *cobra.Command, args []string) {
+			renderPlan(cmd, manifestPlan(cmd))
+		},
+	}
+
+	manifestFlags(planCmd)
+
+	return planCmd
+}
+
+func applyCommand() *cobra.Command {
+	applyCmd := &cobra.Command{
+		Use:   `apply`,
+		Args:  cobra.NoArgs,
+		Short: `Make the database match the manifest`,
+		Long:  `Changes are applied in one transaction, so nothing is changed when any of them fails.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			plan := manifestPlan(cmd)
+			CheckErr(service(cmd).Exchange.Apply(cmd.Context(), plan))
+			renderPlan(cmd, plan)
+		},
+	}
+
+	manifestFlags(applyCmd)
+
+	return applyCmd
+}
+
+func manifestFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(`file`, `f`, `taxonomy.yaml`, `manifest in YAML or JSON, "-" reads stdin`)
+	cmd.Flags().Bool(`prune`, false, `delete namespaces, vocabularies and terms missing in the manifest, `+
+		`referenced terms and namespaces are never deleted`)
+}
+
+// manifestPlan reads the manifest and plans its changes.
+func manifestPlan(cmd *cobra.Command) *exchange.Plan {
+	file, err := cmd.Flags().GetString(`file`)
+	CheckErr(err)
+
+	var r = cmd.InOrStdin()
+	if file != `-` {
+		f, err := os.Open(file)
+		CheckErr(err)
+		defer f.Close()
+		r = f
+	}
+
+	taxonomy, err := manifest.Read(r)
+	CheckErr(err)
+
+	s := service(cmd).Exchange
+	plan, err := s.Plan(cmd.Context(), taxonomy)
+	CheckErr(err)
+
+	prune, err := cmd.Flags().GetBool(`prune`)
+	CheckErr(err)
+	if prune {
+		CheckErr(s.Prune(cmd.Context(), plan))
+	}
+
+	return plan
+}
+
+// renderPlan shows changes without skipped ones.
+func renderPlan(cmd *cobra.Command, plan *exchange.Plan) {
+	changes := lo.Filter(plan.Changes, func(change *exchange.Change, _ int) bool {
+		return change.Action != exchange.Skip
+	})
+
+	if len(changes) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), `No changes, the database matches the manifest.`)
+
+		return
+	}
+
+	renderChanges(cmd.OutOrStdout(), changes)
+}
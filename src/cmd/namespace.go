@@ -57,7 +57,7 @@ func namespaceCommand() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "Show all namespaces",
 		Run: func(cmd *cobra.Command, args []string) {
-			namespaces, err := service(cmd).Namespace.Get(cmd.Context(), math.MaxUint, 0)
+			namespaces, err := service(cmd).Namespace.Get(cmd.Context(), math.MaxUint, nil)
 			CheckErr(err)
 
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
@@ -67,9 +67,9 @@ func namespaceCommand() *cobra.Command {
 				table.Append(func(namespace model.Namespace) []string {
 					return []string{
 						strconv.Itoa(int(namespace.ID)),
-						namespace.Name,
+						namespace.Data.Name,
 					}
-				}(namespace))
+				}(*namespace))
 			}
 			table.Render()
 		},
@@ -22,19 +22,17 @@ func relCommand() *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: `Set reference`,
 		Run: func(cmd *cobra.Command, args []string) {
-			termID, err := cmd.Flags().GetUint(`term`)
+			termID, err := cmd.Flags().GetUint64(`term`)
 			CheckErr(err)
 			namespace, err := cmd.Flags().GetString(`namespace`)
 			CheckErr(err)
-			entitiesID, err := cmd.Flags().GetUintSlice(`entity`)
-			CheckErr(err)
-			CheckErr(service(cmd).Term.SetReference(cmd.Context(), termID, namespace, entitiesID...))
+			CheckErr(service(cmd).Reference.Create(cmd.Context(), termID, namespace, entities(cmd, `entity`)...))
 		},
 	}
 
-	setCmd.Flags().UintP(`term`, `t`, 0, `term's id'`)
-	setCmd.Flags().StringP(`namespace`, `n`, ``, `description for the term`)
-	setCmd.Flags().UintSliceP(`entity`, `e`, nil, `entity's id for reference`)
+	setCmd.Flags().Uint64P(`term`, `t`, 0, `term's id'`)
+	setCmd.Flags().StringP(`namespace`, `n`, ``, `namespace of entities`)
+	setCmd.Flags().StringSliceP(`entity`, `e`, nil, `entity's id for reference`)
 	CheckErr(setCmd.MarkFlagRequired(`term`))
 	CheckErr(setCmd.MarkFlagRequired(`namespace`))
 	CheckErr(setCmd.MarkFlagRequired(`entity`))
@@ -43,31 +41,179 @@ func relCommand() *cobra.Command {
 		Use:   `list`,
 		Args:  cobra.NoArgs,
 		Short: `List of references`,
+		Long: `Every --term flag adds a group of comma separated term ids. Entity matches when it has any term ` +
+			`from every group, i.e. --term 1,2 --term 3 means (1 OR 2) AND 3. Entities having any term of ` +
+			`--exclude flags are skipped, i.e. --term 1 --exclude 4,5 means 1 AND NOT (4 OR 5).`,
 		Run: func(cmd *cobra.Command, args []string) {
-			namespace, err := cmd.Flags().GetString(`namespace`)
+			references, err := service(cmd).Reference.Get(cmd.Context(), referenceFilter(cmd))
 			CheckErr(err)
-			references, err := service(cmd).Term.GetReferences(cmd.Context(), &model.EntityFilter{Namespace: []string{namespace}})
 
-			CheckErr(err)
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
 			table.SetHeader([]string{`Term ID`, `Entity ID`})
 
 			for _, reference := range references {
-				table.Append(func(reference model.Reference) []string {
-					return []string{
-						strconv.Itoa(int(reference.TermID)),
-						reference.EntityID,
+				table.Append([]string{
+					strconv.FormatUint(reference.TermID, 10),
+					string(reference.EntityID),
+				})
+			}
+			table.Render()
+		},
+	}
+
+	filterFlags(listCmd)
+
+	facetsCmd := &cobra.Command{
+		Use:   `facets`,
+		Args:  cobra.NoArgs,
+		Short: `Counts of entities by terms and vocabularies`,
+		Long: `Shows how many entities matching the filter are related with every term and vocabulary, i.e. how ` +
+			`many entities would be found with one more --term flag. Filter flags are the same as for list command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			facets, err := service(cmd).Reference.Facets(cmd.Context(), referenceFilter(cmd))
+			CheckErr(err)
+
+			terms := tablewriter.NewWriter(cmd.OutOrStdout())
+			terms.SetHeader([]string{`Term ID`, `Entities`})
+
+			for _, facet := range facets.Terms {
+				terms.Append([]string{
+					strconv.FormatUint(facet.TermID, 10),
+					strconv.FormatUint(facet.Count, 10),
+				})
+			}
+			terms.Render()
+
+			vocabularies := tablewriter.NewWriter(cmd.OutOrStdout())
+			vocabularies.SetHeader([]string{`Vocabulary ID`, `Entities`})
+
+			for _, facet := range facets.Vocabularies {
+				vocabularies.Append([]string{
+					strconv.FormatUint(facet.VocabularyID, 10),
+					strconv.FormatUint(facet.Count, 10),
+				})
+			}
+			vocabularies.Render()
+		},
+	}
+
+	filterFlags(facetsCmd)
+
+	termsCmd := &cobra.Command{
+		Use:   `terms [entity id]...`,
+		Args:  cobra.MinimumNArgs(1),
+		Short: `Terms of entities grouped by vocabularies`,
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace, err := cmd.Flags().GetString(`namespace`)
+			CheckErr(err)
+
+			entitiesID := make([]model.EntityID, len(args))
+			for i, arg := range args {
+				entitiesID[i] = model.EntityID(arg)
+			}
+
+			entities, err := service(cmd).Reference.GetTerms(cmd.Context(), namespace, entitiesID...)
+			CheckErr(err)
+
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{`Entity ID`, `Vocabulary ID`, `Term ID`, `Name`, `Title`})
+
+			for _, entity := range entities {
+				for _, vocabulary := range entity.Vocabularies {
+					for _, term := range vocabulary.Terms {
+						table.Append([]string{
+							string(entity.EntityID),
+							strconv.FormatUint(vocabulary.VocabularyID, 10),
+							strconv.FormatUint(term.ID, 10),
+							term.Data.Name,
+							term.Data.Title,
+						})
 					}
-				}(reference))
+				}
 			}
 			table.Render()
 		},
 	}
 
-	listCmd.Flags().StringP(`namespace`, `n`, ``, `description for the term`)
-	CheckErr(setCmd.MarkFlagRequired(`namespace`))
+	termsCmd.Flags().StringP(`namespace`, `n`, ``, `namespace of entities`)
+	CheckErr(termsCmd.MarkFlagRequired(`namespace`))
+
+	replaceCmd := &cobra.Command{
+		Use:   `replace [entity id]`,
+		Args:  cobra.ExactArgs(1),
+		Short: `Replace terms of entity`,
+		Long: `Entity gets all --term terms, its other terms are unlinked. With --vocabulary only terms of the ` +
+			`vocabulary are replaced. Terms are replaced in one transaction, nothing is changed when any term fails.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			namespace, err := cmd.Flags().GetString(`namespace`)
+			CheckErr(err)
 
-	relCmd.AddCommand(setCmd, listCmd)
+			var (
+				entityID = model.EntityID(args[0])
+				termsID  = uint64Slice(cmd, `term`)
+				changes  *model.ReferenceChanges
+			)
+
+			if cmd.Flags().Changed(`vocabulary`) {
+				vocabularyID, err := cmd.Flags().GetUint64(`vocabulary`)
+				CheckErr(err)
+				changes, err = service(cmd).Reference.ReplaceInVocabulary(cmd.Context(), namespace, entityID,
+					vocabularyID, termsID)
+				CheckErr(err)
+			} else {
+				changes, err = service(cmd).Reference.Replace(cmd.Context(), namespace, entityID, termsID)
+				CheckErr(err)
+			}
+
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{`Term ID`, `Change`})
+
+			for _, id := range changes.Added {
+				table.Append([]string{strconv.FormatUint(id, 10), `added`})
+			}
+			for _, id := range changes.Removed {
+				table.Append([]string{strconv.FormatUint(id, 10), `removed`})
+			}
+			table.Render()
+		},
+	}
+
+	replaceCmd.Flags().StringP(`namespace`, `n`, ``, `namespace of entity`)
+	replaceCmd.Flags().UintSliceP(`term`, `t`, nil, `ids of all terms of entity, empty to unlink all terms`)
+	replaceCmd.Flags().Uint64(`vocabulary`, 0, `replace only terms of the vocabulary`)
+	CheckErr(replaceCmd.MarkFlagRequired(`namespace`))
+
+	relCmd.AddCommand(setCmd, listCmd, facetsCmd, termsCmd, replaceCmd)
 
 	return relCmd
 }
+
+// filterFlags adds flags of references filter to the command.
+func filterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(`namespace`, `n`, ``, `namespace of entities`)
+	cmd.Flags().StringArrayP(`term`, `t`, nil, `comma separated ids of terms, any of them should be set`)
+	cmd.Flags().StringArray(`exclude`, nil, `comma separated ids of terms, none of them should be set`)
+	cmd.Flags().StringSliceP(`entity`, `e`, nil, `entity's id`)
+	cmd.Flags().Bool(`subterms`, false, `match narrower terms of given ones too`)
+	cmd.Flags().Bool(`vocabulary-descendants`, false, `match terms of vocabularies nested into given terms' ones`)
+	CheckErr(cmd.MarkFlagRequired(`namespace`))
+}
+
+// referenceFilter returns filter by flags added with filterFlags.
+func referenceFilter(cmd *cobra.Command) *model.ReferenceFilter {
+	namespace, err := cmd.Flags().GetString(`namespace`)
+	CheckErr(err)
+	withSubterms, err := cmd.Flags().GetBool(`subterms`)
+	CheckErr(err)
+	withVocabularyDescendants, err := cmd.Flags().GetBool(`vocabulary-descendants`)
+	CheckErr(err)
+
+	return &model.ReferenceFilter{
+		TermID:                    termGroups(cmd, `term`),
+		ExcludeTermID:             termGroups(cmd, `exclude`),
+		Namespace:                 []string{namespace},
+		EntityID:                  entities(cmd, `entity`),
+		WithSubterms:              withSubterms,
+		WithVocabularyDescendants: withVocabularyDescendants,
+	}
+}
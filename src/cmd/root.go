@@ -25,7 +25,9 @@ func New() *cobra.Command {
 	c.PersistentFlags().BoolP("verbose", "v", false, "Make some output more verbose.")
 
 	// Add subcommands
-	c.AddCommand(initCommand(), vocabularyCommand(), termCommand(), namespaceCommand(), relCommand(), serveCommand())
+	c.AddCommand(initCommand(), vocabularyCommand(), termCommand(), namespaceCommand(), relCommand(), serveCommand(),
+		importCommand(), exportCommand(), planCommand(), applyCommand(), dumpCommand(), restoreCommand(),
+		tagTextCommand())
 
 	return c
 }
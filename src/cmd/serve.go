@@ -4,6 +4,8 @@ import (
 	"strconv"
 
 	"github.com/dmalykh/taxonomy/api/graphql"
+	"github.com/dmalykh/taxonomy/api/grpc"
+	"github.com/dmalykh/taxonomy/api/rest"
 	"github.com/spf13/cobra"
 )
 
@@ -16,7 +18,7 @@ func serveCommand() *cobra.Command {
 
 	serveCmd.PersistentFlags().IntP(`port`, `p`, 8080, `port on which the github.com/dmalykh/internal will listen`) //nolint:gomnd
 
-	serveCmd.AddCommand(&cobra.Command{
+	graphqlCmd := &cobra.Command{
 		Use:   `graphql`,
 		Short: `Run graphql API`,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -28,11 +30,69 @@ func serveCommand() *cobra.Command {
 			CheckErr(err)
 			// Run service
 			s := service(cmd)
+			// Terms are loaded into memory before the first suggestion is requested
+			CheckErr(s.Suggest.Load(cmd.Context()))
 			CheckErr(graphql.Serve(&graphql.Config{
 				Port:              strconv.Itoa(port),
 				TermService:       s.Term,
 				VocabularyService: s.Vocabulary,
 				NamespaceService:  s.Namespace,
+				ReferenceService:  s.Reference,
+				SuggestService:    s.Suggest,
+				Fallback:          fallback(cmd),
+				Verbose:           verbose,
+			}))
+		},
+	}
+	graphqlCmd.Flags().StringSlice(`default-locale`, nil, `locales of labels used when requested ones aren't translated`)
+	graphqlCmd.Flags().StringArray(`locale-fallback`, nil,
+		`locales tried after the locale, i.e. de-AT=de-DE,de (could be repeated)`)
+	serveCmd.AddCommand(graphqlCmd)
+
+	serveCmd.AddCommand(&cobra.Command{
+		Use:   `grpc`,
+		Short: `Run gRPC API`,
+		Run: func(cmd *cobra.Command, args []string) {
+			// Get port flag
+			port, err := cmd.Flags().GetInt(`port`)
+			CheckErr(err)
+			// Get verbose flag
+			verbose, err := cmd.Flags().GetBool(`verbose`)
+			CheckErr(err)
+			// Run service
+			s := service(cmd)
+			CheckErr(grpc.Serve(&grpc.Config{
+				Port:              strconv.Itoa(port),
+				TermService:       s.Term,
+				VocabularyService: s.Vocabulary,
+				NamespaceService:  s.Namespace,
+				ReferenceService:  s.Reference,
+				Verbose:           verbose,
+			}))
+		},
+	})
+
+	serveCmd.AddCommand(&cobra.Command{
+		Use:   `rest`,
+		Short: `Run REST API`,
+		Run: func(cmd *cobra.Command, args []string) {
+			// Get port flag
+			port, err := cmd.Flags().GetInt(`port`)
+			CheckErr(err)
+			// Get verbose flag
+			verbose, err := cmd.Flags().GetBool(`verbose`)
+			CheckErr(err)
+			// Run service
+			s := service(cmd)
+			// Terms are loaded into memory before the first suggestion is requested
+			CheckErr(s.Suggest.Load(cmd.Context()))
+			CheckErr(rest.Serve(&rest.Config{
+				Port:              strconv.Itoa(port),
+				TermService:       s.Term,
+				VocabularyService: s.Vocabulary,
+				NamespaceService:  s.Namespace,
+				ReferenceService:  s.Reference,
+				SuggestService:    s.Suggest,
 				Verbose:           verbose,
 			}))
 		},
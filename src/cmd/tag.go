@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+func tagTextCommand() *cobra.Command {
+	tagCmd := &cobra.Command{
+		Use:   `tag-text [text]`,
+		Short: `Find terms mentioned in the text by their names and synonyms`,
+		Long: `Text is read from arguments, from --file or from stdin. Whole words are compared ignoring case and ` +
+			`diacritics, only the longest of overlapping mentions is shown without --overlapping. Start and End ` +
+			`count characters of the text. With --namespace and --entity the entity is related with all mentioned ` +
+			`terms.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			overlapping, err := cmd.Flags().GetBool(`overlapping`)
+			CheckErr(err)
+			namespace, err := cmd.Flags().GetString(`namespace`)
+			CheckErr(err)
+			entity, err := cmd.Flags().GetString(`entity`)
+			CheckErr(err)
+
+			var tagging = &model.TextTagging{
+				Text:         tagText(cmd, args),
+				VocabularyID: uint64Slice(cmd, `vocabulary`),
+				Overlapping:  overlapping,
+			}
+
+			switch {
+			case namespace != `` && entity != ``:
+				tagging.Reference = &model.TagReference{Namespace: namespace, EntityID: model.EntityID(entity)}
+			case namespace != `` || entity != ``:
+				CheckErr(errors.New(`--namespace and --entity should be set together`))
+			}
+
+			tags, err := service(cmd).Suggest.Tag(cmd.Context(), tagging)
+			CheckErr(err)
+
+			table := tablewriter.NewWriter(cmd.OutOrStdout())
+			table.SetHeader([]string{`ID`, `Name`, `Text`, `Start`, `End`, `Field`})
+
+			for _, tag := range tags {
+				table.Append([]string{
+					strconv.FormatUint(tag.Term.ID, 10),
+					tag.Term.Data.Name,
+					tag.Text,
+					strconv.Itoa(tag.Start),
+					strconv.Itoa(tag.End),
+					string(tag.Field),
+				})
+			}
+			table.Render()
+		},
+	}
+
+	tagCmd.Flags().StringP(`file`, `f`, ``, `file with the text`)
+	tagCmd.Flags().UintSlice(`vocabulary`, nil, `find only terms of given vocabularies`)
+	tagCmd.Flags().Bool(`overlapping`, false, `show mentions inside longer ones too`)
+	tagCmd.Flags().StringP(`namespace`, `n`, ``, `namespace of the entity to relate with mentioned terms`)
+	tagCmd.Flags().StringP(`entity`, `e`, ``, `entity's id to relate with mentioned terms`)
+
+	return tagCmd
+}
+
+// tagText returns text of arguments, of the file flag or of stdin.
+func tagText(cmd *cobra.Command, args []string) string {
+	if len(args) > 0 {
+		return strings.Join(args, ` `)
+	}
+
+	file, err := cmd.Flags().GetString(`file`)
+	CheckErr(err)
+
+	var r = cmd.InOrStdin()
+	if file != `` {
+		f, err := os.Open(file)
+		CheckErr(err)
+		defer f.Close()
+		r = f
+	}
+
+	text, err := io.ReadAll(r)
+	CheckErr(err)
+
+	return string(text)
+}
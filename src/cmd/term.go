@@ -1,8 +1,14 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dmalykh/taxonomy/taxonomy"
 	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"io"
 	"strconv"
+	"strings"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
@@ -24,34 +30,36 @@ func termCommand() *cobra.Command {
 		Short:      `Create new term`,
 		Run: func(cmd *cobra.Command, args []string) {
 			_, err := service(cmd).Term.Create(cmd.Context(), &model.TermData{
-				Name:        args[0],
-				Title:       cmd.Flag(`title`).Value.String(),
-				Description: cmd.Flag(`description`).Value.String(),
-				VocabularyID: func() []uint64 {
-					if !cmd.Flags().Changed(`vocabulary`) {
-						cobra.CompErrorln(`vocabulary required`)
-					}
-					vocabularyID, err := cmd.Flags().GetUintSlice(`vocabulary`)
-					CheckErr(err)
-
-					return vocabularyID
-				}(),
+				Name:         args[0],
+				Title:        cmd.Flag(`title`).Value.String(),
+				Description:  cmd.Flag(`description`).Value.String(),
+				Labels:       labels(cmd),
+				Synonyms:     synonyms(cmd),
+				VocabularyID: uint64Slice(cmd, `vocabulary`),
+				SuperID:      uint64Slice(cmd, `super`),
+				SubID:        uint64Slice(cmd, `sub`),
 			})
 			CheckErr(err)
 		},
 	}
 
 	createCmd.Flags().StringP(`title`, `t`, ``, `title of the term`)
-	createCmd.Flags().UintSliceP(`vocabulary`, `v`, {}, `id of vocabulary for the term`)
+	createCmd.Flags().UintSlice(`vocabulary`, nil, `id of vocabulary for the term`)
 	createCmd.Flags().String(`description`, ``, `description for the term`)
+	createCmd.Flags().UintSlice(`super`, nil, `id of broader term`)
+	createCmd.Flags().UintSlice(`sub`, nil, `id of narrower term`)
+	labelsFlags(createCmd)
+	synonymsFlags(createCmd)
 	CheckErr(createCmd.MarkFlagRequired(`vocabulary`))
 
 	updateCmd := &cobra.Command{
 		Use:   `update [id]`,
 		Args:  cobra.ExactArgs(1),
 		Short: `Update term`,
+		Long: `Omitted flags keep current values. Set --super= or --sub= to remove all links, ` +
+			`--synonym= to remove all synonyms.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			id, err := strconv.ParseUint(args[0], 10, 32)
+			id, err := strconv.ParseUint(args[0], 10, 64)
 			CheckErr(err)
 			var update model.TermData
 			{
@@ -75,75 +83,304 @@ func termCommand() *cobra.Command {
 					update.Description = description
 				}
 			}
-			{
-				if cmd.Flags().Changed(`vocabulary`) {
-					vocabulary, err := cmd.Flags().GetUint64(`vocabulary`)
-					CheckErr(err)
-					if vocabulary > 0 {
-						update.VocabularyID = vocabulary
-					}
-				}
+			update.Labels = labels(cmd)
+			update.Synonyms = synonyms(cmd)
+			update.VocabularyID = uint64Slice(cmd, `vocabulary`)
+			// Changed flag replaces links, even with empty value
+			if cmd.Flags().Changed(`super`) {
+				update.SuperID = append([]uint64{}, uint64Slice(cmd, `super`)...)
+			}
+			if cmd.Flags().Changed(`sub`) {
+				update.SubID = append([]uint64{}, uint64Slice(cmd, `sub`)...)
 			}
-			_, err = service(cmd).Term.Update(cmd.Context(), uint(id), &update)
+			_, err = service(cmd).Term.Update(cmd.Context(), id, &update)
 			CheckErr(err)
 		},
 	}
 
-	updateCmd.Flags().Uint(`vocabulary`, 0, `id of vocabulary for this term`)
+	updateCmd.Flags().UintSlice(`vocabulary`, nil, `id of vocabulary for this term`)
 	updateCmd.Flags().StringP(`name`, `n`, ``, `name of the term`)
 	updateCmd.Flags().StringP(`title`, `t`, ``, `title of the term`)
 	updateCmd.Flags().String(`description`, ``, `description for this vocabulary`)
+	updateCmd.Flags().UintSlice(`super`, nil, `id of broader term`)
+	updateCmd.Flags().UintSlice(`sub`, nil, `id of narrower term`)
+	labelsFlags(updateCmd)
+	synonymsFlags(updateCmd)
+
+	createBulkCmd := &cobra.Command{
+		Use:   `create-bulk`,
+		Args:  cobra.NoArgs,
+		Short: `Create terms read from stdin`,
+		Long: `Reads terms as JSON objects, one per line: {"name": "red", "title": "Red", "vocabulary_id": [1]}. ` +
+			`Nothing is created when any term is invalid, errors are shown with numbers of lines.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			lines := readTerms(cmd.InOrStdin())
+			data := make([]*model.TermData, len(lines))
+			for i, line := range lines {
+				data[i] = &line.Data
+			}
+			terms, err := service(cmd).Term.CreateBulk(cmd.Context(), data...)
+			checkBulkErr(cmd, err)
+			renderTerms(cmd, terms)
+		},
+	}
+
+	updateBulkCmd := &cobra.Command{
+		Use:   `update-bulk`,
+		Args:  cobra.NoArgs,
+		Short: `Update terms read from stdin`,
+		Long: `Reads terms as JSON objects with id, one per line: {"id": 3, "title": "Red"}. Omitted fields keep ` +
+			`current values. Nothing is updated when any term is invalid, errors are shown with numbers of lines.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			terms, err := service(cmd).Term.UpdateBulk(cmd.Context(), readTerms(cmd.InOrStdin())...)
+			checkBulkErr(cmd, err)
+			renderTerms(cmd, terms)
+		},
+	}
 
 	deleteCmd := &cobra.Command{
 		Use:   `delete [id]`,
 		Args:  cobra.ExactArgs(1),
 		Short: `Delete term`,
 		Run: func(cmd *cobra.Command, args []string) {
-			id, err := strconv.ParseUint(args[0], 10, 32)
+			id, err := strconv.ParseUint(args[0], 10, 64)
 			CheckErr(err)
-			CheckErr(service(cmd).Term.Delete(cmd.Context(), uint(id)))
+			CheckErr(service(cmd).Term.Delete(cmd.Context(), id))
+		},
+	}
+
+	linkCmd := &cobra.Command{
+		Use:   `link [super id] [sub id]`,
+		Args:  cobra.ExactArgs(2), //nolint:gomnd
+		Short: `Make one term broader than another`,
+		Run: func(cmd *cobra.Command, args []string) {
+			superID, subID := termPair(args)
+			CheckErr(service(cmd).Term.Link(cmd.Context(), superID, subID))
+		},
+	}
+
+	unlinkCmd := &cobra.Command{
+		Use:   `unlink [super id] [sub id]`,
+		Args:  cobra.ExactArgs(2), //nolint:gomnd
+		Short: `Remove broader-narrower relation between terms`,
+		Run: func(cmd *cobra.Command, args []string) {
+			superID, subID := termPair(args)
+			CheckErr(service(cmd).Term.Unlink(cmd.Context(), superID, subID))
 		},
 	}
 
 	listCmd := &cobra.Command{
 		Use:   `list [vocabulary's id] [limit] [offset]`,
-		Args:  cobra.MinimumNArgs(1),
+		Args:  cobra.RangeArgs(1, 3), //nolint:gomnd
 		Short: `Show all terms`,
 		Run: func(cmd *cobra.Command, args []string) {
-			vocabularyID, err := strconv.ParseUint(args[0], 10, 32)
+			vocabularyID, err := strconv.ParseUint(args[0], 10, 64)
 			CheckErr(err)
-			limit, err := strconv.ParseUint(args[1], 10, 32)
-			if err != nil {
-				limit = 10
+			var limit, offset uint64 = 10, 0
+			if len(args) > 1 {
+				limit, err = strconv.ParseUint(args[1], 10, 32)
+				CheckErr(err)
 			}
-			offset, err := strconv.ParseUint(args[2], 10, 32)
-			if err != nil {
-				offset = 0
+			if len(args) > 2 { //nolint:gomnd
+				offset, err = strconv.ParseUint(args[2], 10, 32)
+				CheckErr(err)
 			}
 			terms, err := service(cmd).Term.Get(cmd.Context(), &model.TermFilter{
-				VocabularyID: []uint64{uint64(vocabularyID)},
+				VocabularyID: []uint64{vocabularyID},
+				SuperID:      uint64Slice(cmd, `super`),
+				SubID:        uint64Slice(cmd, `sub`),
 				Limit:        uint(limit),
-				Offset:       uint64(offset),
+				Offset:       uint(offset),
+			})
+			CheckErr(err)
+			renderTerms(cmd, terms)
+		},
+	}
+
+	listCmd.Flags().UintSlice(`super`, nil, `show only narrower terms of given terms`)
+	listCmd.Flags().UintSlice(`sub`, nil, `show only broader terms of given terms`)
+	listCmd.Flags().String(`locale`, ``, `show titles translated to the locale`)
+
+	searchCmd := &cobra.Command{
+		Use:   `search [query]`,
+		Args:  cobra.MinimumNArgs(1),
+		Short: `Search terms by names, synonyms, titles and descriptions`,
+		Long: `Modes: prefix matches beginnings of words, substring matches any part of fields, ` +
+			`fuzzy is like prefix, but tolerates typos.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			mode, ok := map[string]model.SearchMode{
+				`prefix`:    model.SearchPrefix,
+				`substring`: model.SearchSubstring,
+				`fuzzy`:     model.SearchFuzzy,
+			}[cmd.Flag(`mode`).Value.String()]
+			if !ok {
+				CheckErr(fmt.Errorf(`unknown mode %q`, cmd.Flag(`mode`).Value.String()))
+			}
+
+			limit, err := cmd.Flags().GetUint(`limit`)
+			CheckErr(err)
+
+			matches, err := service(cmd).Term.Search(cmd.Context(), &model.TermSearch{
+				Query:        strings.Join(args, ` `),
+				Mode:         mode,
+				VocabularyID: uint64Slice(cmd, `vocabulary`),
+				Limit:        limit,
 			})
 			CheckErr(err)
 
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
-			table.SetHeader([]string{`ID`, `Name`, `Title`})
-
-			for _, term := range terms {
-				table.Append(func(term model.Term) []string {
-					return []string{
-						strconv.Itoa(int(term.ID)),
-						term.Data.Name,
-						term.Data.Title,
-					}
-				}(term))
+			table.SetHeader([]string{`ID`, `Name`, `Title`, `Score`, `Field`})
+
+			var chain = localeChain(cmd)
+
+			for _, match := range matches {
+				table.Append([]string{
+					strconv.FormatUint(match.Term.ID, 10),
+					match.Term.Data.Name,
+					match.Term.Data.Labels.Title(chain, match.Term.Data.Title),
+					strconv.FormatFloat(match.Score, 'f', 2, 64),
+					string(match.Field),
+				})
 			}
 			table.Render()
 		},
 	}
 
-	termCmd.AddCommand(createCmd, updateCmd, deleteCmd, listCmd)
+	searchCmd.Flags().String(`mode`, `prefix`, `prefix, substring or fuzzy`)
+	searchCmd.Flags().UintSlice(`vocabulary`, nil, `search only terms of given vocabularies`)
+	searchCmd.Flags().Uint(`limit`, 20, `count of terms`) //nolint:gomnd
+	searchCmd.Flags().String(`locale`, ``, `show titles translated to the locale`)
+
+	termCmd.AddCommand(createCmd, createBulkCmd, updateCmd, updateBulkCmd, deleteCmd, linkCmd, unlinkCmd, listCmd,
+		searchCmd)
 
 	return termCmd
 }
+
+func renderTerms(cmd *cobra.Command, terms []*model.Term) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{`ID`, `Name`, `Title`, `Synonyms`, `Super ID`, `Sub ID`})
+
+	var chain = localeChain(cmd)
+
+	for _, term := range terms {
+		table.Append(func(term *model.Term) []string {
+			return []string{
+				strconv.FormatUint(term.ID, 10),
+				term.Data.Name,
+				term.Data.Labels.Title(chain, term.Data.Title),
+				joinSynonyms(term.Data.Synonyms),
+				joinIDs(term.Data.SuperID),
+				joinIDs(term.Data.SubID),
+			}
+		}(term))
+	}
+	table.Render()
+}
+
+// termLine is a term in JSON lines read by bulk commands.
+type termLine struct {
+	ID           uint64          `json:"id"`
+	Name         string          `json:"name"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	Labels       model.Labels    `json:"labels"`
+	Synonyms     []model.Synonym `json:"synonyms"`
+	VocabularyID []uint64        `json:"vocabulary_id"`
+	SuperID      []uint64        `json:"super_id"`
+	SubID        []uint64        `json:"sub_id"`
+}
+
+// readTerms reads terms until the end of input.
+func readTerms(r io.Reader) []*model.Term {
+	var (
+		terms   []*model.Term
+		decoder = json.NewDecoder(r)
+	)
+
+	for {
+		var line termLine
+		if err := decoder.Decode(&line); err != nil {
+			if errors.Is(err, io.EOF) {
+				return terms
+			}
+
+			CheckErr(fmt.Errorf(`term %d: %w`, len(terms)+1, err))
+		}
+
+		terms = append(terms, &model.Term{ID: line.ID, Data: model.TermData{
+			Name:         line.Name,
+			Title:        line.Title,
+			Description:  line.Description,
+			Labels:       line.Labels,
+			Synonyms:     line.Synonyms,
+			VocabularyID: line.VocabularyID,
+			SuperID:      line.SuperID,
+			SubID:        line.SubID,
+		}})
+	}
+}
+
+// checkBulkErr shows errors of failed terms with their line numbers.
+func checkBulkErr(cmd *cobra.Command, err error) {
+	var bulk *taxonomy.BulkError
+	if !errors.As(err, &bulk) {
+		CheckErr(err)
+
+		return
+	}
+
+	table := tablewriter.NewWriter(cmd.ErrOrStderr())
+	table.SetHeader([]string{`Line`, `Error`})
+
+	for _, i := range bulk.Indexes() {
+		table.Append([]string{strconv.Itoa(i + 1), bulk.Errors[i].Error()})
+	}
+	table.Render()
+
+	CheckErr(fmt.Errorf(`%d terms failed`, len(bulk.Errors)))
+}
+
+func termPair(args []string) (uint64, uint64) {
+	superID, err := strconv.ParseUint(args[0], 10, 64)
+	CheckErr(err)
+	subID, err := strconv.ParseUint(args[1], 10, 64)
+	CheckErr(err)
+
+	return superID, subID
+}
+
+// joinSynonyms returns visible synonyms with their locales, hidden ones are used for lookups only.
+func joinSynonyms(synonyms []model.Synonym) string {
+	var names = make([]string, 0, len(synonyms))
+
+	for _, synonym := range synonyms {
+		switch {
+		case synonym.Hidden:
+			continue
+		case synonym.Locale != ``:
+			names = append(names, synonym.Name+`@`+synonym.Locale)
+		default:
+			names = append(names, synonym.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return `—`
+	}
+
+	return strings.Join(names, `, `)
+}
+
+func joinIDs(ids []uint64) string {
+	if len(ids) == 0 {
+		return `—`
+	}
+
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatUint(id, 10)
+	}
+
+	return strings.Join(s, `, `)
+}
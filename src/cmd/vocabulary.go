@@ -30,6 +30,7 @@ func vocabularyCommand() *cobra.Command {
 				Name:        args[0],
 				Title:       cmd.Flag(`title`).Value.String(),
 				Description: &description,
+				Labels:      labels(cmd),
 				ParentID: func() *uint64 {
 					if !cmd.Flags().Changed(`parent`) {
 						return nil
@@ -46,8 +47,9 @@ func vocabularyCommand() *cobra.Command {
 	}
 
 	createCmd.Flags().StringP(`title`, `t`, ``, `title of this vocabulary`)
-	createCmd.Flags().UintP(`parent`, `p`, 0, `id of parent vocabulary for this vocabulary`)
+	createCmd.Flags().Uint64P(`parent`, `p`, 0, `id of parent vocabulary for this vocabulary`)
 	createCmd.Flags().String(`description`, ``, `description for this vocabulary`)
+	labelsFlags(createCmd)
 
 	updateCmd := &cobra.Command{
 		Use:   `update [id]`,
@@ -55,7 +57,7 @@ func vocabularyCommand() *cobra.Command {
 		Short: `Update vocabulary`,
 		Long:  `Vocabulary's name and parent must be unique.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			id, err := strconv.ParseUint(args[0], 10, 32)
+			id, err := strconv.ParseUint(args[0], 10, 64)
 			CheckErr(err)
 			var update model.VocabularyData
 			{
@@ -87,7 +89,8 @@ func vocabularyCommand() *cobra.Command {
 					}
 				}
 			}
-			_, err = service(cmd).Vocabulary.Update(cmd.Context(), uint(id), &update)
+			update.Labels = labels(cmd)
+			_, err = service(cmd).Vocabulary.Update(cmd.Context(), id, &update)
 			CheckErr(err)
 		},
 	}
@@ -96,15 +99,16 @@ func vocabularyCommand() *cobra.Command {
 	updateCmd.Flags().StringP(`name`, `n`, ``, `name of this vocabulary (name must be unique)`)
 	updateCmd.Flags().StringP(`title`, `t`, ``, `title of this vocabulary`)
 	updateCmd.Flags().String(`description`, ``, `description for this vocabulary`)
+	labelsFlags(updateCmd)
 
 	deleteCmd := &cobra.Command{
 		Use:   `delete [id]`,
 		Args:  cobra.ExactArgs(1),
 		Short: `Delete vocabulary`,
 		Run: func(cmd *cobra.Command, args []string) {
-			id, err := strconv.ParseUint(args[0], 10, 32)
+			id, err := strconv.ParseUint(args[0], 10, 64)
 			CheckErr(err)
-			CheckErr(service(cmd).Vocabulary.Delete(cmd.Context(), uint(id)))
+			CheckErr(service(cmd).Vocabulary.Delete(cmd.Context(), id))
 		},
 	}
 
@@ -119,12 +123,14 @@ func vocabularyCommand() *cobra.Command {
 			table := tablewriter.NewWriter(cmd.OutOrStdout())
 			table.SetHeader([]string{`ID`, `Name`, `Title`, `Parent ID`})
 
+			var chain = localeChain(cmd)
+
 			for _, vocabulary := range vocabularies {
 				table.Append(func(vocabulary model.Vocabulary) []string {
 					return []string{
 						strconv.Itoa(int(vocabulary.ID)),
 						vocabulary.Data.Name,
-						vocabulary.Data.Title,
+						vocabulary.Data.Labels.Title(chain, vocabulary.Data.Title),
 						func(parentId *uint64) string {
 							if parentId == nil {
 								return `—`
@@ -133,12 +139,14 @@ func vocabularyCommand() *cobra.Command {
 							return strconv.Itoa(int(*parentId))
 						}(vocabulary.Data.ParentID),
 					}
-				}(vocabulary))
+				}(*vocabulary))
 			}
 			table.Render()
 		},
 	}
 
+	listCmd.Flags().String(`locale`, ``, `show titles translated to the locale`)
+
 	vocabularyCmd.AddCommand(createCmd, updateCmd, deleteCmd, listCmd)
 
 	return vocabularyCmd
@@ -0,0 +1,62 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/cmd"
+	"github.com/dmalykh/taxonomy/cmd/loader"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dsn = `sqlite:file:cmd?mode=memory&cache=shared&_fk=1`
+
+// run executes the command like the binary does, output of the command is returned.
+func run(ctx context.Context, t *testing.T, args ...string) string {
+	t.Helper()
+
+	var out bytes.Buffer
+
+	c := cmd.New()
+	c.SetArgs(append(args, `--dsn`, dsn))
+	c.SetOut(&out)
+	require.NoError(t, c.ExecuteContext(ctx))
+
+	return out.String()
+}
+
+func TestVocabulary_Update(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	run(ctx, t, `init`)
+
+	// Service keeps in-memory database open between commands
+	service, err := loader.Load(ctx, dsn, false)
+	require.NoError(t, err)
+
+	run(ctx, t, `vocabulary`, `create`, `colors`, `--title`, `Colors`, `--locale-title`, `de=Farben,uk=Кольори`)
+
+	vocabularies, err := service.Vocabulary.Get(ctx, &model.VocabularyFilter{})
+	require.NoError(t, err)
+	require.Len(t, vocabularies, 1)
+
+	id := strconv.FormatUint(vocabularies[0].ID, 10)
+	run(ctx, t, `vocabulary`, `update`, id, `--locale-title`, `fr=Couleurs`, `--locale-description`, `de=Alle Farben`)
+
+	updated, err := service.Vocabulary.GetByID(ctx, vocabularies[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, `colors`, updated.Data.Name)
+	// Labels of listed locales are replaced, other locales are kept
+	assert.Equal(t, model.Labels{
+		`de`: {Description: `Alle Farben`},
+		`fr`: {Title: `Couleurs`},
+		`uk`: {Title: `Кольори`},
+	}, updated.Data.Labels)
+
+	assert.Contains(t, run(ctx, t, `vocabulary`, `list`, `--locale`, `fr`), `Couleurs`)
+}
@@ -3,10 +3,12 @@ package entgo
 
 import (
 	"context"
+	entsql "entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
 	"fmt"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/migrate"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/repository"
 	"log"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -16,12 +18,33 @@ import (
 )
 
 func Connect(ctx context.Context, dsn string, debug bool) (*ent.Client, error) {
+	drv, err := Open(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(drv, debug), nil
+}
+
+// NewClient returns client of the driver, debug client logs all queries.
+func NewClient(drv *entsql.Driver, debug bool) *ent.Client {
+	client := ent.NewClient(ent.Driver(drv))
+
+	if debug {
+		client = client.Debug()
+	}
+
+	return client
+}
+
+// Open returns driver of the database for the dsn, the driver is closed when context is done.
+func Open(ctx context.Context, dsn string) (*entsql.Driver, error) {
 	u, err := dburl.Parse(dsn)
 	if err != nil {
 		return nil, fmt.Errorf(`wrong dsn %w`, err)
 	}
 
-	client, err := ent.Open(u.Driver, u.DSN)
+	drv, err := entsql.Open(u.Driver, u.DSN)
 	if err != nil {
 		return nil, fmt.Errorf(`(sql open "%s") %w`, u.Driver, err)
 	}
@@ -30,26 +53,28 @@ func Connect(ctx context.Context, dsn string, debug bool) (*ent.Client, error) {
 	go func() {
 		<-ctx.Done()
 
-		if err := client.Close(); err != nil {
+		if err := drv.Close(); err != nil {
 			log.Fatal(err)
 		}
 	}()
 
-	if debug {
-		client = client.Debug()
-	}
-
-	return client, nil
+	return drv, nil
 }
 
+// Init creates tables and full-text indexes used by search of terms.
 func Init(ctx context.Context, dsn string, verbose bool) error {
-	client, err := Connect(ctx, dsn, verbose)
+	drv, err := Open(ctx, dsn)
 	if err != nil {
 		return err
 	}
 
 	// Run the automatic migration tool to create all schema resources.
-	if err := client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true), schema.WithAtlas(true)); err != nil {
+	if err := NewClient(drv, verbose).Schema.Create(ctx, migrate.WithGlobalUniqueID(true),
+		schema.WithAtlas(true)); err != nil {
+		return fmt.Errorf(`error create schema: %w`, err)
+	}
+
+	if err := repository.CreateFullText(ctx, drv); err != nil {
 		return fmt.Errorf(`error create schema: %w`, err)
 	}
 
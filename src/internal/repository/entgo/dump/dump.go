@@ -0,0 +1,107 @@
+// Package dump writes the whole database as newline delimited JSON and restores it preserving ids, so data could be
+// moved between any dialects supported by entgo.Connect. Every line is a record with type and data, the first record
+// is a header with version of the format and the last one is a footer with count of records between them:
+//
+//	{"type":"header","data":{"version":1,"created_at":"2024-01-01T00:00:00Z"}}
+//	{"type":"namespace","data":{"id":1,"name":"products"}}
+//	{"type":"vocabulary","data":{"id":4294967297,"name":"colors","parent_id":null}}
+//	{"type":"term","data":{"id":8589934593,"name":"red","vocabulary_id":[4294967297]}}
+//	{"type":"synonym","data":{"id":17179869185,"term_id":8589934593,"name":"scarlet"}}
+//	{"type":"term_link","data":{"super_id":8589934593,"sub_id":8589934594}}
+//	{"type":"reference","data":{"id":12884901889,"term_id":8589934593,"namespace_id":1,"entity_id":"sku-1"}}
+//	{"type":"footer","data":{"count":6}}
+//
+// Records of every type are ordered by ids and go after records they depend on.
+package dump
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+// Version of the format, restore refuses dumps of other versions.
+const Version = 1
+
+// batchSize is a count of rows read or written by one query.
+const batchSize = 1000
+
+var (
+	ErrVersion     = errors.New(`unsupported version of dump`)
+	ErrTruncated   = errors.New(`dump is truncated`)
+	ErrNotEmpty    = errors.New(`database isn't empty`)
+	ErrUnknownType = errors.New(`unknown type of record`)
+)
+
+const (
+	typeHeader     = `header`
+	typeNamespace  = `namespace`
+	typeVocabulary = `vocabulary`
+	typeTerm       = `term`
+	typeSynonym    = `synonym`
+	typeTermLink   = `term_link`
+	typeReference  = `reference`
+	typeFooter     = `footer`
+)
+
+type record struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type Header struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Namespace struct {
+	ID    uint64 `json:"id"`
+	Name  string `json:"name"`
+	Title string `json:"title,omitempty"`
+}
+
+type Vocabulary struct {
+	ID          uint64       `json:"id"`
+	Name        string       `json:"name"`
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Labels      model.Labels `json:"labels,omitempty"`
+	ParentID    *uint64      `json:"parent_id"`
+}
+
+type Term struct {
+	ID           uint64       `json:"id"`
+	Name         string       `json:"name"`
+	Title        string       `json:"title,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	Labels       model.Labels `json:"labels,omitempty"`
+	VocabularyID []uint64     `json:"vocabulary_id"`
+}
+
+type Synonym struct {
+	ID     uint64 `json:"id"`
+	TermID uint64 `json:"term_id"`
+	Name   string `json:"name"`
+	Locale string `json:"locale,omitempty"`
+	Hidden bool   `json:"hidden,omitempty"`
+}
+
+// TermLink makes term with SuperID broader than term with SubID.
+type TermLink struct {
+	SuperID uint64 `json:"super_id"`
+	SubID   uint64 `json:"sub_id"`
+}
+
+type Reference struct {
+	ID          uint64    `json:"id"`
+	TermID      uint64    `json:"term_id"`
+	NamespaceID uint64    `json:"namespace_id"`
+	EntityID    string    `json:"entity_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Footer struct {
+	Count uint64 `json:"count"`
+}
@@ -0,0 +1,141 @@
+package dump_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/dump"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/migrate"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/namespace"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/xiaoqidun/entps"
+)
+
+var databases int
+
+// open returns driver of new in-memory database with created schema.
+func open(t *testing.T) (*entsql.Driver, *ent.Client) {
+	databases++
+
+	drv, err := entsql.Open(`sqlite3`, fmt.Sprintf(`file:dump%d?mode=memory&cache=shared&_fk=1`, databases))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = drv.Close() })
+
+	client := ent.NewClient(ent.Driver(drv))
+	require.NoError(t, client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true)))
+
+	return drv, client
+}
+
+// seed fills the database with more rows than one batch contains.
+func seed(t *testing.T, client *ent.Client) {
+	var ctx = context.Background()
+
+	products := client.Namespace.Create().SetName(`products`).SetTitle(`Products`).SaveX(ctx)
+	client.Namespace.Create().SetName(`articles`).SaveX(ctx)
+
+	catalog := client.Vocabulary.Create().SetName(`catalog`).SetTitle(`Catalog`).
+		SetLabels(model.Labels{`de`: {Title: `Katalog`}}).SaveX(ctx)
+	colors := client.Vocabulary.Create().SetName(`colors`).SetDescription("Colors\nof goods").
+		SetParentID(catalog.ID).SaveX(ctx)
+
+	var terms = make([]*ent.Term, 0)
+	for i := 0; i < 1500; i++ {
+		terms = append(terms, client.Term.Create().SetName(fmt.Sprintf(`term %d`, i)).
+			AddVocabularyIDs(colors.ID).SaveX(ctx))
+	}
+
+	client.Term.UpdateOne(terms[0]).SetLabels(model.Labels{`de`: {Title: `Rot`, Description: `Farbe`}}).
+		AddVocabularyIDs(catalog.ID).AddSubtermIDs(terms[1].ID, terms[2].ID).ExecX(ctx)
+	client.Term.UpdateOne(terms[1499]).AddSubtermIDs(terms[0].ID).ExecX(ctx)
+	client.Synonym.Create().SetTermID(terms[0].ID).SetName(`scarlet`).ExecX(ctx)
+	client.Synonym.Create().SetTermID(terms[0].ID).SetName(`rde`).SetLocale(`de`).SetHidden(true).ExecX(ctx)
+
+	for i := 0; i < 1100; i++ {
+		client.Reference.Create().SetTermID(terms[i%10].ID).SetNamespaceID(products.ID).
+			SetEntityID(fmt.Sprintf(`sku-%d`, i)).ExecX(ctx)
+	}
+}
+
+func TestDump_Restore(t *testing.T) {
+	var ctx = context.Background()
+
+	source, client := open(t)
+	seed(t, client)
+
+	var dumped bytes.Buffer
+	require.NoError(t, dump.Dump(ctx, source, &dumped))
+
+	lines := strings.Split(strings.TrimSpace(dumped.String()), "\n")
+	require.Len(t, lines, 1+2+2+1500+2+3+1100+1)
+	assert.Contains(t, lines[0], `"version":1`)
+	assert.Equal(t, `{"type":"footer","data":{"count":2609}}`, lines[len(lines)-1])
+
+	target, restored := open(t)
+	require.NoError(t, dump.Restore(ctx, target, strings.NewReader(dumped.String())))
+
+	var again bytes.Buffer
+	require.NoError(t, dump.Dump(ctx, target, &again))
+	assert.Equal(t, lines[1:], strings.Split(strings.TrimSpace(again.String()), "\n")[1:])
+
+	// New rows get ids after restored ones
+	ns := restored.Namespace.Create().SetName(`reviews`).SaveX(ctx)
+	assert.Greater(t, ns.ID, restored.Namespace.Query().Where(namespace.Name(`articles`)).OnlyIDX(ctx))
+
+	assert.ErrorIs(t, dump.Restore(ctx, target, strings.NewReader(dumped.String())), dump.ErrNotEmpty)
+}
+
+func TestRestore_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		dump string
+		err  error
+	}{
+		{
+			name: `truncated`,
+			dump: `{"type":"header","data":{"version":1}}
+{"type":"namespace","data":{"id":1,"name":"products"}}`,
+			err: dump.ErrTruncated,
+		},
+		{
+			name: `wrong count`,
+			dump: `{"type":"header","data":{"version":1}}
+{"type":"namespace","data":{"id":1,"name":"products"}}
+{"type":"footer","data":{"count":2}}`,
+			err: dump.ErrTruncated,
+		},
+		{
+			name: `unsupported version`,
+			dump: `{"type":"header","data":{"version":2}}`,
+			err:  dump.ErrVersion,
+		},
+		{
+			name: `without header`,
+			dump: `{"type":"namespace","data":{"id":1,"name":"products"}}`,
+			err:  dump.ErrVersion,
+		},
+		{
+			name: `unknown type`,
+			dump: `{"type":"header","data":{"version":1}}
+{"type":"glossary","data":{}}`,
+			err: dump.ErrUnknownType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drv, client := open(t)
+
+			assert.ErrorIs(t, dump.Restore(context.Background(), drv, strings.NewReader(tt.dump)), tt.err)
+			assert.False(t, client.Namespace.Query().ExistX(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+)
+
+// Restore reads the dump into empty database in one transaction, nothing is restored when any record fails. Ids are
+// preserved, sequences of ids in PostgreSQL are moved after restored ids.
+func Restore(ctx context.Context, drv *entsql.Driver, r io.Reader) error {
+	client := ent.NewClient(ent.Driver(drv))
+
+	if err := checkEmpty(ctx, client); err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf(`start transaction: %w`, err)
+	}
+
+	if err := (&restorer{client: tx.Client()}).restore(ctx, r); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`commit: %w`, err)
+	}
+
+	if drv.Dialect() == dialect.Postgres {
+		return resetSequences(ctx, drv)
+	}
+
+	return nil
+}
+
+func checkEmpty(ctx context.Context, client *ent.Client) error {
+	for name, exist := range map[string]func(context.Context) (bool, error){
+		`namespaces`:   client.Namespace.Query().Exist,
+		`vocabularies`: client.Vocabulary.Query().Exist,
+		`terms`:        client.Term.Query().Exist,
+		`references`:   client.Reference.Query().Exist,
+	} {
+		found, err := exist(ctx)
+		if err != nil {
+			return fmt.Errorf(`check %s: %w`, name, err)
+		}
+
+		if found {
+			return fmt.Errorf(`%w: %s exist`, ErrNotEmpty, name)
+		}
+	}
+
+	return nil
+}
+
+// resetSequences sets sequences to maximal ids, PostgreSQL doesn't move them when ids are inserted explicitly.
+func resetSequences(ctx context.Context, drv *entsql.Driver) error {
+	for _, table := range []string{`namespaces`, `vocabularies`, `terms`, `synonyms`, `"references"`} {
+		if _, err := drv.ExecContext(ctx, fmt.Sprintf(
+			`SELECT setval(pg_get_serial_sequence('%s', 'id'), MAX(id)) FROM %s HAVING MAX(id) IS NOT NULL`,
+			table, table)); err != nil {
+			return fmt.Errorf(`reset sequence of %s: %w`, table, err)
+		}
+	}
+
+	return nil
+}
+
+// restorer collects rows of the same type and creates them by batches.
+type restorer struct {
+	client       *ent.Client
+	namespaces   []*ent.NamespaceCreate
+	vocabularies []*ent.VocabularyCreate
+	terms        []*ent.TermCreate
+	synonyms     []*ent.SynonymCreate
+	links        []TermLink
+	references   []*ent.ReferenceCreate
+	pending      int
+}
+
+func (r *restorer) restore(ctx context.Context, in io.Reader) error {
+	var (
+		decoder = json.NewDecoder(in)
+		count   uint64
+		footer  *Footer
+	)
+
+	for i := 1; ; i++ {
+		var rec record
+		if err := decoder.Decode(&rec); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf(`record %d: %w`, i, err)
+		}
+
+		switch {
+		case i == 1 && rec.Type != typeHeader:
+			return fmt.Errorf(`%w: header is missing`, ErrVersion)
+		case footer != nil:
+			return fmt.Errorf(`record %d: %s after footer`, i, rec.Type)
+		}
+
+		if err := r.add(ctx, rec); err != nil {
+			return fmt.Errorf(`record %d: %w`, i, err)
+		}
+
+		if rec.Type == typeFooter {
+			footer = new(Footer)
+			if err := json.Unmarshal(rec.Data, footer); err != nil {
+				return fmt.Errorf(`record %d: %w`, i, err)
+			}
+		} else if rec.Type != typeHeader {
+			count++
+		}
+	}
+
+	if footer == nil || footer.Count != count {
+		return fmt.Errorf(`%w: %d records restored`, ErrTruncated, count)
+	}
+
+	return nil
+}
+
+// add adds row of the record to the batch, the batch is created when type of rows changes or it's full.
+func (r *restorer) add(ctx context.Context, rec record) error {
+	var flush = r.pending >= batchSize ||
+		len(r.namespaces) > 0 && rec.Type != typeNamespace ||
+		len(r.vocabularies) > 0 && rec.Type != typeVocabulary ||
+		len(r.terms) > 0 && rec.Type != typeTerm ||
+		len(r.synonyms) > 0 && rec.Type != typeSynonym ||
+		len(r.links) > 0 && rec.Type != typeTermLink ||
+		len(r.references) > 0 && rec.Type != typeReference
+
+	if flush {
+		if err := r.flush(ctx); err != nil {
+			return err
+		}
+	}
+
+	switch rec.Type {
+	case typeHeader:
+		var header Header
+		if err := json.Unmarshal(rec.Data, &header); err != nil {
+			return fmt.Errorf(`header: %w`, err)
+		}
+
+		if header.Version != Version {
+			return fmt.Errorf(`%w %d`, ErrVersion, header.Version)
+		}
+
+		return nil
+	case typeFooter:
+		return nil
+	case typeNamespace:
+		var ns Namespace
+		if err := json.Unmarshal(rec.Data, &ns); err != nil {
+			return fmt.Errorf(`namespace: %w`, err)
+		}
+
+		r.namespaces = append(r.namespaces, r.client.Namespace.Create().
+			SetID(ns.ID).SetName(ns.Name).SetTitle(ns.Title))
+	case typeVocabulary:
+		var v Vocabulary
+		if err := json.Unmarshal(rec.Data, &v); err != nil {
+			return fmt.Errorf(`vocabulary: %w`, err)
+		}
+
+		r.vocabularies = append(r.vocabularies, r.client.Vocabulary.Create().
+			SetID(v.ID).SetName(v.Name).SetTitle(v.Title).SetDescription(v.Description).SetLabels(v.Labels).
+			SetNillableParentID(v.ParentID))
+	case typeTerm:
+		var t Term
+		if err := json.Unmarshal(rec.Data, &t); err != nil {
+			return fmt.Errorf(`term: %w`, err)
+		}
+
+		r.terms = append(r.terms, r.client.Term.Create().
+			SetID(t.ID).SetName(t.Name).SetTitle(t.Title).SetDescription(t.Description).SetLabels(t.Labels).
+			AddVocabularyIDs(t.VocabularyID...))
+	case typeSynonym:
+		var s Synonym
+		if err := json.Unmarshal(rec.Data, &s); err != nil {
+			return fmt.Errorf(`synonym: %w`, err)
+		}
+
+		r.synonyms = append(r.synonyms, r.client.Synonym.Create().
+			SetID(s.ID).SetTermID(s.TermID).SetName(s.Name).SetLocale(s.Locale).SetHidden(s.Hidden))
+	case typeTermLink:
+		var link TermLink
+		if err := json.Unmarshal(rec.Data, &link); err != nil {
+			return fmt.Errorf(`term link: %w`, err)
+		}
+
+		r.links = append(r.links, link)
+	case typeReference:
+		var ref Reference
+		if err := json.Unmarshal(rec.Data, &ref); err != nil {
+			return fmt.Errorf(`reference: %w`, err)
+		}
+
+		r.references = append(r.references, r.client.Reference.Create().
+			SetID(ref.ID).SetTermID(ref.TermID).SetNamespaceID(ref.NamespaceID).SetEntityID(ref.EntityID).
+			SetCreatedAt(ref.CreatedAt))
+	default:
+		return fmt.Errorf(`%w %q`, ErrUnknownType, rec.Type)
+	}
+
+	r.pending++
+
+	return nil
+}
+
+// flush creates collected rows.
+func (r *restorer) flush(ctx context.Context) error {
+	var err error
+
+	switch {
+	case len(r.namespaces) > 0:
+		err = r.client.Namespace.CreateBulk(r.namespaces...).Exec(ctx)
+	case len(r.vocabularies) > 0:
+		err = r.client.Vocabulary.CreateBulk(r.vocabularies...).Exec(ctx)
+	case len(r.terms) > 0:
+		err = r.client.Term.CreateBulk(r.terms...).Exec(ctx)
+	case len(r.synonyms) > 0:
+		err = r.client.Synonym.CreateBulk(r.synonyms...).Exec(ctx)
+	case len(r.links) > 0:
+		err = r.link(ctx)
+	case len(r.references) > 0:
+		err = r.client.Reference.CreateBulk(r.references...).Exec(ctx)
+	}
+
+	r.namespaces, r.vocabularies, r.terms, r.synonyms, r.links, r.references = nil, nil, nil, nil, nil, nil
+	r.pending = 0
+
+	if err != nil {
+		return fmt.Errorf(`restore batch: %w`, err)
+	}
+
+	return nil
+}
+
+// link adds narrower terms to every broader term of the batch with one query.
+func (r *restorer) link(ctx context.Context) error {
+	var (
+		supers []uint64
+		subs   = make(map[uint64][]uint64)
+	)
+
+	for _, link := range r.links {
+		if _, ok := subs[link.SuperID]; !ok {
+			supers = append(supers, link.SuperID)
+		}
+
+		subs[link.SuperID] = append(subs[link.SuperID], link.SubID)
+	}
+
+	for _, id := range supers {
+		if err := r.client.Term.UpdateOneID(id).AddSubtermIDs(subs[id]...).Exec(ctx); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package dump
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/namespace"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/reference"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/synonym"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/term"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/vocabulary"
+)
+
+type writer struct {
+	encoder *json.Encoder
+	count   uint64
+}
+
+func (w *writer) write(typ string, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf(`marshal %s: %w`, typ, err)
+	}
+
+	if err := w.encoder.Encode(record{Type: typ, Data: raw}); err != nil {
+		return fmt.Errorf(`write %s: %w`, typ, err)
+	}
+
+	w.count++
+
+	return nil
+}
+
+// Dump writes all rows in one read transaction, so the dump is consistent while the database is used.
+func Dump(ctx context.Context, drv *entsql.Driver, w io.Writer) error {
+	tx, err := ent.NewClient(ent.Driver(drv)).Tx(ctx)
+	if err != nil {
+		return fmt.Errorf(`start transaction: %w`, err)
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	var (
+		buf = bufio.NewWriter(w)
+		out = &writer{encoder: json.NewEncoder(buf)}
+	)
+
+	if err := out.write(typeHeader, Header{Version: Version, CreatedAt: time.Now().UTC()}); err != nil {
+		return err
+	}
+
+	var client = tx.Client()
+
+	for _, dump := range []func(context.Context, *ent.Client, *writer) error{
+		dumpNamespaces, dumpVocabularies, dumpTerms, dumpSynonyms, dumpTermLinks, dumpReferences,
+	} {
+		if err := dump(ctx, client, out); err != nil {
+			return err
+		}
+	}
+
+	// Header isn't counted
+	if err := out.write(typeFooter, Footer{Count: out.count - 1}); err != nil {
+		return err
+	}
+
+	return errors.Join(buf.Flush(), tx.Commit())
+}
+
+// batches calls fn for batches of rows ordered by id until a batch is shorter than batchSize.
+func batches[T any](ctx context.Context, get func(ctx context.Context, afterID uint64) ([]T, error),
+	id func(T) uint64, fn func(T) error,
+) error {
+	var afterID uint64
+
+	for {
+		rows, err := get(ctx, afterID)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+
+		if len(rows) < batchSize {
+			return nil
+		}
+
+		afterID = id(rows[len(rows)-1])
+	}
+}
+
+func dumpNamespaces(ctx context.Context, client *ent.Client, out *writer) error {
+	return batches(ctx, func(ctx context.Context, afterID uint64) ([]*ent.Namespace, error) {
+		return client.Namespace.Query().Where(namespace.IDGT(afterID)).
+			Order(ent.Asc(namespace.FieldID)).Limit(batchSize).All(ctx)
+	}, func(ns *ent.Namespace) uint64 {
+		return ns.ID
+	}, func(ns *ent.Namespace) error {
+		return out.write(typeNamespace, Namespace{ID: ns.ID, Name: ns.Name, Title: ns.Title})
+	})
+}
+
+func dumpVocabularies(ctx context.Context, client *ent.Client, out *writer) error {
+	return batches(ctx, func(ctx context.Context, afterID uint64) ([]*ent.Vocabulary, error) {
+		return client.Vocabulary.Query().Where(vocabulary.IDGT(afterID)).
+			Order(ent.Asc(vocabulary.FieldID)).Limit(batchSize).All(ctx)
+	}, func(v *ent.Vocabulary) uint64 {
+		return v.ID
+	}, func(v *ent.Vocabulary) error {
+		return out.write(typeVocabulary, Vocabulary{
+			ID:          v.ID,
+			Name:        v.Name,
+			Title:       v.Title,
+			Description: v.Description,
+			Labels:      v.Labels,
+			ParentID:    v.ParentID,
+		})
+	})
+}
+
+func dumpTerms(ctx context.Context, client *ent.Client, out *writer) error {
+	return batches(ctx, func(ctx context.Context, afterID uint64) ([]*ent.Term, error) {
+		return client.Term.Query().Where(term.IDGT(afterID)).Order(ent.Asc(term.FieldID)).Limit(batchSize).
+			WithVocabulary(func(q *ent.VocabularyQuery) {
+				q.Select(vocabulary.FieldID).Order(ent.Asc(vocabulary.FieldID))
+			}).All(ctx)
+	}, func(t *ent.Term) uint64 {
+		return t.ID
+	}, func(t *ent.Term) error {
+		var vocabularyID = make([]uint64, len(t.Edges.Vocabulary))
+		for i, v := range t.Edges.Vocabulary {
+			vocabularyID[i] = v.ID
+		}
+
+		return out.write(typeTerm, Term{
+			ID:           t.ID,
+			Name:         t.Name,
+			Title:        t.Title,
+			Description:  t.Description,
+			Labels:       t.Labels,
+			VocabularyID: vocabularyID,
+		})
+	})
+}
+
+func dumpSynonyms(ctx context.Context, client *ent.Client, out *writer) error {
+	return batches(ctx, func(ctx context.Context, afterID uint64) ([]*ent.Synonym, error) {
+		return client.Synonym.Query().Where(synonym.IDGT(afterID)).
+			Order(ent.Asc(synonym.FieldID)).Limit(batchSize).All(ctx)
+	}, func(s *ent.Synonym) uint64 {
+		return s.ID
+	}, func(s *ent.Synonym) error {
+		return out.write(typeSynonym, Synonym{
+			ID:     s.ID,
+			TermID: s.TermID,
+			Name:   s.Name,
+			Locale: s.Locale,
+			Hidden: s.Hidden,
+		})
+	})
+}
+
+// dumpTermLinks writes links after all terms, because linked terms could have greater ids.
+func dumpTermLinks(ctx context.Context, client *ent.Client, out *writer) error {
+	return batches(ctx, func(ctx context.Context, afterID uint64) ([]*ent.Term, error) {
+		return client.Term.Query().Where(term.IDGT(afterID)).Order(ent.Asc(term.FieldID)).Limit(batchSize).
+			Select(term.FieldID).
+			WithSubterms(func(q *ent.TermQuery) {
+				q.Select(term.FieldID).Order(ent.Asc(term.FieldID))
+			}).All(ctx)
+	}, func(t *ent.Term) uint64 {
+		return t.ID
+	}, func(t *ent.Term) error {
+		for _, sub := range t.Edges.Subterms {
+			if err := out.write(typeTermLink, TermLink{SuperID: t.ID, SubID: sub.ID}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func dumpReferences(ctx context.Context, client *ent.Client, out *writer) error {
+	return batches(ctx, func(ctx context.Context, afterID uint64) ([]*ent.Reference, error) {
+		return client.Reference.Query().Where(reference.IDGT(afterID)).
+			Order(ent.Asc(reference.FieldID)).Limit(batchSize).All(ctx)
+	}, func(r *ent.Reference) uint64 {
+		return r.ID
+	}, func(r *ent.Reference) error {
+		return out.write(typeReference, Reference{
+			ID:          r.ID,
+			TermID:      r.TermID,
+			NamespaceID: r.NamespaceID,
+			EntityID:    r.EntityID,
+			CreatedAt:   r.CreatedAt.UTC(),
+		})
+	})
+}
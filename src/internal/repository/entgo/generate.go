@@ -1,3 +1,3 @@
 package entgo
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/upsert --target ./ent ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/upsert,sql/modifier --target ./ent ./schema
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Recursive queries below return ids of the objects themselves and all their ancestors or descendants not deeper than
+// limit. Ids are put into queries as numbers or subqueries, so queries don't depend on dialect's placeholders.
+const (
+	// vocabularyAncestorsQuery walks up through vocabulary's parent_id.
+	vocabularyAncestorsQuery = `WITH RECURSIVE ancestors(id, parent_id, depth) AS (` +
+		`SELECT id, parent_id, 0 FROM %[1]s WHERE id IN (%[2]s) ` +
+		`UNION SELECT v.id, v.parent_id, a.depth + 1 FROM %[1]s v JOIN ancestors a ON v.id = a.parent_id ` +
+		`WHERE a.depth < %[3]d) SELECT id FROM ancestors`
+	// vocabularyDescendantsQuery walks down through vocabularies having parent_id of found ones.
+	vocabularyDescendantsQuery = `WITH RECURSIVE descendants(id, depth) AS (` +
+		`SELECT id, 0 FROM %[1]s WHERE id IN (%[2]s) ` +
+		`UNION SELECT v.id, d.depth + 1 FROM %[1]s v JOIN descendants d ON v.parent_id = d.id ` +
+		`WHERE d.depth < %[3]d) SELECT id FROM descendants`
+	// termAncestorsQuery walks up through edges table, where the first column keeps broader term and the second one
+	// keeps narrower term.
+	termAncestorsQuery = `WITH RECURSIVE ancestors(id, depth) AS (` +
+		`SELECT id, 0 FROM %[1]s WHERE id IN (%[3]s) ` +
+		`UNION SELECT e.%[4]s, a.depth + 1 FROM %[2]s e JOIN ancestors a ON e.%[5]s = a.id ` +
+		`WHERE a.depth < %[6]d) SELECT id FROM ancestors`
+	// termDescendantsQuery walks down through edges table.
+	termDescendantsQuery = `WITH RECURSIVE descendants(id, depth) AS (` +
+		`SELECT id, 0 FROM %[1]s WHERE id IN (%[3]s) ` +
+		`UNION SELECT e.%[5]s, d.depth + 1 FROM %[2]s e JOIN descendants d ON e.%[4]s = d.id ` +
+		`WHERE d.depth < %[6]d) SELECT id FROM descendants`
+)
+
+// maxDepth limits recursive queries which don't have depth from caller. Depth of hierarchies is checked by services,
+// so the limit only guards queries from endless loops.
+const maxDepth = 64
+
+// ids joins ids for IN condition.
+func ids(id ...uint64) string {
+	s := make([]string, len(id))
+	for i, v := range id {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+
+	return strings.Join(s, `, `)
+}
+
+// idIn returns predicate which limits selected objects by result of recursive query.
+func idIn(column string, query string) func(s *sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(fmt.Sprintf(`%s IN (%s)`, s.C(column), query)))
+	}
+}
+
+// byDepth sorts objects by their depth, objects of the same depth are sorted by id.
+func byDepth[T any](items []T, id func(T) uint64, depth map[uint64]uint) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Or(cmp.Compare(depth[id(a)], depth[id(b)]), cmp.Compare(id(a), id(b)))
+	})
+}
+
+// levels returns minimal distance from the root to every reachable object, next returns ids of objects on the next
+// level.
+func levels(root uint64, next func(id uint64) []uint64) map[uint64]uint {
+	var (
+		depth   = map[uint64]uint{root: 0}
+		current = []uint64{root}
+	)
+
+	for len(current) > 0 {
+		var following []uint64
+
+		for _, id := range current {
+			for _, n := range next(id) {
+				if _, ok := depth[n]; ok {
+					continue
+				}
+
+				depth[n] = depth[id] + 1
+				following = append(following, n)
+			}
+		}
+
+		current = following
+	}
+
+	return depth
+}
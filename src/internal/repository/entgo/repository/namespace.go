@@ -17,12 +17,21 @@ func NewNamespace(client *ent.NamespaceClient) repository.Namespace {
 	}
 }
 
+// clientFrom returns client of transaction started by Transaction or own client when context has no transaction.
+func (n *Namespace) clientFrom(ctx context.Context) *ent.NamespaceClient {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Namespace
+	}
+
+	return n.client
+}
+
 type Namespace struct {
 	client *ent.NamespaceClient
 }
 
 func (n *Namespace) Create(ctx context.Context, data *model.NamespaceData) (*model.Namespace, error) {
-	ns, err := n.client.Create().
+	ns, err := n.clientFrom(ctx).Create().
 		SetName(data.Name).
 		SetTitle(data.Title).
 		Save(ctx)
@@ -34,7 +43,7 @@ func (n *Namespace) Create(ctx context.Context, data *model.NamespaceData) (*mod
 }
 
 func (n *Namespace) Update(ctx context.Context, id uint64, data *model.NamespaceData) (*model.Namespace, error) {
-	ns, err := n.client.UpdateOneID(id).
+	ns, err := n.clientFrom(ctx).UpdateOneID(id).
 		SetName(data.Name).
 		SetTitle(data.Title).
 		Save(ctx)
@@ -46,7 +55,7 @@ func (n *Namespace) Update(ctx context.Context, id uint64, data *model.Namespace
 }
 
 func (n *Namespace) Delete(ctx context.Context, filter *repository.NamespaceFilter) error {
-	_, err := n.client.Delete().Where(
+	_, err := n.clientFrom(ctx).Delete().Where(
 		n.buildQuery(filter)...,
 	).Exec(ctx)
 	if err != nil {
@@ -57,9 +66,9 @@ func (n *Namespace) Delete(ctx context.Context, filter *repository.NamespaceFilt
 }
 
 func (n *Namespace) Get(ctx context.Context, filter *repository.NamespaceFilter) ([]*model.Namespace, error) {
-	nss, err := n.client.Query().Where(
+	nss, err := n.clientFrom(ctx).Query().Where(
 		n.buildQuery(filter)...,
-	).Limit(int(filter.Limit)).All(ctx)
+	).Order(ent.Asc(namespace.FieldID)).Limit(int(filter.Limit)).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", repository.ErrFindNamespace, err.Error())
 	}
@@ -76,6 +85,11 @@ func (n *Namespace) Get(ctx context.Context, filter *repository.NamespaceFilter)
 func (n *Namespace) buildQuery(filter *repository.NamespaceFilter) []predicate.Namespace {
 	var predicates = make([]predicate.Namespace, 0)
 
+	// Filter by ids
+	if len(filter.ID) > 0 {
+		predicates = append(predicates, namespace.IDIn(filter.ID...))
+	}
+
 	// Filter by fill name
 	if len(filter.Name) > 0 {
 		predicates = append(predicates, namespace.NameIn(filter.Name...))
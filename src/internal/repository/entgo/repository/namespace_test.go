@@ -70,3 +70,28 @@ func TestNamespace_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespace_Delete(t *testing.T) {
+	ctx := context.TODO()
+	client := enttest.Open(t, "sqlite3", ":memory:?_fk=1", []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}...)
+
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	c := repo.NewNamespace(client.Namespace)
+
+	first, err := c.Create(ctx, &model.NamespaceData{Name: `products`})
+	require.NoError(t, err)
+	second, err := c.Create(ctx, &model.NamespaceData{Name: `articles`})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Delete(ctx, &repository.NamespaceFilter{ID: []uint64{first.ID}}))
+
+	got, err := c.Get(ctx, &repository.NamespaceFilter{})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, second.ID, got[0].ID)
+}
@@ -1,16 +1,21 @@
 package repository
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"entgo.io/ent/dialect/sql"
 	"errors"
 	"fmt"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/predicate"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/reference"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/term"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/vocabulary"
 	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
 	"github.com/samber/lo"
+	"slices"
 )
 
 type Reference struct {
@@ -23,12 +28,21 @@ func NewReference(client *ent.ReferenceClient) repository.Reference {
 	}
 }
 
+// clientFrom returns client bound to transaction from context or the default one.
+func (r *Reference) clientFrom(ctx context.Context) *ent.ReferenceClient {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Reference
+	}
+
+	return r.client
+}
+
 func (r *Reference) Set(ctx context.Context, reference ...*repository.ReferenceModel) error {
-	err := r.client.CreateBulk(func() []*ent.ReferenceCreate {
+	err := r.clientFrom(ctx).CreateBulk(func() []*ent.ReferenceCreate {
 		create := make([]*ent.ReferenceCreate, 0, len(reference))
 
 		for _, rel := range reference {
-			create = append(create, r.client.Create().
+			create = append(create, r.clientFrom(ctx).Create().
 				SetTermID(rel.TermID).
 				SetNamespaceID(rel.NamespaceID).
 				SetEntityID(string(rel.EntityID)),
@@ -61,27 +75,43 @@ func (r *Reference) buildQuery(filter *repository.ReferenceFilter) []predicate.R
 
 		var groups = make([]predicate.Reference, 0, len(filter.TermID))
 		for _, termID := range filter.TermID {
-			terms := lo.Map[uint64, any](termID, func(item uint64, index int) any {
-				return any(item)
-			})
 			groups = append(groups, func(s *sql.Selector) {
+				grouped := sql.Table(reference.Table).As(`grouped`)
 				s.Where(sql.In(
 					s.C(reference.FieldEntityID),
-					sql.Select(s.C(reference.FieldEntityID)).
-						From(sql.Table(reference.Table)).
-						Where(sql.In(
-							s.C(reference.FieldTermID),
-							terms...),
-						),
+					sql.Select(grouped.C(reference.FieldEntityID)).
+						From(grouped).
+						Where(sql.And(
+							sql.ColumnsEQ(grouped.C(reference.FieldNamespaceID), s.C(reference.FieldNamespaceID)),
+							r.termIn(grouped.C(reference.FieldTermID), termID, filter),
+						)),
 				))
 			})
 		}
 		predicates = append(predicates,
-			reference.TermIDIn(lo.Uniq[uint64](lo.Flatten[uint64](filter.TermID))...),
+			func(s *sql.Selector) {
+				s.Where(r.termIn(s.C(reference.FieldTermID), lo.Uniq[uint64](lo.Flatten[uint64](filter.TermID)), filter))
+			},
 			reference.And(groups...),
 		)
 	}
 
+	// Exclude entities having any term of excluded group
+	for _, termID := range filter.ExcludeTermID {
+		predicates = append(predicates, func(s *sql.Selector) {
+			excluded := sql.Table(reference.Table).As(`excluded`)
+			s.Where(sql.NotIn(
+				s.C(reference.FieldEntityID),
+				sql.Select(excluded.C(reference.FieldEntityID)).
+					From(excluded).
+					Where(sql.And(
+						sql.ColumnsEQ(excluded.C(reference.FieldNamespaceID), s.C(reference.FieldNamespaceID)),
+						r.termIn(excluded.C(reference.FieldTermID), termID, filter),
+					)),
+			))
+		})
+	}
+
 	// Filter by entity
 	if len(filter.EntityID) > 0 {
 		predicates = append(predicates, reference.EntityIDIn(func() []string {
@@ -101,12 +131,55 @@ func (r *Reference) buildQuery(filter *repository.ReferenceFilter) []predicate.R
 	return predicates
 }
 
+// termIn returns condition for term's column. Terms are expanded with narrower terms and terms of nested vocabularies
+// when filter requires it.
+func (r *Reference) termIn(column string, termID []uint64, filter *repository.ReferenceFilter) *sql.Predicate {
+	var predicates = []*sql.Predicate{
+		sql.In(column, lo.Map[uint64, any](termID, func(item uint64, index int) any {
+			return any(item)
+		})...),
+	}
+
+	if filter.WithSubterms {
+		predicates = append(predicates, sql.ExprP(fmt.Sprintf(`%s IN (%s)`, column, fmt.Sprintf(termDescendantsQuery,
+			term.Table, term.SubtermsTable, ids(termID...), term.SubtermsPrimaryKey[0], term.SubtermsPrimaryKey[1],
+			maxDepth))))
+	}
+
+	if filter.WithVocabularyDescendants {
+		// Vocabularies of terms are excluded, only nested ones are used
+		vocabularies := fmt.Sprintf(vocabularyDescendantsQuery, vocabulary.Table,
+			fmt.Sprintf(`SELECT %s FROM %s WHERE %s IN (%s)`, term.VocabularyPrimaryKey[0], term.VocabularyTable,
+				term.VocabularyPrimaryKey[1], ids(termID...)), maxDepth) + ` WHERE depth > 0`
+		predicates = append(predicates, sql.ExprP(fmt.Sprintf(`%s IN (SELECT %s FROM %s WHERE %s IN (%s))`, column,
+			term.VocabularyPrimaryKey[1], term.VocabularyTable, term.VocabularyPrimaryKey[0], vocabularies)))
+	}
+
+	return sql.Or(predicates...)
+}
+
 func (r *Reference) Delete(ctx context.Context, filter *repository.ReferenceFilter) error {
 	if len(filter.NamespaceID) == 0 {
 		return repository.ErrWithoutNamespace
 	}
 
-	_, err := r.client.Delete().Where(
+	// MySQL can't delete from the table read by subqueries of term groups, so references are found first
+	if len(filter.TermID) > 0 || len(filter.ExcludeTermID) > 0 {
+		id, err := r.clientFrom(ctx).Query().Where(r.buildQuery(filter)...).IDs(ctx)
+		if err != nil {
+			return errors.Join(repository.ErrDeleteReferences, err)
+		}
+
+		for batch := range slices.Chunk(id, bulkSize) {
+			if _, err := r.clientFrom(ctx).Delete().Where(reference.IDIn(batch...)).Exec(ctx); err != nil {
+				return errors.Join(repository.ErrDeleteReferences, err)
+			}
+		}
+
+		return nil
+	}
+
+	_, err := r.clientFrom(ctx).Delete().Where(
 		r.buildQuery(filter)...,
 	).Exec(ctx)
 	if err != nil {
@@ -121,9 +194,15 @@ func (r *Reference) Get(ctx context.Context, filter *repository.ReferenceFilter)
 		return nil, repository.ErrWithoutNamespace
 	}
 
-	entreferences, err := r.client.Query().Where(
+	query := r.clientFrom(ctx).Query().Where(
 		reference.And(r.buildQuery(filter)...),
-	).All(ctx)
+	).Order(ent.Asc(reference.FieldID))
+
+	if filter.Limit != nil {
+		query.Limit(int(*filter.Limit))
+	}
+
+	entreferences, err := query.All(ctx)
 	if err != nil {
 		return nil, errors.Join(repository.ErrGetReference, err)
 	}
@@ -140,3 +219,209 @@ func (r *Reference) Get(ctx context.Context, filter *repository.ReferenceFilter)
 
 	return references, nil
 }
+
+func (r *Reference) Count(ctx context.Context, filter *repository.ReferenceFilter) (uint64, error) {
+	count, err := r.clientFrom(ctx).Query().Where(
+		reference.And(r.buildQuery(filter)...),
+	).Count(ctx)
+	if err != nil {
+		return 0, errors.Join(repository.ErrGetReference, err)
+	}
+
+	return uint64(count), nil
+}
+
+func (r *Reference) Iterate(ctx context.Context, filter *repository.ReferenceFilter, batchSize uint,
+	fn func(reference *repository.ReferenceModel) error,
+) error {
+	if batchSize == 0 {
+		return repository.ErrZeroBatchSize
+	}
+
+	// Copy filter to keep caller's cursor untouched
+	var batch = *filter
+	batch.Limit = &batchSize
+
+	for {
+		references, err := r.Get(ctx, &batch)
+		if err != nil {
+			return err
+		}
+
+		for _, reference := range references {
+			if err := fn(reference); err != nil {
+				return err
+			}
+		}
+
+		if uint(len(references)) < batchSize {
+			return nil
+		}
+
+		batch.AfterID = &references[len(references)-1].ID
+	}
+}
+
+func (r *Reference) Facets(ctx context.Context, filter *repository.ReferenceFilter) (*model.Facets, error) {
+	if len(filter.NamespaceID) == 0 {
+		return nil, repository.ErrWithoutNamespace
+	}
+
+	// Counts are taken among all references of matched entities, so every facet shows how many entities match
+	// the filter with one more group of the facet's term or vocabulary.
+	var matched = func(s *sql.Selector) {
+		s.Where(sql.In(s.C(reference.FieldNamespaceID), lo.Map[uint64, any](filter.NamespaceID, func(item uint64, index int) any {
+			return any(item)
+		})...))
+
+		if len(filter.TermID) == 0 && len(filter.ExcludeTermID) == 0 && len(filter.EntityID) == 0 {
+			return
+		}
+
+		entities := sql.Select(reference.FieldEntityID).From(sql.Table(reference.Table))
+		reference.And(r.buildQuery(&repository.ReferenceFilter{
+			TermID:                    filter.TermID,
+			ExcludeTermID:             filter.ExcludeTermID,
+			NamespaceID:               filter.NamespaceID,
+			EntityID:                  filter.EntityID,
+			WithSubterms:              filter.WithSubterms,
+			WithVocabularyDescendants: filter.WithVocabularyDescendants,
+		})...)(entities)
+		s.Where(sql.In(s.C(reference.FieldEntityID), entities))
+	}
+
+	var terms []struct {
+		TermID uint64 `sql:"term_id"`
+		Count  uint64 `sql:"count"`
+	}
+
+	err := r.clientFrom(ctx).Query().Where(matched).
+		GroupBy(reference.FieldTermID).
+		Aggregate(countEntities).
+		Scan(ctx, &terms)
+	if err != nil {
+		return nil, errors.Join(repository.ErrGetReference, err)
+	}
+
+	var vocabularies []struct {
+		VocabularyID uint64 `sql:"vocabulary_id"`
+		Count        uint64 `sql:"count"`
+	}
+
+	err = r.clientFrom(ctx).Query().Where(matched).
+		Modify(func(s *sql.Selector) {
+			t := sql.Table(term.VocabularyTable)
+			s.Join(t).On(s.C(reference.FieldTermID), t.C(term.VocabularyPrimaryKey[1])).
+				Select(sql.As(t.C(term.VocabularyPrimaryKey[0]), `vocabulary_id`), countEntities(s)).
+				GroupBy(t.C(term.VocabularyPrimaryKey[0]))
+		}).
+		Scan(ctx, &vocabularies)
+	if err != nil {
+		return nil, errors.Join(repository.ErrGetReference, err)
+	}
+
+	var facets = &model.Facets{
+		Terms:        make([]*model.TermFacet, len(terms)),
+		Vocabularies: make([]*model.VocabularyFacet, len(vocabularies)),
+	}
+
+	for i, t := range terms {
+		facets.Terms[i] = &model.TermFacet{TermID: t.TermID, Count: t.Count}
+	}
+
+	for i, v := range vocabularies {
+		facets.Vocabularies[i] = &model.VocabularyFacet{VocabularyID: v.VocabularyID, Count: v.Count}
+	}
+
+	slices.SortFunc(facets.Terms, func(a, b *model.TermFacet) int {
+		return cmp.Compare(a.TermID, b.TermID)
+	})
+	slices.SortFunc(facets.Vocabularies, func(a, b *model.VocabularyFacet) int {
+		return cmp.Compare(a.VocabularyID, b.VocabularyID)
+	})
+
+	return facets, nil
+}
+
+func (r *Reference) Terms(ctx context.Context, namespaceID uint64, entityID ...model.EntityID,
+) (map[model.EntityID][]*model.Term, error) {
+	var rows []struct {
+		EntityID     string         `sql:"entity_id"`
+		TermID       uint64         `sql:"term_id"`
+		Name         string         `sql:"name"`
+		Title        sql.NullString `sql:"title"`
+		Description  sql.NullString `sql:"description"`
+		Labels       sql.NullString `sql:"labels"`
+		VocabularyID uint64         `sql:"vocabulary_id"`
+	}
+
+	// Every row is a term of the entity in one of term's vocabularies
+	err := r.clientFrom(ctx).Query().
+		Where(
+			reference.NamespaceID(namespaceID),
+			reference.EntityIDIn(lo.Map(entityID, func(item model.EntityID, _ int) string {
+				return string(item)
+			})...),
+		).
+		Modify(func(s *sql.Selector) {
+			terms, vocabularies := sql.Table(term.Table), sql.Table(term.VocabularyTable)
+			s.Join(terms).On(s.C(reference.FieldTermID), terms.C(term.FieldID)).
+				Join(vocabularies).On(terms.C(term.FieldID), vocabularies.C(term.VocabularyPrimaryKey[1])).
+				Select(
+					s.C(reference.FieldEntityID),
+					sql.As(terms.C(term.FieldID), `term_id`),
+					terms.C(term.FieldName),
+					terms.C(term.FieldTitle),
+					terms.C(term.FieldDescription),
+					terms.C(term.FieldLabels),
+					sql.As(vocabularies.C(term.VocabularyPrimaryKey[0]), `vocabulary_id`),
+				).
+				OrderBy(s.C(reference.FieldEntityID), terms.C(term.FieldID), vocabularies.C(term.VocabularyPrimaryKey[0]))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, errors.Join(repository.ErrGetReference, err)
+	}
+
+	var terms = make(map[model.EntityID][]*model.Term)
+
+	for _, row := range rows {
+		var (
+			id    = model.EntityID(row.EntityID)
+			found = terms[id]
+		)
+
+		// Rows are ordered by term, so the same term's rows go one by one
+		if len(found) > 0 && found[len(found)-1].ID == row.TermID {
+			last := found[len(found)-1]
+			last.Data.VocabularyID = append(last.Data.VocabularyID, row.VocabularyID)
+
+			continue
+		}
+
+		var labels model.Labels
+		if row.Labels.Valid {
+			if err := json.Unmarshal([]byte(row.Labels.String), &labels); err != nil {
+				return nil, errors.Join(repository.ErrGetReference, err)
+			}
+		}
+
+		terms[id] = append(found, &model.Term{
+			ID: row.TermID,
+			Data: model.TermData{
+				Name:         row.Name,
+				Title:        row.Title.String,
+				Description:  row.Description.String,
+				Labels:       labels,
+				VocabularyID: []uint64{row.VocabularyID},
+			},
+		})
+	}
+
+	return terms, nil
+}
+
+// countEntities counts distinct entities of grouped references.
+func countEntities(s *sql.Selector) string {
+	return sql.As(sql.Count(sql.Distinct(s.C(reference.FieldEntityID))), `count`)
+}
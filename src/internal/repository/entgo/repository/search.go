@@ -0,0 +1,373 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/predicate"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/synonym"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/term"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/vocabulary"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+)
+
+// FullText is a kind of full-text index of terms and synonyms, search falls back to LIKE conditions without it.
+type FullText string
+
+const (
+	NoFullText FullText = ``
+	// PostgresFullText matches tsvector of terms, it works without index, GIN indexes only make it fast
+	PostgresFullText FullText = `tsvector`
+	// MySQLFullText uses FULLTEXT indexes
+	MySQLFullText FullText = `fulltext`
+	// SQLiteFullText uses FTS5 tables kept in sync with terms and synonyms by triggers
+	SQLiteFullText FullText = `fts5`
+)
+
+// mysqlMinToken is the default innodb_ft_min_token_size, shorter prefixes are looked up without index.
+const mysqlMinToken = 3
+
+const (
+	termsSearch    = `terms_search`
+	synonymsSearch = `synonyms_search`
+	// postgresTermVector and postgresSynonymVector must be the same in indexes and queries, so indexes are used
+	postgresTermVector = `to_tsvector('simple', coalesce(%[1]s, '') || ' ' || coalesce(%[2]s, '') || ' ' || ` +
+		`coalesce(%[3]s, ''))`
+	postgresSynonymVector = `to_tsvector('simple', %s)`
+)
+
+var fullTextSchema = map[string][]string{
+	dialect.Postgres: {
+		`CREATE INDEX IF NOT EXISTS ` + termsSearch + ` ON terms USING GIN ((` +
+			fmt.Sprintf(postgresTermVector, `name`, `title`, `description`) + `))`,
+		`CREATE INDEX IF NOT EXISTS ` + synonymsSearch + ` ON synonyms USING GIN ((` +
+			fmt.Sprintf(postgresSynonymVector, `name`) + `))`,
+	},
+	dialect.SQLite: {
+		`CREATE VIRTUAL TABLE IF NOT EXISTS ` + termsSearch + ` USING fts5(name, title, description, ` +
+			`content='terms', content_rowid='id')`,
+		`CREATE TRIGGER IF NOT EXISTS terms_search_insert AFTER INSERT ON terms BEGIN ` +
+			`INSERT INTO terms_search(rowid, name, title, description) ` +
+			`VALUES (new.id, new.name, new.title, new.description); END`,
+		`CREATE TRIGGER IF NOT EXISTS terms_search_delete AFTER DELETE ON terms BEGIN ` +
+			`INSERT INTO terms_search(terms_search, rowid, name, title, description) ` +
+			`VALUES ('delete', old.id, old.name, old.title, old.description); END`,
+		`CREATE TRIGGER IF NOT EXISTS terms_search_update AFTER UPDATE ON terms BEGIN ` +
+			`INSERT INTO terms_search(terms_search, rowid, name, title, description) ` +
+			`VALUES ('delete', old.id, old.name, old.title, old.description); ` +
+			`INSERT INTO terms_search(rowid, name, title, description) ` +
+			`VALUES (new.id, new.name, new.title, new.description); END`,
+		`CREATE VIRTUAL TABLE IF NOT EXISTS ` + synonymsSearch + ` USING fts5(name, ` +
+			`content='synonyms', content_rowid='id')`,
+		`CREATE TRIGGER IF NOT EXISTS synonyms_search_insert AFTER INSERT ON synonyms BEGIN ` +
+			`INSERT INTO synonyms_search(rowid, name) VALUES (new.id, new.name); END`,
+		`CREATE TRIGGER IF NOT EXISTS synonyms_search_delete AFTER DELETE ON synonyms BEGIN ` +
+			`INSERT INTO synonyms_search(synonyms_search, rowid, name) VALUES ('delete', old.id, old.name); END`,
+		`CREATE TRIGGER IF NOT EXISTS synonyms_search_update AFTER UPDATE ON synonyms BEGIN ` +
+			`INSERT INTO synonyms_search(synonyms_search, rowid, name) VALUES ('delete', old.id, old.name); ` +
+			`INSERT INTO synonyms_search(rowid, name) VALUES (new.id, new.name); END`,
+	},
+}
+
+// sqliteRebuild fills FTS5 tables with existing terms and synonyms, later triggers keep them in sync.
+var sqliteRebuild = []string{
+	`INSERT INTO terms_search(terms_search) VALUES ('rebuild')`,
+	`INSERT INTO synonyms_search(synonyms_search) VALUES ('rebuild')`,
+}
+
+// mysqlIndexes contains columns of FULLTEXT indexes by tables, MySQL can't create index if it doesn't exist.
+var mysqlIndexes = []struct {
+	table, index, columns string
+}{
+	{term.Table, termsSearch, `name, title, description`},
+	{synonym.Table, synonymsSearch, `name`},
+}
+
+// CreateFullText creates full-text indexes of terms and synonyms for the dialect of the driver, existing indexes are
+// kept.
+func CreateFullText(ctx context.Context, drv *sql.Driver) error {
+	if drv.Dialect() == dialect.MySQL {
+		for _, index := range mysqlIndexes {
+			exists, err := mysqlIndexExists(ctx, drv, index.table, index.index)
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				if _, err := drv.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s ADD FULLTEXT INDEX %s (%s)`,
+					index.table, index.index, index.columns)); err != nil {
+					return fmt.Errorf(`create index %s: %w`, index.index, err)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// SQLite tables are rebuilt only when they are created, rebuilding reads all terms and synonyms
+	var rebuild []string
+	if drv.Dialect() == dialect.SQLite {
+		fullText, err := DetectFullText(ctx, drv)
+		if err != nil {
+			return err
+		}
+
+		if fullText == NoFullText {
+			rebuild = sqliteRebuild
+		}
+	}
+
+	for _, statement := range append(fullTextSchema[drv.Dialect()], rebuild...) {
+		if _, err := drv.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf(`create full-text index: %w`, err)
+		}
+	}
+
+	return nil
+}
+
+// DetectFullText returns kind of full-text index created by CreateFullText in the database.
+func DetectFullText(ctx context.Context, drv *sql.Driver) (FullText, error) {
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		return PostgresFullText, nil
+	case dialect.MySQL:
+		for _, index := range mysqlIndexes {
+			exists, err := mysqlIndexExists(ctx, drv, index.table, index.index)
+			if err != nil || !exists {
+				return NoFullText, err
+			}
+		}
+
+		return MySQLFullText, nil
+	case dialect.SQLite:
+		count, err := queryCount(ctx, drv, `SELECT COUNT(*) FROM sqlite_master WHERE name IN (?, ?)`,
+			termsSearch, synonymsSearch)
+		if err != nil || count != 2 {
+			return NoFullText, err
+		}
+
+		return SQLiteFullText, nil
+	}
+
+	return NoFullText, nil
+}
+
+func mysqlIndexExists(ctx context.Context, drv *sql.Driver, table, index string) (bool, error) {
+	count, err := queryCount(ctx, drv, `SELECT COUNT(*) FROM information_schema.statistics `+
+		`WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?`, table, index)
+
+	return count > 0, err
+}
+
+func queryCount(ctx context.Context, drv *sql.Driver, query string, args ...any) (int, error) {
+	rows, err := drv.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf(`check full-text index: %w`, err)
+	}
+
+	defer rows.Close()
+
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, fmt.Errorf(`check full-text index: %w`, err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf(`check full-text index: %w`, err)
+	}
+
+	return count, nil
+}
+
+// Search selects terms matching the search. Terms found by full-text index are ordered by relevance given by the
+// database, shorter names go first among equally relevant terms and without index because they are closer to the
+// query.
+func (t *Term) Search(ctx context.Context, search *repository.TermSearch) ([]*model.Term, error) {
+	var predicates = make([]predicate.Term, 0)
+
+	if len(search.VocabularyID) > 0 {
+		predicates = append(predicates, term.HasVocabularyWith(vocabulary.IDIn(search.VocabularyID...)))
+	}
+
+	var prefixes = make([]string, 0, len(search.Prefixes))
+
+	for _, prefix := range search.Prefixes {
+		if prefix = sanitize(prefix); prefix != `` {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	var order = make([]term.OrderOption, 0)
+
+	switch {
+	case len(prefixes) > 0 && t.native(prefixes):
+		predicates = append(predicates, t.fullTextPredicate(prefixes))
+		order = append(order, t.fullTextRank(prefixes))
+	case len(prefixes) > 0:
+		for _, prefix := range prefixes {
+			predicates = append(predicates, contains(prefix))
+		}
+	case search.Substring != ``:
+		predicates = append(predicates, contains(search.Substring))
+	default:
+		return []*model.Term{}, nil
+	}
+
+	entterms, err := t.clientFrom(ctx).Query().
+		Where(predicates...).
+		WithVocabulary().
+		WithSuperterms().
+		WithSubterms().
+		WithSynonyms(func(q *ent.SynonymQuery) { q.Order(ent.Asc(synonym.FieldID)) }).
+		Order(append(order, func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(fmt.Sprintf(`LENGTH(%s)`, s.C(term.FieldName))))
+		}, ent.Asc(term.FieldID))...).
+		Limit(int(search.Limit)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(repository.ErrFindTerm, err)
+	}
+
+	terms := make([]*model.Term, 0, len(entterms))
+
+	for _, entterm := range entterms {
+		terms = append(terms, t.ent2model(entterm))
+	}
+
+	return terms, nil
+}
+
+// native returns true when prefixes are looked up by full-text index.
+func (t *Term) native(prefixes []string) bool {
+	if t.fullText != MySQLFullText {
+		return t.fullText != NoFullText
+	}
+
+	// Tokens shorter than minimal size aren't indexed
+	for _, prefix := range prefixes {
+		if utf8.RuneCountInString(prefix) < mysqlMinToken {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fullTextQuery returns query of the full-text index matching words starting with all prefixes.
+func (t *Term) fullTextQuery(prefixes []string) string {
+	switch t.fullText {
+	case PostgresFullText:
+		return strings.Join(prefixes, `:* & `) + `:*`
+	case MySQLFullText:
+		return `+` + strings.Join(prefixes, `* +`) + `*`
+	default:
+		return `"` + strings.Join(prefixes, `"* AND "`) + `"*`
+	}
+}
+
+// fullTextPredicate matches terms which name, title and description or name of any synonym have words starting with
+// all prefixes. Condition is wrapped in parentheses, so its OR doesn't skip other predicates like vocabularies.
+func (t *Term) fullTextPredicate(prefixes []string) predicate.Term {
+	return func(s *sql.Selector) {
+		var (
+			id    = s.C(term.FieldID)
+			query = t.fullTextQuery(prefixes)
+			p     = sql.P()
+		)
+
+		switch t.fullText {
+		case PostgresFullText:
+			p.Append(func(b *sql.Builder) {
+				b.WriteString(`(` + fmt.Sprintf(postgresTermVector, s.C(term.FieldName), s.C(term.FieldTitle),
+					s.C(term.FieldDescription)))
+				b.WriteString(` @@ to_tsquery('simple', `).Arg(query).WriteString(`) OR ` + id + ` IN (`)
+				b.WriteString(`SELECT term_id FROM synonyms WHERE ` + fmt.Sprintf(postgresSynonymVector, `name`))
+				b.WriteString(` @@ to_tsquery('simple', `).Arg(query).WriteString(`)))`)
+			})
+		case MySQLFullText:
+			p.Append(func(b *sql.Builder) {
+				b.WriteString(fmt.Sprintf(`(MATCH(%s, %s, %s) AGAINST (`, s.C(term.FieldName), s.C(term.FieldTitle),
+					s.C(term.FieldDescription)))
+				b.Arg(query).WriteString(` IN BOOLEAN MODE) OR ` + id + ` IN (`)
+				b.WriteString(`SELECT term_id FROM synonyms WHERE MATCH(name) AGAINST (`)
+				b.Arg(query).WriteString(` IN BOOLEAN MODE)))`)
+			})
+		default:
+			p.Append(func(b *sql.Builder) {
+				b.WriteString(`(` + id + ` IN (SELECT rowid FROM terms_search WHERE terms_search MATCH `).Arg(query)
+				b.WriteString(`) OR ` + id + ` IN (SELECT synonyms.term_id FROM synonyms_search `)
+				b.WriteString(`JOIN synonyms ON synonyms.id = synonyms_search.rowid WHERE synonyms_search MATCH `)
+				b.Arg(query).WriteString(`))`)
+			})
+		}
+
+		s.Where(p)
+	}
+}
+
+// fullTextRank orders terms matched by fullTextPredicate by relevance given by the database: ts_rank on PostgreSQL,
+// MATCH score on MySQL and bm25 on SQLite. Relevance of the best synonym is used when it's higher than the term's one.
+func (t *Term) fullTextRank(prefixes []string) term.OrderOption {
+	return func(s *sql.Selector) {
+		var (
+			id    = s.C(term.FieldID)
+			query = t.fullTextQuery(prefixes)
+		)
+
+		// OrderExprFunc drops arguments of the expression, ExprFunc keeps them
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			switch t.fullText {
+			case PostgresFullText:
+				b.WriteString(`GREATEST(ts_rank(` + fmt.Sprintf(postgresTermVector, s.C(term.FieldName),
+					s.C(term.FieldTitle), s.C(term.FieldDescription)))
+				b.WriteString(`, to_tsquery('simple', `).Arg(query).WriteString(`)), COALESCE((SELECT MAX(ts_rank(`)
+				b.WriteString(fmt.Sprintf(postgresSynonymVector, `name`) + `, to_tsquery('simple', `).Arg(query)
+				b.WriteString(`))) FROM synonyms WHERE term_id = ` + id + `), 0)) DESC`)
+			case MySQLFullText:
+				b.WriteString(fmt.Sprintf(`GREATEST(MATCH(%s, %s, %s) AGAINST (`, s.C(term.FieldName),
+					s.C(term.FieldTitle), s.C(term.FieldDescription)))
+				b.Arg(query).WriteString(` IN BOOLEAN MODE), COALESCE((SELECT MAX(MATCH(name) AGAINST (`)
+				b.Arg(query).WriteString(` IN BOOLEAN MODE)) FROM synonyms WHERE term_id = ` + id + `), 0)) DESC`)
+			default:
+				// rank column is bm25 which is negative, more relevant rows have lower values. Unlike bm25 function,
+				// the column could be read in correlated subqueries.
+				b.WriteString(`MIN(COALESCE((SELECT rank FROM terms_search WHERE terms_search MATCH `).Arg(query)
+				b.WriteString(` AND rowid = ` + id + `), 0), COALESCE((SELECT MIN(rank) FROM synonyms_search `)
+				b.WriteString(`WHERE synonyms_search MATCH `).Arg(query)
+				b.WriteString(` AND rowid IN (SELECT id FROM synonyms WHERE term_id = ` + id + `)), 0))`)
+			}
+		}))
+	}
+}
+
+// contains matches terms containing the substring in name, title, description or name of any synonym.
+func contains(substring string) predicate.Term {
+	return term.Or(
+		term.NameContainsFold(substring),
+		term.TitleContainsFold(substring),
+		term.DescriptionContainsFold(substring),
+		term.HasSynonymsWith(synonym.NameContainsFold(substring)),
+	)
+}
+
+// sanitize keeps letters and digits of the prefix, so it can't break syntax of full-text queries.
+func sanitize(prefix string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, prefix)
+}
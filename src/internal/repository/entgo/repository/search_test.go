@@ -0,0 +1,185 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/migrate"
+	repo "github.com/dmalykh/taxonomy/internal/repository/entgo/repository"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerm_Search(t *testing.T) {
+	for _, fullText := range []repo.FullText{repo.NoFullText, repo.SQLiteFullText} {
+		t.Run(string(fullText), func(t *testing.T) {
+			var ctx = context.Background()
+
+			drv, err := entsql.Open(`sqlite3`, `file:search`+string(fullText)+`?mode=memory&cache=shared&_fk=1`)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = drv.Close() })
+
+			client := ent.NewClient(ent.Driver(drv))
+			require.NoError(t, client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)))
+
+			if fullText != repo.NoFullText {
+				require.NoError(t, repo.CreateFullText(ctx, drv))
+			}
+
+			detected, err := repo.DetectFullText(ctx, drv)
+			require.NoError(t, err)
+			require.Equal(t, fullText, detected)
+
+			colors := client.Vocabulary.Create().SetName(`colors`).SaveX(ctx)
+			shapes := client.Vocabulary.Create().SetName(`shapes`).SaveX(ctx)
+			terms := repo.NewTerm(client.Term, client.Synonym, detected)
+
+			var created = make([]*model.Term, 0)
+
+			for _, data := range []*model.TermData{
+				{Name: `dark-red`, Title: `Dark red`, VocabularyID: []uint64{colors.ID}},
+				{Name: `red`, Title: `Red`, VocabularyID: []uint64{colors.ID},
+					Synonyms: []model.Synonym{{Name: `scarlet`}}},
+				{Name: `reddish`, Description: `Color of a dark brick`, VocabularyID: []uint64{colors.ID}},
+				{Name: `square`, Title: `Red square`, VocabularyID: []uint64{shapes.ID}},
+			} {
+				trm, err := terms.Create(ctx, data)
+				require.NoError(t, err)
+
+				created = append(created, trm)
+			}
+
+			names := func(search *repository.TermSearch) []string {
+				found, err := terms.Search(ctx, search)
+				require.NoError(t, err)
+
+				var names = make([]string, 0, len(found))
+				for _, trm := range found {
+					names = append(names, trm.Data.Name)
+				}
+
+				return names
+			}
+
+			// Full-text index orders terms by relevance, so limit keeps the most relevant ones. Without index shorter
+			// names go first.
+			var re, dar = []string{`red`, `reddish`, `dark-red`}, []string{`reddish`, `dark-red`}
+			if fullText != repo.NoFullText {
+				re, dar = []string{`red`, `dark-red`, `reddish`}, []string{`dark-red`, `reddish`}
+			}
+
+			assert.Equal(t, re,
+				names(&repository.TermSearch{Prefixes: []string{`re`}, VocabularyID: []uint64{colors.ID}}))
+			assert.Equal(t, dar, names(&repository.TermSearch{Prefixes: []string{`dar`}}))
+			assert.Equal(t, dar[:1], names(&repository.TermSearch{Prefixes: []string{`dar`}, Limit: 1}))
+			assert.Equal(t, []string{`red`}, names(&repository.TermSearch{Prefixes: []string{`scar`}}))
+			assert.Empty(t, names(&repository.TermSearch{Prefixes: []string{`scar`}, VocabularyID: []uint64{shapes.ID}}))
+			assert.Equal(t, []string{`square`}, names(&repository.TermSearch{Prefixes: []string{`Red`, `SQU`}}))
+			assert.Equal(t, []string{`reddish`}, names(&repository.TermSearch{Substring: `BRICK`}))
+			assert.Empty(t, names(&repository.TermSearch{Prefixes: []string{`"*`}}))
+
+			// Index follows changes of terms and synonyms
+			require.NoError(t, terms.Delete(ctx, &repository.TermFilter{ID: []uint64{created[2].ID}}))
+			_, err = terms.Update(ctx, created[1].ID, &model.TermData{Name: `red`, VocabularyID: []uint64{colors.ID},
+				Synonyms: []model.Synonym{{Name: `crimson`}}})
+			require.NoError(t, err)
+			assert.Empty(t, names(&repository.TermSearch{Prefixes: []string{`scar`}}))
+			assert.Equal(t, []string{`red`}, names(&repository.TermSearch{Prefixes: []string{`crim`}}))
+			assert.Equal(t, []string{`dark-red`}, names(&repository.TermSearch{Prefixes: []string{`dar`}}))
+		})
+	}
+}
+
+func TestTerm_SearchDialects(t *testing.T) {
+	tests := []struct {
+		dialect  string
+		fullText repo.FullText
+		want     string
+	}{
+		{
+			dialect:  dialect.Postgres,
+			fullText: repo.PostgresFullText,
+			want: `ORDER BY GREATEST(ts_rank(to_tsvector('simple', coalesce("terms"."name", '') || ' ' || ` +
+				`coalesce("terms"."title", '') || ' ' || coalesce("terms"."description", '')), ` +
+				`to_tsquery('simple', $3)), COALESCE((SELECT MAX(ts_rank(to_tsvector('simple', name), ` +
+				`to_tsquery('simple', $4))) FROM synonyms WHERE term_id = "terms"."id"), 0)) DESC`,
+		},
+		{
+			dialect:  dialect.MySQL,
+			fullText: repo.MySQLFullText,
+			want: "ORDER BY GREATEST(MATCH(`terms`.`name`, `terms`.`title`, `terms`.`description`) AGAINST " +
+				"(? IN BOOLEAN MODE), COALESCE((SELECT MAX(MATCH(name) AGAINST (? IN BOOLEAN MODE)) FROM synonyms " +
+				"WHERE term_id = `terms`.`id`), 0)) DESC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			drv := &recorder{dialect: tt.dialect}
+			client := ent.NewClient(ent.Driver(drv))
+
+			_, err := repo.NewTerm(client.Term, client.Synonym, tt.fullText).Search(context.Background(),
+				&repository.TermSearch{Prefixes: []string{`dark`}, Limit: 10})
+			require.ErrorIs(t, err, io.EOF)
+			assert.Contains(t, drv.query, tt.want)
+			assert.Len(t, drv.args, 4)
+		})
+	}
+}
+
+// execCounter counts executed statements containing the substring.
+type execCounter struct {
+	entsql.ExecQuerier
+	substring string
+	count     int
+}
+
+func (e *execCounter) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if strings.Contains(query, e.substring) {
+		e.count++
+	}
+
+	return e.ExecQuerier.ExecContext(ctx, query, args...)
+}
+
+func TestCreateFullText_RebuildOnce(t *testing.T) {
+	var ctx = context.Background()
+
+	db, err := entsql.Open(`sqlite3`, `file:rebuild?mode=memory&cache=shared&_fk=1`)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	client := ent.NewClient(ent.Driver(db))
+	require.NoError(t, client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)))
+
+	// Terms created before the index are found after rebuilding
+	colors := client.Vocabulary.Create().SetName(`colors`).SaveX(ctx)
+	terms := repo.NewTerm(client.Term, client.Synonym, repo.SQLiteFullText)
+	_, err = terms.Create(ctx, &model.TermData{Name: `crimson`, VocabularyID: []uint64{colors.ID},
+		Synonyms: []model.Synonym{{Name: `scarlet`}}})
+	require.NoError(t, err)
+
+	counter := &execCounter{ExecQuerier: db.DB(), substring: `'rebuild'`}
+	drv := entsql.NewDriver(dialect.SQLite, entsql.Conn{ExecQuerier: counter})
+
+	require.NoError(t, repo.CreateFullText(ctx, drv))
+	assert.Equal(t, 2, counter.count)
+
+	require.NoError(t, repo.CreateFullText(ctx, drv))
+	assert.Equal(t, 2, counter.count, `existing index must not be rebuilt`)
+
+	for _, prefix := range []string{`crim`, `scar`} {
+		found, err := terms.Search(ctx, &repository.TermSearch{Prefixes: []string{prefix}})
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, `crimson`, found[0].Data.Name)
+	}
+}
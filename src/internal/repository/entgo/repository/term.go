@@ -1,67 +1,288 @@
 package repository
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/predicate"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/synonym"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/term"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/vocabulary"
 	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"slices"
 )
 
+// bulkSize limits count of terms in one query, so queries don't exceed limits of placeholders.
+const bulkSize = 500
+
 type Term struct {
-	client *ent.TermClient
+	client   *ent.TermClient
+	synonyms *ent.SynonymClient
+	fullText FullText
 }
 
-func NewTerm(client *ent.TermClient) repository.Term {
+// NewTerm returns repository of terms, fullText is a kind of full-text index used by search, see DetectFullText.
+func NewTerm(client *ent.TermClient, synonyms *ent.SynonymClient, fullText FullText) repository.Term {
 	return &Term{
-		client: client,
+		client:   client,
+		synonyms: synonyms,
+		fullText: fullText,
+	}
+}
+
+// clientFrom returns terms client of context's transaction, if any.
+func (t *Term) clientFrom(ctx context.Context) *ent.TermClient {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Term
+	}
+
+	return t.client
+}
+
+// synonymsFrom returns synonyms client of context's transaction, if any.
+func (t *Term) synonymsFrom(ctx context.Context) *ent.SynonymClient {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Synonym
+	}
+
+	return t.synonyms
+}
+
+// setSynonyms replaces synonyms of terms, terms with nil synonyms keep current ones.
+func (t *Term) setSynonyms(ctx context.Context, synonyms map[uint64][]model.Synonym) error {
+	var (
+		replaced = make([]uint64, 0, len(synonyms))
+		builders = make([]*ent.SynonymCreate, 0)
+	)
+
+	for id, items := range synonyms {
+		if items == nil {
+			continue
+		}
+
+		replaced = append(replaced, id)
+
+		for _, s := range items {
+			builders = append(builders, t.synonymsFrom(ctx).Create().
+				SetTermID(id).
+				SetName(s.Name).
+				SetLocale(model.NormalizeLocale(s.Locale)).
+				SetHidden(s.Hidden))
+		}
+	}
+
+	for batch := range slices.Chunk(replaced, bulkSize) {
+		if _, err := t.synonymsFrom(ctx).Delete().Where(synonym.TermIDIn(batch...)).Exec(ctx); err != nil {
+			return err //nolint:wrapcheck
+		}
 	}
+
+	for batch := range slices.Chunk(builders, bulkSize) {
+		if err := t.synonymsFrom(ctx).CreateBulk(batch...).Exec(ctx); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return nil
 }
 
 func (t *Term) Create(ctx context.Context, data *model.TermData) (*model.Term, error) {
-	created, err := t.client.Create().
+	created, err := t.clientFrom(ctx).Create().
 		SetName(data.Name).
 		SetTitle(data.Title).
 		SetDescription(data.Description).
+		SetLabels(data.Labels).
 		AddVocabularyIDs(data.VocabularyID...).
+		AddSupertermIDs(data.SuperID...).
+		AddSubtermIDs(data.SubID...).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", repository.ErrCreateTerm, err.Error())
 	}
 
-	trm, err := t.client.Query().WithVocabulary().Where(term.ID(created.ID)).Only(ctx)
-	if err != nil {
-		return nil, errors.Join(repository.ErrFindTerm, err)
+	if err := t.setSynonyms(ctx, map[uint64][]model.Synonym{created.ID: data.Synonyms}); err != nil {
+		return nil, fmt.Errorf("%w: %s", repository.ErrCreateTerm, err.Error())
 	}
 
-	return t.ent2model(trm), nil
+	return t.one(ctx, created.ID)
 }
 
 func (t *Term) Update(ctx context.Context, id uint64, data *model.TermData) (*model.Term, error) {
-	updated, err := t.client.UpdateOneID(id).
+	updated, err := t.clientFrom(ctx).UpdateOneID(id).
 		SetName(data.Name).
 		SetTitle(data.Title).
 		SetDescription(data.Description).
+		SetLabels(data.Labels).
+		ClearVocabulary().
 		AddVocabularyIDs(data.VocabularyID...).
+		ClearSuperterms().
+		AddSupertermIDs(data.SuperID...).
+		ClearSubterms().
+		AddSubtermIDs(data.SubID...).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", repository.ErrUpdateTerm, err.Error())
 	}
 
-	trm, err := t.client.Query().WithVocabulary().Where(term.ID(updated.ID)).Only(ctx)
+	if err := t.setSynonyms(ctx, map[uint64][]model.Synonym{updated.ID: data.Synonyms}); err != nil {
+		return nil, fmt.Errorf("%w: %s", repository.ErrUpdateTerm, err.Error())
+	}
+
+	return t.one(ctx, updated.ID)
+}
+
+// CreateBulk inserts terms by batches of bulkSize, every batch is inserted with one query per table.
+func (t *Term) CreateBulk(ctx context.Context, data ...*model.TermData) ([]*model.Term, error) {
+	var created = make([]uint64, 0, len(data))
+
+	for batch := range slices.Chunk(data, bulkSize) {
+		builders := make([]*ent.TermCreate, len(batch))
+		for i, d := range batch {
+			builders[i] = t.clientFrom(ctx).Create().
+				SetName(d.Name).
+				SetTitle(d.Title).
+				SetDescription(d.Description).
+				SetLabels(d.Labels).
+				AddVocabularyIDs(d.VocabularyID...).
+				AddSupertermIDs(d.SuperID...).
+				AddSubtermIDs(d.SubID...)
+		}
+
+		terms, err := t.clientFrom(ctx).CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", repository.ErrCreateTerm, err.Error())
+		}
+
+		var synonyms = make(map[uint64][]model.Synonym, len(terms))
+
+		for i, trm := range terms {
+			created = append(created, trm.ID)
+			synonyms[trm.ID] = batch[i].Synonyms
+		}
+
+		if err := t.setSynonyms(ctx, synonyms); err != nil {
+			return nil, fmt.Errorf("%w: %s", repository.ErrCreateTerm, err.Error())
+		}
+	}
+
+	return t.ordered(ctx, created)
+}
+
+// UpdateBulk updates every term with its own query, ent can't update rows by different values at once.
+func (t *Term) UpdateBulk(ctx context.Context, terms ...*model.Term) ([]*model.Term, error) {
+	var updated = make([]uint64, 0, len(terms))
+
+	for _, trm := range terms {
+		err := t.clientFrom(ctx).UpdateOneID(trm.ID).
+			SetName(trm.Data.Name).
+			SetTitle(trm.Data.Title).
+			SetDescription(trm.Data.Description).
+			SetLabels(trm.Data.Labels).
+			ClearVocabulary().
+			AddVocabularyIDs(trm.Data.VocabularyID...).
+			ClearSuperterms().
+			AddSupertermIDs(trm.Data.SuperID...).
+			ClearSubterms().
+			AddSubtermIDs(trm.Data.SubID...).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: term %d: %s", repository.ErrUpdateTerm, trm.ID, err.Error())
+		}
+
+		updated = append(updated, trm.ID)
+	}
+
+	var synonyms = make(map[uint64][]model.Synonym, len(terms))
+	for _, trm := range terms {
+		synonyms[trm.ID] = trm.Data.Synonyms
+	}
+
+	if err := t.setSynonyms(ctx, synonyms); err != nil {
+		return nil, fmt.Errorf("%w: %s", repository.ErrUpdateTerm, err.Error())
+	}
+
+	return t.ordered(ctx, updated)
+}
+
+// ordered returns terms with given ids in the same order.
+func (t *Term) ordered(ctx context.Context, id []uint64) ([]*model.Term, error) {
+	var found = make(map[uint64]*model.Term, len(id))
+
+	for batch := range slices.Chunk(id, bulkSize) {
+		terms, err := t.Get(ctx, &repository.TermFilter{ID: batch})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trm := range terms {
+			found[trm.ID] = trm
+		}
+	}
+
+	var terms = make([]*model.Term, 0, len(id))
+
+	for _, i := range id {
+		trm, ok := found[i]
+		if !ok {
+			return nil, fmt.Errorf(`%w: term %d`, repository.ErrFindTerm, i)
+		}
+
+		terms = append(terms, trm)
+	}
+
+	return terms, nil
+}
+
+// Link adds broader-narrower edge between terms. Existing edge is kept as is.
+func (t *Term) Link(ctx context.Context, superID, subID uint64) error {
+	exists, err := t.clientFrom(ctx).Query().Where(
+		term.ID(subID),
+		term.HasSupertermsWith(term.ID(superID)),
+	).Exist(ctx)
+	if err != nil {
+		return errors.Join(repository.ErrFindTerm, err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	if err := t.clientFrom(ctx).UpdateOneID(subID).AddSupertermIDs(superID).Exec(ctx); err != nil {
+		return fmt.Errorf("%w: %s", repository.ErrUpdateTerm, err.Error())
+	}
+
+	return nil
+}
+
+// Unlink removes broader-narrower edge between terms.
+func (t *Term) Unlink(ctx context.Context, superID, subID uint64) error {
+	if err := t.clientFrom(ctx).UpdateOneID(subID).RemoveSupertermIDs(superID).Exec(ctx); err != nil {
+		return fmt.Errorf("%w: %s", repository.ErrUpdateTerm, err.Error())
+	}
+
+	return nil
+}
+
+func (t *Term) one(ctx context.Context, id uint64) (*model.Term, error) {
+	trm, err := t.clientFrom(ctx).Query().
+		WithVocabulary().
+		WithSuperterms().
+		WithSubterms().
+		WithSynonyms(func(q *ent.SynonymQuery) { q.Order(ent.Asc(synonym.FieldID)) }).
+		Where(term.ID(id)).
+		Only(ctx)
 	if err != nil {
 		return nil, errors.Join(repository.ErrFindTerm, err)
 	}
 
-	return t.ent2model(trm), err
+	return t.ent2model(trm), nil
 }
 
 func (t *Term) Delete(ctx context.Context, filter *repository.TermFilter) error {
-	_, err := t.client.Delete().Where(
+	_, err := t.clientFrom(ctx).Delete().Where(
 		t.buildQuery(filter)...,
 	).Exec(ctx)
 	if err != nil {
@@ -72,10 +293,127 @@ func (t *Term) Delete(ctx context.Context, filter *repository.TermFilter) error
 }
 
 func (t *Term) Get(ctx context.Context, filter *repository.TermFilter) ([]*model.Term, error) {
-	entterms, err := t.client.Query().Where(
+	entterms, err := t.clientFrom(ctx).Query().Where(
 		t.buildQuery(filter)...,
 	).
 		WithVocabulary().
+		WithSuperterms().
+		WithSubterms().
+		WithSynonyms(func(q *ent.SynonymQuery) { q.Order(ent.Asc(synonym.FieldID)) }).
+		Order(ent.Asc(term.FieldID)).
+		Limit(int(filter.Limit)).
+		Offset(int(filter.Offset)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Join(repository.ErrFindTerm, err)
+	}
+
+	terms := make([]*model.Term, 0, len(entterms))
+
+	for _, entterm := range entterms {
+		terms = append(terms, t.ent2model(entterm))
+	}
+
+	return terms, nil
+}
+
+func (t *Term) Count(ctx context.Context, filter *repository.TermFilter) (uint64, error) {
+	count, err := t.clientFrom(ctx).Query().Where(t.buildQuery(filter)...).Count(ctx)
+	if err != nil {
+		return 0, errors.Join(repository.ErrFindTerm, err)
+	}
+
+	return uint64(count), nil
+}
+
+// Ancestors returns all broader terms of the term up to depth levels, terms farther from the term go first.
+func (t *Term) Ancestors(ctx context.Context, id uint64, depth uint) ([]*model.Term, error) {
+	terms, err := t.related(ctx, fmt.Sprintf(termAncestorsQuery, term.Table, term.SubtermsTable, ids(id),
+		term.SubtermsPrimaryKey[0], term.SubtermsPrimaryKey[1], depth))
+	if err != nil {
+		return nil, err
+	}
+
+	// Term could be reached by different paths, the longest one defines term's place
+	var (
+		found    = make(map[uint64]*model.Term, len(terms))
+		distance = make(map[uint64]uint, len(terms))
+		longest  func(id uint64) uint
+	)
+
+	for _, trm := range terms {
+		found[trm.ID] = trm
+	}
+
+	longest = func(current uint64) uint {
+		if d, ok := distance[current]; ok || current == id {
+			return d
+		}
+
+		distance[current] = 0 // guards from loops
+
+		var d uint
+
+		for _, sub := range found[current].Data.SubID {
+			if _, ok := found[sub]; ok {
+				d = max(d, longest(sub)+1)
+			}
+		}
+
+		distance[current] = d
+
+		return d
+	}
+
+	terms = slices.DeleteFunc(terms, func(trm *model.Term) bool {
+		return trm.ID == id
+	})
+
+	slices.SortStableFunc(terms, func(a, b *model.Term) int {
+		return cmp.Or(cmp.Compare(longest(b.ID), longest(a.ID)), cmp.Compare(a.ID, b.ID))
+	})
+
+	return terms, nil
+}
+
+// Descendants returns all narrower terms of the term up to depth levels ordered by distance from the term.
+func (t *Term) Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Term, error) {
+	terms, err := t.related(ctx, fmt.Sprintf(termDescendantsQuery, term.Table, term.SubtermsTable, ids(id),
+		term.SubtermsPrimaryKey[0], term.SubtermsPrimaryKey[1], depth))
+	if err != nil {
+		return nil, err
+	}
+
+	var found = make(map[uint64]*model.Term, len(terms))
+	for _, trm := range terms {
+		found[trm.ID] = trm
+	}
+
+	distance := levels(id, func(current uint64) []uint64 {
+		if trm, ok := found[current]; ok {
+			return trm.Data.SubID
+		}
+
+		return nil
+	})
+
+	terms = slices.DeleteFunc(terms, func(trm *model.Term) bool {
+		_, ok := distance[trm.ID]
+
+		return trm.ID == id || !ok
+	})
+	byDepth(terms, func(trm *model.Term) uint64 { return trm.ID }, distance)
+
+	return terms, nil
+}
+
+func (t *Term) related(ctx context.Context, query string) ([]*model.Term, error) {
+	entterms, err := t.clientFrom(ctx).Query().
+		Where(idIn(term.FieldID, query)).
+		WithVocabulary().
+		WithSuperterms().
+		WithSubterms().
+		WithSynonyms(func(q *ent.SynonymQuery) { q.Order(ent.Asc(synonym.FieldID)) }).
 		All(ctx)
 	if err != nil {
 		return nil, errors.Join(repository.ErrFindTerm, err)
@@ -104,7 +442,25 @@ func (t *Term) buildQuery(filter *repository.TermFilter) []predicate.Term {
 	}
 	// Filter by name
 	if filter.Name != nil {
-		predicates = append(predicates, term.Name(*filter.Name))
+		if filter.WithSynonyms {
+			predicates = append(predicates, term.Or(
+				term.Name(*filter.Name),
+				term.HasSynonymsWith(synonym.Name(*filter.Name)),
+			))
+		} else {
+			predicates = append(predicates, term.Name(*filter.Name))
+		}
+	}
+
+	if len(filter.NameIn) > 0 {
+		if filter.WithSynonyms {
+			predicates = append(predicates, term.Or(
+				term.NameIn(filter.NameIn...),
+				term.HasSynonymsWith(synonym.NameIn(filter.NameIn...)),
+			))
+		} else {
+			predicates = append(predicates, term.NameIn(filter.NameIn...))
+		}
 	}
 	// Get subterms that have certain super
 	if len(filter.SuperID) > 0 {
@@ -133,6 +489,7 @@ func (t *Term) ent2model(term *ent.Term) *model.Term {
 			Name:        term.Name,
 			Title:       term.Title,
 			Description: term.Description,
+			Labels:      term.Labels,
 			VocabularyID: toUint64s[ent.Vocabulary](term.Edges.Vocabulary, func(item *ent.Vocabulary) uint64 {
 				return item.ID
 			}),
@@ -142,6 +499,20 @@ func (t *Term) ent2model(term *ent.Term) *model.Term {
 			SubID: toUint64s[ent.Term](term.Edges.Subterms, func(item *ent.Term) uint64 {
 				return item.ID
 			}),
+			Synonyms: ent2synonyms(term.Edges.Synonyms),
 		},
 	}
 }
+
+func ent2synonyms(items []*ent.Synonym) []model.Synonym {
+	if len(items) == 0 {
+		return nil
+	}
+
+	var synonyms = make([]model.Synonym, 0, len(items))
+	for _, item := range items {
+		synonyms = append(synonyms, model.Synonym{Name: item.Name, Locale: item.Locale, Hidden: item.Hidden})
+	}
+
+	return synonyms
+}
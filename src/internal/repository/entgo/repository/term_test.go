@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"fmt"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/enttest"
 	repo "github.com/dmalykh/taxonomy/internal/repository/entgo/repository"
@@ -9,6 +10,7 @@ import (
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
 	"testing"
 
+	"github.com/AlekSi/pointer"
 	"github.com/jaswdr/faker"
 	suitetest "github.com/stretchr/testify/suite"
 )
@@ -47,6 +49,7 @@ func (suite *TestTermOperations) TestTerm_Create() {
 			model.TermData{
 				Name:         faker.Beer().Name(),
 				Title:        faker.Beer().Name(),
+				Labels:       model.Labels{`de`: {Title: faker.Beer().Name()}},
 				VocabularyID: []uint64{1},
 			},
 			func(err error, i ...interface{}) {
@@ -111,7 +114,7 @@ func (suite *TestTermOperations) TestTerm_Create() {
 			suite.TearDownTest()
 			suite.SetupTest()
 			ctx := context.TODO()
-			termClient := repo.NewTerm(suite.client.Term)
+			termClient := repo.NewTerm(suite.client.Term, suite.client.Synonym, repo.NoFullText)
 
 			tt.prepare()
 			returned, err := termClient.Create(ctx, &tt.data)
@@ -133,12 +136,219 @@ func (suite *TestTermOperations) TestTerm_Create() {
 				suite.Equal(tt.data.Name, got[0].Data.Name)
 				suite.Equal(tt.data.Title, got[0].Data.Title)
 				suite.Equal(tt.data.Description, got[0].Data.Description)
+				suite.Equal(tt.data.Labels, got[0].Data.Labels)
 				suite.Equal(tt.data.VocabularyID, got[0].Data.VocabularyID)
 			}
 		})
 	}
 }
 
+func (suite *TestTermOperations) TestTerm_Hierarchy() {
+	ctx := context.TODO()
+	termClient := repo.NewTerm(suite.client.Term, suite.client.Synonym, repo.NoFullText)
+	suite.client.Vocabulary.Create().SetName(`animals`).SetTitle(``).SaveX(ctx)
+
+	animal, err := termClient.Create(ctx, &model.TermData{Name: `animal`, VocabularyID: []uint64{1}})
+	suite.Require().NoError(err)
+	mammal, err := termClient.Create(ctx, &model.TermData{Name: `mammal`, VocabularyID: []uint64{1},
+		SuperID: []uint64{animal.ID}})
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{animal.ID}, mammal.Data.SuperID)
+
+	cat, err := termClient.Create(ctx, &model.TermData{Name: `cat`, VocabularyID: []uint64{1}})
+	suite.Require().NoError(err)
+
+	suite.Run(`link is idempotent`, func() {
+		suite.Require().NoError(termClient.Link(ctx, mammal.ID, cat.ID))
+		suite.Require().NoError(termClient.Link(ctx, mammal.ID, cat.ID))
+
+		got, err := termClient.Get(ctx, &repository.TermFilter{ID: []uint64{mammal.ID}})
+		suite.Require().NoError(err)
+		suite.Require().Len(got, 1)
+		suite.Equal([]uint64{animal.ID}, got[0].Data.SuperID)
+		suite.Equal([]uint64{cat.ID}, got[0].Data.SubID)
+	})
+
+	suite.Run(`filter by super and sub`, func() {
+		subterms, err := termClient.Get(ctx, &repository.TermFilter{SuperID: []uint64{mammal.ID}})
+		suite.Require().NoError(err)
+		suite.Require().Len(subterms, 1)
+		suite.Equal(cat.ID, subterms[0].ID)
+
+		superterms, err := termClient.Get(ctx, &repository.TermFilter{SubID: []uint64{mammal.ID}})
+		suite.Require().NoError(err)
+		suite.Require().Len(superterms, 1)
+		suite.Equal(animal.ID, superterms[0].ID)
+	})
+
+	suite.Run(`update replaces edges`, func() {
+		updated, err := termClient.Update(ctx, cat.ID, &model.TermData{Name: `cat`, VocabularyID: []uint64{1},
+			SuperID: []uint64{animal.ID}})
+		suite.Require().NoError(err)
+		suite.Equal([]uint64{animal.ID}, updated.Data.SuperID)
+		suite.Equal([]uint64{1}, updated.Data.VocabularyID)
+	})
+
+	suite.Run(`unlink`, func() {
+		suite.Require().NoError(termClient.Unlink(ctx, animal.ID, cat.ID))
+
+		got, err := termClient.Get(ctx, &repository.TermFilter{SuperID: []uint64{animal.ID}})
+		suite.Require().NoError(err)
+		suite.Require().Len(got, 1)
+		suite.Equal(mammal.ID, got[0].ID)
+	})
+}
+
+func (suite *TestTermOperations) TestTerm_AncestorsDescendants() {
+	ctx := context.TODO()
+	termClient := repo.NewTerm(suite.client.Term, suite.client.Synonym, repo.NoFullText)
+	suite.client.Vocabulary.Create().SetName(`animals`).SetTitle(``).SaveX(ctx)
+
+	// animal -> mammal -> cat -> kitten, animal -> pet -> cat, pet -> parrot
+	for _, data := range []model.TermData{
+		{Name: `animal`},
+		{Name: `mammal`, SuperID: []uint64{1}},
+		{Name: `pet`, SuperID: []uint64{1}},
+		{Name: `cat`, SuperID: []uint64{2, 3}},
+		{Name: `kitten`, SuperID: []uint64{4}},
+		{Name: `parrot`, SuperID: []uint64{3}},
+	} {
+		data.VocabularyID = []uint64{1}
+		_, err := termClient.Create(ctx, &data)
+		suite.Require().NoError(err)
+	}
+
+	ids := func(terms []*model.Term) []uint64 {
+		result := make([]uint64, len(terms))
+		for i, term := range terms {
+			result[i] = term.ID
+		}
+
+		return result
+	}
+
+	ancestors, err := termClient.Ancestors(ctx, 5, 32)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{1, 2, 3, 4}, ids(ancestors))
+
+	ancestors, err = termClient.Ancestors(ctx, 5, 1)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{4}, ids(ancestors))
+
+	descendants, err := termClient.Descendants(ctx, 1, 32)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{2, 3, 4, 6, 5}, ids(descendants))
+
+	descendants, err = termClient.Descendants(ctx, 3, 1)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{4, 6}, ids(descendants))
+}
+
+func (suite *TestTermOperations) TestTerm_Bulk() {
+	ctx := context.TODO()
+	termClient := repo.NewTerm(suite.client.Term, suite.client.Synonym, repo.NoFullText)
+	suite.client.Vocabulary.Create().SetName(`colors`).SetTitle(``).SaveX(ctx)
+	suite.client.Vocabulary.Create().SetName(`shades`).SetTitle(``).SaveX(ctx)
+
+	// More terms than one batch keeps
+	var data = make([]*model.TermData, 0, 1200)
+	for i := range cap(data) {
+		data = append(data, &model.TermData{Name: fmt.Sprintf(`color %d`, i), VocabularyID: []uint64{1}})
+	}
+
+	data[1199].VocabularyID = []uint64{1, 2}
+
+	created, err := termClient.CreateBulk(ctx, data...)
+	suite.Require().NoError(err)
+	suite.Require().Len(created, len(data))
+
+	for i, trm := range created {
+		suite.Equal(data[i].Name, trm.Data.Name)
+	}
+
+	suite.Equal([]uint64{1, 2}, created[1199].Data.VocabularyID)
+
+	updated, err := termClient.UpdateBulk(ctx,
+		&model.Term{ID: created[5].ID, Data: model.TermData{Name: `red`, VocabularyID: []uint64{2}}},
+		&model.Term{ID: created[0].ID, Data: model.TermData{Name: `colour`, VocabularyID: []uint64{1},
+			SubID: []uint64{created[5].ID}}},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(updated, 2)
+	suite.Equal(`red`, updated[0].Data.Name)
+	suite.Equal([]uint64{created[0].ID}, updated[0].Data.SuperID)
+	suite.Equal([]uint64{created[5].ID}, updated[1].Data.SubID)
+
+	found, err := termClient.Get(ctx, &repository.TermFilter{NameIn: []string{`red`, `colour`, `color 7`}})
+	suite.Require().NoError(err)
+	suite.Len(found, 3)
+
+	count, err := termClient.Count(ctx, &repository.TermFilter{VocabularyID: []uint64{2}, Limit: 1})
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), count)
+
+	_, err = termClient.CreateBulk(ctx, &model.TermData{Name: `blue`, VocabularyID: []uint64{1}},
+		&model.TermData{Name: ``, VocabularyID: []uint64{1}})
+	suite.ErrorIs(err, repository.ErrCreateTerm)
+}
+
+func (suite *TestTermOperations) TestTerm_Synonyms() {
+	ctx := context.TODO()
+	termClient := repo.NewTerm(suite.client.Term, suite.client.Synonym, repo.NoFullText)
+	suite.client.Vocabulary.Create().SetName(`colors`).SetTitle(``).SaveX(ctx)
+
+	red, err := termClient.Create(ctx, &model.TermData{Name: `red`, VocabularyID: []uint64{1}, Synonyms: []model.Synonym{
+		{Name: `scarlet`},
+		{Name: `rot`, Locale: `de`},
+		{Name: `rde`, Hidden: true},
+	}})
+	suite.Require().NoError(err)
+	suite.Equal([]model.Synonym{{Name: `scarlet`}, {Name: `rot`, Locale: `de`}, {Name: `rde`, Hidden: true}},
+		red.Data.Synonyms)
+
+	created, err := termClient.CreateBulk(ctx, &model.TermData{Name: `blue`, VocabularyID: []uint64{1},
+		Synonyms: []model.Synonym{{Name: `azure`}}})
+	suite.Require().NoError(err)
+	suite.Equal([]model.Synonym{{Name: `azure`}}, created[0].Data.Synonyms)
+
+	suite.Run(`names match synonyms`, func() {
+		found, err := termClient.Get(ctx, &repository.TermFilter{Name: pointer.ToString(`rde`)})
+		suite.Require().NoError(err)
+		suite.Empty(found)
+
+		found, err = termClient.Get(ctx, &repository.TermFilter{Name: pointer.ToString(`rde`), WithSynonyms: true})
+		suite.Require().NoError(err)
+		suite.Require().Len(found, 1)
+		suite.Equal(red.ID, found[0].ID)
+
+		found, err = termClient.Get(ctx, &repository.TermFilter{NameIn: []string{`azure`, `red`},
+			WithSynonyms: true})
+		suite.Require().NoError(err)
+		suite.Len(found, 2)
+	})
+
+	suite.Run(`nil synonyms are kept, empty are removed`, func() {
+		updated, err := termClient.Update(ctx, red.ID, &model.TermData{Name: `red`, VocabularyID: []uint64{1}})
+		suite.Require().NoError(err)
+		suite.Len(updated.Data.Synonyms, 3)
+
+		updated, err = termClient.Update(ctx, red.ID, &model.TermData{Name: `red`, VocabularyID: []uint64{1},
+			Synonyms: []model.Synonym{{Name: `crimson`}}})
+		suite.Require().NoError(err)
+		suite.Equal([]model.Synonym{{Name: `crimson`}}, updated.Data.Synonyms)
+
+		bulk, err := termClient.UpdateBulk(ctx, &model.Term{ID: created[0].ID, Data: model.TermData{Name: `blue`,
+			VocabularyID: []uint64{1}, Synonyms: []model.Synonym{}}})
+		suite.Require().NoError(err)
+		suite.Empty(bulk[0].Data.Synonyms)
+	})
+
+	suite.Run(`synonyms are removed with term`, func() {
+		suite.Require().NoError(termClient.Delete(ctx, &repository.TermFilter{ID: []uint64{red.ID}}))
+		suite.Zero(suite.client.Synonym.Query().CountX(ctx))
+	})
+}
+
 func TestTermOperationsSuite(t *testing.T) {
 	suitetest.Run(t, new(TestTermOperations))
 }
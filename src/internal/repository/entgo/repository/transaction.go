@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+)
+
+// Transaction starts ent transactions and passes them to repositories through context.
+type Transaction struct {
+	client *ent.Client
+}
+
+func NewTransaction(client *ent.Client) repository.Transaction {
+	return &Transaction{
+		client: client,
+	}
+}
+
+func (t *Transaction) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Join transaction started by caller
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := t.client.Tx(ctx)
+	if err != nil {
+		return errors.Join(repository.ErrTransaction, err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+
+			panic(v)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, repository.ErrTransaction, rollbackErr)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Join(repository.ErrTransaction, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent"
+	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/enttest"
+	repo "github.com/dmalykh/taxonomy/internal/repository/entgo/repository"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction_Run(t *testing.T) {
+	var errFailed = errors.New(`failed`)
+
+	tests := []struct {
+		name  string
+		run   func(ctx context.Context, transaction repository.Transaction, namespace repository.Namespace) error
+		err   error
+		names []string
+	}{
+		{
+			name: `committed`,
+			run: func(ctx context.Context, transaction repository.Transaction, namespace repository.Namespace) error {
+				return transaction.Run(ctx, func(ctx context.Context) error {
+					_, err := namespace.Create(ctx, &model.NamespaceData{Name: `first`})
+
+					return err
+				})
+			},
+			names: []string{`first`},
+		},
+		{
+			name: `rolled back`,
+			run: func(ctx context.Context, transaction repository.Transaction, namespace repository.Namespace) error {
+				return transaction.Run(ctx, func(ctx context.Context) error {
+					if _, err := namespace.Create(ctx, &model.NamespaceData{Name: `first`}); err != nil {
+						return err
+					}
+
+					return errFailed
+				})
+			},
+			err: errFailed,
+		},
+		{
+			name: `nested transaction joins outer one`,
+			run: func(ctx context.Context, transaction repository.Transaction, namespace repository.Namespace) error {
+				return transaction.Run(ctx, func(ctx context.Context) error {
+					if _, err := namespace.Create(ctx, &model.NamespaceData{Name: `first`}); err != nil {
+						return err
+					}
+
+					// Error of inner call rolls back the whole transaction
+					return transaction.Run(ctx, func(ctx context.Context) error {
+						if _, err := namespace.Create(ctx, &model.NamespaceData{Name: `second`}); err != nil {
+							return err
+						}
+
+						return errFailed
+					})
+				})
+			},
+			err: errFailed,
+		},
+		{
+			name: `rolled back on panic`,
+			run: func(ctx context.Context, transaction repository.Transaction, namespace repository.Namespace) error {
+				defer func() {
+					_ = recover()
+				}()
+
+				return transaction.Run(ctx, func(ctx context.Context) error {
+					if _, err := namespace.Create(ctx, &model.NamespaceData{Name: `first`}); err != nil {
+						return err
+					}
+
+					panic(errFailed)
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctx = context.Background()
+
+			client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1", []enttest.Option{
+				enttest.WithOptions(ent.Log(t.Log)),
+			}...)
+			defer client.Close()
+
+			namespace := repo.NewNamespace(client.Namespace)
+
+			err := tt.run(ctx, repo.NewTransaction(client), namespace)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			}
+
+			namespaces, err := namespace.Get(ctx, &repository.NamespaceFilter{})
+			require.NoError(t, err)
+
+			var names = make([]string, 0)
+			for _, ns := range namespaces {
+				names = append(names, ns.Data.Name)
+			}
+
+			assert.ElementsMatch(t, tt.names, names)
+		})
+	}
+}
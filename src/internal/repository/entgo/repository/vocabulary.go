@@ -9,6 +9,7 @@ import (
 	"github.com/dmalykh/taxonomy/internal/repository/entgo/ent/vocabulary"
 	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"slices"
 )
 
 type Vocabulary struct {
@@ -21,11 +22,21 @@ func NewVocabulary(client *ent.VocabularyClient) repository.Vocabulary {
 	}
 }
 
+// clientFrom makes queries of the repository a part of context's transaction.
+func (v *Vocabulary) clientFrom(ctx context.Context) *ent.VocabularyClient {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Vocabulary
+	}
+
+	return v.client
+}
+
 func (v *Vocabulary) Create(ctx context.Context, data *model.VocabularyData) (*model.Vocabulary, error) {
-	ns, err := v.client.Create().
+	ns, err := v.clientFrom(ctx).Create().
 		SetName(data.Name).
 		SetTitle(data.Title).
 		SetNillableDescription(data.Description).
+		SetLabels(data.Labels).
 		SetNillableParentID(func() *uint64 { return data.ParentID }()).
 		Save(ctx)
 	if err != nil {
@@ -40,10 +51,11 @@ func (v *Vocabulary) Create(ctx context.Context, data *model.VocabularyData) (*m
 }
 
 func (v *Vocabulary) Update(ctx context.Context, id uint64, data *model.VocabularyData) (*model.Vocabulary, error) {
-	updated, err := v.client.UpdateOneID(id).
+	updated, err := v.clientFrom(ctx).UpdateOneID(id).
 		SetName(data.Name).
 		SetTitle(data.Title).
 		SetNillableDescription(data.Description).
+		SetLabels(data.Labels).
 		ClearParentID().
 		SetNillableParentID(func() *uint64 { return data.ParentID }()).
 		Save(ctx)
@@ -59,7 +71,7 @@ func (v *Vocabulary) Update(ctx context.Context, id uint64, data *model.Vocabula
 }
 
 func (v *Vocabulary) Delete(ctx context.Context, filter *repository.VocabularyFilter) error {
-	_, err := v.client.Delete().Where(
+	_, err := v.clientFrom(ctx).Delete().Where(
 		v.buildQuery(filter)...,
 	).Exec(ctx)
 	if err != nil {
@@ -69,7 +81,7 @@ func (v *Vocabulary) Delete(ctx context.Context, filter *repository.VocabularyFi
 }
 
 func (v *Vocabulary) Get(ctx context.Context, filter *repository.VocabularyFilter) ([]*model.Vocabulary, error) {
-	entvoc, err := v.client.Query().Where(
+	entvoc, err := v.clientFrom(ctx).Query().Where(
 		v.buildQuery(filter)...,
 	).All(ctx)
 
@@ -86,6 +98,73 @@ func (v *Vocabulary) Get(ctx context.Context, filter *repository.VocabularyFilte
 	return vocabularies, nil
 }
 
+// Ancestors returns parents of the vocabulary up to depth levels ordered from the root.
+func (v *Vocabulary) Ancestors(ctx context.Context, id uint64, depth uint) ([]*model.Vocabulary, error) {
+	vocabularies, err := v.related(ctx, fmt.Sprintf(vocabularyAncestorsQuery, vocabulary.Table, ids(id), depth))
+	if err != nil {
+		return nil, err
+	}
+
+	var found = make(map[uint64]*model.Vocabulary, len(vocabularies))
+	for _, voc := range vocabularies {
+		found[voc.ID] = voc
+	}
+
+	ancestors := make([]*model.Vocabulary, 0, len(vocabularies))
+
+	for current, ok := found[id]; ok && current.Data.ParentID != nil; {
+		if current, ok = found[*current.Data.ParentID]; ok {
+			ancestors = append(ancestors, current)
+		}
+	}
+
+	slices.Reverse(ancestors)
+
+	return ancestors, nil
+}
+
+// Descendants returns children of the vocabulary up to depth levels ordered by distance from the vocabulary.
+func (v *Vocabulary) Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Vocabulary, error) {
+	vocabularies, err := v.related(ctx, fmt.Sprintf(vocabularyDescendantsQuery, vocabulary.Table, ids(id), depth))
+	if err != nil {
+		return nil, err
+	}
+
+	var children = make(map[uint64][]uint64)
+
+	for _, voc := range vocabularies {
+		if voc.Data.ParentID != nil {
+			children[*voc.Data.ParentID] = append(children[*voc.Data.ParentID], voc.ID)
+		}
+	}
+
+	distance := levels(id, func(current uint64) []uint64 {
+		return children[current]
+	})
+
+	vocabularies = slices.DeleteFunc(vocabularies, func(voc *model.Vocabulary) bool {
+		return voc.ID == id
+	})
+	byDepth(vocabularies, func(voc *model.Vocabulary) uint64 { return voc.ID }, distance)
+
+	return vocabularies, nil
+}
+
+func (v *Vocabulary) related(ctx context.Context, query string) ([]*model.Vocabulary, error) {
+	entvoc, err := v.clientFrom(ctx).Query().Where(idIn(vocabulary.FieldID, query)).All(ctx)
+	if err != nil {
+		return nil, errors.Join(repository.ErrFindVocabulary, err)
+	}
+
+	vocabularies := make([]*model.Vocabulary, 0, len(entvoc))
+
+	for _, voc := range entvoc {
+		vocabularies = append(vocabularies, v.ent2model(voc))
+	}
+
+	return vocabularies, nil
+}
+
 func (v *Vocabulary) buildQuery(filter *repository.VocabularyFilter) []predicate.Vocabulary {
 	var predicates = make([]predicate.Vocabulary, 0)
 	// Filter by id
@@ -97,7 +176,7 @@ func (v *Vocabulary) buildQuery(filter *repository.VocabularyFilter) []predicate
 		predicates = append(predicates, vocabulary.ParentIDIn(filter.ParentID...))
 	}
 	// Filter by name
-	if len(filter.ParentID) > 0 {
+	if len(filter.Name) > 0 {
 		predicates = append(predicates, vocabulary.NameIn(filter.Name...))
 	}
 
@@ -111,6 +190,7 @@ func (v *Vocabulary) ent2model(vocabulary *ent.Vocabulary) *model.Vocabulary {
 			Name:        vocabulary.Name,
 			Title:       vocabulary.Title,
 			Description: &vocabulary.Description,
+			Labels:      vocabulary.Labels,
 			ParentID:    vocabulary.ParentID,
 		},
 	}
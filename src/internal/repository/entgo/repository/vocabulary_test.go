@@ -225,3 +225,58 @@ func vocabularyClient(t *testing.T) (repository.Vocabulary, *ent.Client) {
 
 	return c, client
 }
+
+func TestVocabulary_AncestorsDescendants(t *testing.T) {
+	ctx := context.TODO()
+	client := enttest.Open(t, "sqlite3", ":memory:?_fk=1", []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}...)
+
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+	})
+
+	v := repo.NewVocabulary(client.Vocabulary)
+
+	// Electronics <- Computers <- Laptops <- Gaming, Electronics <- Phones, Computers <- Tablets
+	for _, data := range []model.VocabularyData{
+		{Name: `Electronics`},
+		{Name: `Computers`, ParentID: pointer.ToUint64(1)},
+		{Name: `Laptops`, ParentID: pointer.ToUint64(2)},
+		{Name: `Gaming`, ParentID: pointer.ToUint64(3)},
+		{Name: `Phones`, ParentID: pointer.ToUint64(1)},
+		{Name: `Tablets`, ParentID: pointer.ToUint64(2)},
+	} {
+		_, err := v.Create(ctx, &data)
+		require.NoError(t, err)
+	}
+
+	ids := func(vocabularies []*model.Vocabulary) []uint64 {
+		result := make([]uint64, len(vocabularies))
+		for i, vocabulary := range vocabularies {
+			result[i] = vocabulary.ID
+		}
+
+		return result
+	}
+
+	ancestors, err := v.Ancestors(ctx, 4, 32)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, ids(ancestors))
+
+	ancestors, err = v.Ancestors(ctx, 4, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3}, ids(ancestors))
+
+	ancestors, err = v.Ancestors(ctx, 1, 32)
+	require.NoError(t, err)
+	assert.Empty(t, ancestors)
+
+	descendants, err := v.Descendants(ctx, 1, 32)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 5, 3, 6, 4}, ids(descendants))
+
+	descendants, err = v.Descendants(ctx, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2, 5}, ids(descendants))
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Synonym holds the schema definition for the Synonym entity.
+type Synonym struct {
+	ent.Schema
+}
+
+// Fields of the Synonym.
+func (Synonym) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64(`id`).Immutable(),
+		field.Uint64(`term_id`),
+		field.String(`name`).NotEmpty(),
+		field.String(`locale`).Default(``),
+		field.Bool(`hidden`).Default(false),
+	}
+}
+
+func (Synonym) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(`name`),
+		index.Fields(`term_id`, `name`, `locale`).Unique(),
+	}
+}
+
+// Edges of the Synonym.
+func (Synonym) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From(`term`, Term.Type).
+			Ref(`synonyms`).Field(`term_id`).Unique().Required(),
+	}
+}
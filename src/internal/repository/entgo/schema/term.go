@@ -2,9 +2,11 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 // Term holds the schema definition for the Term entity.
@@ -19,6 +21,7 @@ func (Term) Fields() []ent.Field {
 		field.String(`name`).NotEmpty(),
 		field.String(`title`).Optional(),
 		field.Text(`description`).Optional(),
+		field.JSON(`labels`, model.Labels{}).Optional(),
 	}
 }
 
@@ -36,5 +39,9 @@ func (Term) Edges() []ent.Edge {
 
 		edge.To("subterms", Term.Type).
 			From("superterms"),
+
+		// Synonyms are removed with their term
+		edge.To(`synonyms`, Synonym.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
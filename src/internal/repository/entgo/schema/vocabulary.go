@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
 )
 
 // Vocabulary holds the schema definition for the Vocabulary entity.
@@ -19,6 +20,7 @@ func (Vocabulary) Fields() []ent.Field {
 		field.String(`name`).NotEmpty(),
 		field.String(`title`).Optional(),
 		field.Text(`description`).Optional(),
+		field.JSON(`labels`, model.Labels{}).Optional(),
 		field.Uint64(`parent_id`).Optional().Nillable(),
 	}
 }
@@ -0,0 +1,316 @@
+// Package csv reads and writes taxonomies as CSV tables maintained in spreadsheets. Every row is a term of the
+// vocabulary, row with empty term keeps the vocabulary without terms.
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+var (
+	ErrColumnNotFound  = errors.New(`column not found`)
+	ErrEmptyVocabulary = errors.New(`vocabulary is empty`)
+)
+
+// BroaderSeparator separates names of broader terms in one cell.
+const BroaderSeparator = `|`
+
+// LocaleSeparator separates name of title or description column and locale of translations in the column, i.e.
+// "title@de".
+const LocaleSeparator = `@`
+
+// Columns contains names of columns in the header, empty name means the column isn't used. Vocabulary and Term columns
+// are required.
+type Columns struct {
+	Vocabulary  string
+	Parent      string
+	Term        string
+	Title       string
+	Description string
+	Broader     string
+}
+
+// DefaultColumns are used when columns aren't mapped.
+var DefaultColumns = Columns{
+	Vocabulary:  `vocabulary`,
+	Parent:      `parent`,
+	Term:        `term`,
+	Title:       `title`,
+	Description: `description`,
+	Broader:     `broader`,
+}
+
+// Read reads vocabularies and terms. Parent contains path of names of vocabulary's parents separated by
+// exchange.PathSeparator, broader terms are separated by BroaderSeparator. Translations are read from title and
+// description columns with locales like "title@de". Rows of the same term are merged: the first non-empty title and
+// description are used, broader terms are joined.
+func Read(r io.Reader, columns Columns) (*exchange.Taxonomy, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf(`read header: %w`, err)
+	}
+
+	index, err := indexes(header, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		labels       = labelColumns(header, columns)
+		taxonomy     = new(exchange.Taxonomy)
+		vocabularies = make(map[string]*exchange.Vocabulary)
+		terms        = make(map[*exchange.Vocabulary]map[string]*exchange.Term)
+	)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return taxonomy, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf(`read: %w`, err)
+		}
+
+		cell := func(column string) string {
+			if i, ok := index[column]; ok && column != `` && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ``
+		}
+
+		var v = &exchange.Vocabulary{
+			Parent: split(cell(columns.Parent), exchange.PathSeparator),
+			Name:   cell(columns.Vocabulary),
+		}
+
+		if v.Name == `` {
+			line, _ := reader.FieldPos(0)
+
+			return nil, fmt.Errorf(`line %d: %w`, line, ErrEmptyVocabulary)
+		}
+
+		if found, ok := vocabularies[exchange.JoinPath(v.Path())]; ok {
+			v = found
+		} else {
+			vocabularies[exchange.JoinPath(v.Path())] = v
+			terms[v] = make(map[string]*exchange.Term)
+			taxonomy.Vocabularies = append(taxonomy.Vocabularies, v)
+		}
+
+		name := cell(columns.Term)
+		if name == `` {
+			continue
+		}
+
+		term, ok := terms[v][name]
+		if !ok {
+			term = &exchange.Term{Name: name}
+			terms[v][name] = term
+			v.Terms = append(v.Terms, term)
+		}
+
+		if term.Title == `` {
+			term.Title = cell(columns.Title)
+		}
+
+		if term.Description == `` {
+			term.Description = cell(columns.Description)
+		}
+
+		for _, column := range labels {
+			var value = cell(column.name)
+			if value == `` {
+				continue
+			}
+
+			if term.Labels == nil {
+				term.Labels = make(model.Labels)
+			}
+
+			label := term.Labels[column.locale]
+			if column.title && label.Title == `` {
+				label.Title = value
+			} else if !column.title && label.Description == `` {
+				label.Description = value
+			}
+
+			term.Labels[column.locale] = label
+		}
+
+		for _, broader := range split(cell(columns.Broader), BroaderSeparator) {
+			if !slices.Contains(term.Broader, broader) {
+				term.Broader = append(term.Broader, broader)
+			}
+		}
+	}
+}
+
+// Write writes every term as a row, vocabularies without terms are written as rows with empty term. Translations are
+// written to title and description columns of every locale after other columns.
+func Write(w io.Writer, taxonomy *exchange.Taxonomy, columns Columns) error {
+	writer := csv.NewWriter(w)
+
+	var header = make([]string, 0)
+
+	for _, column := range []string{columns.Vocabulary, columns.Parent, columns.Term, columns.Title,
+		columns.Description, columns.Broader} {
+		if column != `` {
+			header = append(header, column)
+		}
+	}
+
+	var locales = make([]string, 0)
+
+	for _, v := range taxonomy.Vocabularies {
+		for _, term := range v.Terms {
+			locales = append(locales, term.Labels.Locales()...)
+		}
+	}
+
+	slices.Sort(locales)
+	locales = slices.Compact(locales)
+
+	for _, locale := range locales {
+		for _, column := range []string{columns.Title, columns.Description} {
+			if column != `` {
+				header = append(header, column+LocaleSeparator+locale)
+			}
+		}
+	}
+
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf(`write header: %w`, err)
+	}
+
+	row := func(v *exchange.Vocabulary, term *exchange.Term) []string {
+		var record = make([]string, 0, len(header))
+
+		for _, cell := range []struct {
+			column string
+			value  string
+		}{
+			{columns.Vocabulary, v.Name},
+			{columns.Parent, exchange.JoinPath(v.Parent)},
+			{columns.Term, term.Name},
+			{columns.Title, term.Title},
+			{columns.Description, term.Description},
+			{columns.Broader, strings.Join(term.Broader, BroaderSeparator)},
+		} {
+			if cell.column != `` {
+				record = append(record, cell.value)
+			}
+		}
+
+		for _, locale := range locales {
+			if columns.Title != `` {
+				record = append(record, term.Labels[locale].Title)
+			}
+
+			if columns.Description != `` {
+				record = append(record, term.Labels[locale].Description)
+			}
+		}
+
+		return record
+	}
+
+	for _, v := range taxonomy.Vocabularies {
+		if len(v.Terms) == 0 {
+			if err := writer.Write(row(v, &exchange.Term{})); err != nil {
+				return fmt.Errorf(`write vocabulary %s: %w`, exchange.JoinPath(v.Path()), err)
+			}
+		}
+
+		for _, term := range v.Terms {
+			if err := writer.Write(row(v, term)); err != nil {
+				return fmt.Errorf(`write term %s: %w`, term.Name, err)
+			}
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error() //nolint:wrapcheck
+}
+
+// indexes returns positions of used columns in the header.
+func indexes(header []string, columns Columns) (map[string]int, error) {
+	var index = make(map[string]int, len(header))
+
+	for i, name := range header {
+		// Spreadsheets put byte order mark before the first column
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if _, ok := index[name]; !ok {
+			index[name] = i
+		}
+	}
+
+	for _, column := range []string{columns.Vocabulary, columns.Term} {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf(`%w: %q`, ErrColumnNotFound, column)
+		}
+	}
+
+	for _, column := range []string{columns.Parent, columns.Title, columns.Description, columns.Broader} {
+		if _, ok := index[column]; column != `` && !ok {
+			return nil, fmt.Errorf(`%w: %q`, ErrColumnNotFound, column)
+		}
+	}
+
+	return index, nil
+}
+
+type labelColumn struct {
+	name   string
+	locale string
+	title  bool
+}
+
+// labelColumns returns columns of the header with translations of titles and descriptions.
+func labelColumns(header []string, columns Columns) []labelColumn {
+	var labels []labelColumn
+
+	for _, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+
+		for _, column := range []string{columns.Title, columns.Description} {
+			locale, ok := strings.CutPrefix(name, column+LocaleSeparator)
+			if column == `` || !ok || locale == `` {
+				continue
+			}
+
+			labels = append(labels, labelColumn{
+				name:   name,
+				locale: model.NormalizeLocale(locale),
+				title:  column == columns.Title,
+			})
+		}
+	}
+
+	return labels
+}
+
+// split returns non-empty trimmed parts of the value.
+func split(value, separator string) []string {
+	var parts []string
+
+	for _, part := range strings.Split(value, separator) {
+		if part = strings.TrimSpace(part); part != `` {
+			parts = append(parts, part)
+		}
+	}
+
+	return parts
+}
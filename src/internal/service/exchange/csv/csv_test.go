@@ -0,0 +1,72 @@
+package csv_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/internal/service/exchange/csv"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	const table = "\ufeffCategory,Path,Name,Caption,Broader,Caption@pt_br\n" +
+		"colors,catalog,red,Red,,Vermelho\n" +
+		"colors,catalog,crimson,,red,\n" +
+		"colors,catalog,crimson,Crimson,red | scarlet,\n" +
+		"sizes,catalog / clothes,,,,\n"
+
+	taxonomy, err := csv.Read(strings.NewReader(table), csv.Columns{
+		Vocabulary: `Category`,
+		Parent:     `Path`,
+		Term:       `Name`,
+		Title:      `Caption`,
+		Broader:    `Broader`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Parent: []string{`catalog`}, Name: `colors`, Terms: []*exchange.Term{
+			{Name: `red`, Title: `Red`, Labels: model.Labels{`pt-BR`: {Title: `Vermelho`}}},
+			{Name: `crimson`, Title: `Crimson`, Broader: []string{`red`, `scarlet`}},
+		}},
+		{Parent: []string{`catalog`, `clothes`}, Name: `sizes`},
+	}}, taxonomy)
+}
+
+func TestRead_Errors(t *testing.T) {
+	_, err := csv.Read(strings.NewReader("vocabulary,name\ncolors,red\n"), csv.DefaultColumns)
+	assert.ErrorIs(t, err, csv.ErrColumnNotFound)
+
+	_, err = csv.Read(strings.NewReader("vocabulary,term\ncolors,red\n,green\n"), csv.Columns{
+		Vocabulary: `vocabulary`,
+		Term:       `term`,
+	})
+	assert.ErrorIs(t, err, csv.ErrEmptyVocabulary)
+	assert.ErrorContains(t, err, `line 3`)
+}
+
+func TestWrite(t *testing.T) {
+	var taxonomy = &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Name: `catalog`},
+		{Parent: []string{`catalog`}, Name: `colors`, Terms: []*exchange.Term{
+			{Name: `red`, Title: `Red`, Description: `Color of "blood", fire`, Labels: model.Labels{
+				`de`: {Title: `Rot`, Description: `Farbe`},
+			}},
+			{Name: `crimson`, Broader: []string{`red`, `scarlet`}},
+		}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, csv.Write(&buf, taxonomy, csv.DefaultColumns))
+	assert.Equal(t, "vocabulary,parent,term,title,description,broader,title@de,description@de\n"+
+		"catalog,,,,,,,\n"+
+		"colors,catalog,red,Red,\"Color of \"\"blood\"\", fire\",,Rot,Farbe\n"+
+		"colors,catalog,crimson,,,red|scarlet,,\n", buf.String())
+
+	read, err := csv.Read(&buf, csv.DefaultColumns)
+	require.NoError(t, err)
+	assert.Equal(t, taxonomy, read)
+}
@@ -0,0 +1,140 @@
+package exchange
+
+import (
+	"strings"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"go.uber.org/zap"
+)
+
+// Taxonomy is a set of vocabularies with their terms which is imported or exported. Namespaces are planned only when
+// they are listed.
+type Taxonomy struct {
+	Namespaces   []string
+	Vocabularies []*Vocabulary
+}
+
+// Vocabulary is identified by names of its parents from the root and its own name, because name of vocabulary is
+// unique among vocabularies with the same parent.
+type Vocabulary struct {
+	Parent      []string
+	Name        string
+	Title       string
+	Description string
+	Labels      model.Labels
+	Terms       []*Term
+}
+
+// Path returns names of parents and the vocabulary.
+func (v *Vocabulary) Path() []string {
+	return append(append(make([]string, 0, len(v.Parent)+1), v.Parent...), v.Name)
+}
+
+// Term is identified by its name in the vocabulary. Broader contains names of broader terms of the same vocabulary.
+type Term struct {
+	Name        string
+	Title       string
+	Description string
+	Labels      model.Labels
+	Broader     []string
+}
+
+// PathSeparator separates names of vocabularies in path.
+const PathSeparator = `/`
+
+// JoinPath returns printable path of vocabulary.
+func JoinPath(path []string) string {
+	return strings.Join(path, PathSeparator)
+}
+
+// labelsChanged returns true when any title or description of imported labels differs from the current one, empty
+// ones keep current values.
+func labelsChanged(imported, current model.Labels) bool {
+	for locale, label := range imported {
+		var c = current[model.NormalizeLocale(locale)]
+		if label.Title != `` && label.Title != c.Title || label.Description != `` && label.Description != c.Description {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keepLabels fills empty titles and descriptions of imported labels with current values, so services don't remove
+// translations which aren't imported.
+func keepLabels(imported, current model.Labels) model.Labels {
+	if len(imported) == 0 {
+		return nil
+	}
+
+	var kept = make(model.Labels, len(imported))
+
+	for locale, label := range imported {
+		if label.IsZero() {
+			continue
+		}
+
+		locale = model.NormalizeLocale(locale)
+
+		if label.Title == `` {
+			label.Title = current[locale].Title
+		}
+
+		if label.Description == `` {
+			label.Description = current[locale].Description
+		}
+
+		kept[locale] = label
+	}
+
+	return kept
+}
+
+// key identifies vocabulary by its path in maps, names may contain PathSeparator.
+func key(path []string) string {
+	return strings.Join(path, "\x00")
+}
+
+// splitKey returns path of vocabulary by its key.
+func splitKey(k string) []string {
+	return strings.Split(k, "\x00")
+}
+
+type Config struct {
+	// Transaction makes applying of a plan atomic, repository.NoTransaction is used when it's nil.
+	Transaction       repository.Transaction
+	NamespaceService  taxonomy.Namespace
+	VocabularyService taxonomy.Vocabulary
+	TermService       taxonomy.Term
+	// ReferenceService is used for checks of pruned terms and namespaces
+	ReferenceService taxonomy.Reference
+	Logger           *zap.Logger
+}
+
+func New(config *Config) *Service {
+	var transaction = config.Transaction
+	if transaction == nil {
+		transaction = repository.NoTransaction{}
+	}
+
+	return &Service{
+		transaction:       transaction,
+		namespaceService:  config.NamespaceService,
+		vocabularyService: config.VocabularyService,
+		termService:       config.TermService,
+		referenceService:  config.ReferenceService,
+		log:               config.Logger,
+	}
+}
+
+// Service imports taxonomies with upsert by name semantics and exports them.
+type Service struct {
+	transaction       repository.Transaction
+	namespaceService  taxonomy.Namespace
+	vocabularyService taxonomy.Vocabulary
+	termService       taxonomy.Term
+	referenceService  taxonomy.Reference
+	log               *zap.Logger
+}
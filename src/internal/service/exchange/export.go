@@ -0,0 +1,78 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/AlekSi/pointer"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// Export returns all vocabularies with their terms, parents go before their children. Broader terms from other
+// vocabularies aren't exported, because terms are identified by names in their vocabularies.
+func (s *Service) Export(ctx context.Context) (*Taxonomy, error) {
+	logger := s.log.With(zap.String(`method`, `Export`))
+
+	vocabularies, err := s.vocabularyService.Get(ctx, &model.VocabularyFilter{})
+	if err != nil {
+		return nil, fmt.Errorf(`get vocabularies: %w`, err)
+	}
+
+	var (
+		byID  = lo.KeyBy(vocabularies, func(v *model.Vocabulary) uint64 { return v.ID })
+		paths = make(map[uint64][]string, len(vocabularies))
+	)
+
+	for _, v := range vocabularies {
+		paths[v.ID] = vocabularyPath(v, byID)
+	}
+
+	slices.SortFunc(vocabularies, func(a, b *model.Vocabulary) int {
+		return slices.Compare(paths[a.ID], paths[b.ID])
+	})
+
+	var exported = &Taxonomy{Vocabularies: make([]*Vocabulary, 0, len(vocabularies))}
+
+	for _, v := range vocabularies {
+		path := paths[v.ID]
+
+		terms, err := s.termService.Get(ctx, &model.TermFilter{VocabularyID: []uint64{v.ID}})
+		if err != nil {
+			return nil, fmt.Errorf(`get terms of vocabulary %s: %w`, JoinPath(path), err)
+		}
+
+		var (
+			names      = make(map[uint64]string, len(terms))
+			vocabulary = &Vocabulary{
+				Parent:      path[:len(path)-1],
+				Name:        v.Data.Name,
+				Title:       v.Data.Title,
+				Description: pointer.GetString(v.Data.Description),
+				Labels:      v.Data.Labels,
+				Terms:       make([]*Term, 0, len(terms)),
+			}
+		)
+
+		for _, term := range terms {
+			names[term.ID] = term.Data.Name
+		}
+
+		for _, term := range terms {
+			vocabulary.Terms = append(vocabulary.Terms, &Term{
+				Name:        term.Data.Name,
+				Title:       term.Data.Title,
+				Description: term.Data.Description,
+				Broader:     broaderNames(term, names),
+			})
+		}
+
+		exported.Vocabularies = append(exported.Vocabularies, vocabulary)
+	}
+
+	logger.Debug(`taxonomy exported`, zap.Int(`vocabularies`, len(exported.Vocabularies)))
+
+	return exported, nil
+}
@@ -0,0 +1,140 @@
+// Package manifest reads taxonomy described as code in YAML or JSON. Manifest lists namespaces and trees of
+// vocabularies with their terms:
+//
+//	namespaces: [products]
+//	vocabularies:
+//	  - name: catalog
+//	    vocabularies:
+//	      - name: colors
+//	        title: Colors
+//	        terms:
+//	          - name: red
+//	            labels:
+//	              de: {title: Rot}
+//	          - name: crimson
+//	            broader: [red]
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrEmptyName = errors.New(`name is empty`)
+
+type Manifest struct {
+	Namespaces   []string      `yaml:"namespaces"`
+	Vocabularies []*Vocabulary `yaml:"vocabularies"`
+}
+
+// Vocabulary contains its terms and children vocabularies.
+type Vocabulary struct {
+	Name         string           `yaml:"name"`
+	Title        string           `yaml:"title"`
+	Description  string           `yaml:"description"`
+	Labels       map[string]Label `yaml:"labels"`
+	Terms        []*Term          `yaml:"terms"`
+	Vocabularies []*Vocabulary    `yaml:"vocabularies"`
+}
+
+// Term contains names of broader terms of the same vocabulary.
+type Term struct {
+	Name        string           `yaml:"name"`
+	Title       string           `yaml:"title"`
+	Description string           `yaml:"description"`
+	Labels      map[string]Label `yaml:"labels"`
+	Broader     []string         `yaml:"broader"`
+}
+
+// Label is a translation of title and description to the locale it's keyed by.
+type Label struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+}
+
+// Read reads manifest in YAML or JSON, which is a subset of YAML. Unknown fields are errors, so typos don't remove
+// anything when the plan is pruned.
+func Read(r io.Reader) (*exchange.Taxonomy, error) {
+	var (
+		manifest Manifest
+		decoder  = yaml.NewDecoder(r)
+	)
+
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&manifest); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf(`decode manifest: %w`, err)
+	}
+
+	var taxonomy = &exchange.Taxonomy{Namespaces: manifest.Namespaces}
+
+	for i, name := range manifest.Namespaces {
+		if name == `` {
+			return nil, fmt.Errorf(`%w: namespace #%d`, ErrEmptyName, i+1)
+		}
+	}
+
+	if err := flatten(taxonomy, nil, manifest.Vocabularies); err != nil {
+		return nil, err
+	}
+
+	return taxonomy, nil
+}
+
+// flatten adds vocabularies with their children to taxonomy, parents go before their children.
+func flatten(taxonomy *exchange.Taxonomy, parent []string, vocabularies []*Vocabulary) error {
+	for i, v := range vocabularies {
+		if v.Name == `` {
+			return fmt.Errorf(`%w: vocabulary #%d in %q`, ErrEmptyName, i+1, exchange.JoinPath(parent))
+		}
+
+		var vocabulary = &exchange.Vocabulary{
+			Parent:      parent,
+			Name:        v.Name,
+			Title:       v.Title,
+			Description: v.Description,
+			Labels:      labels(v.Labels),
+			Terms:       make([]*exchange.Term, 0, len(v.Terms)),
+		}
+
+		for j, term := range v.Terms {
+			if term.Name == `` {
+				return fmt.Errorf(`%w: term #%d in %s`, ErrEmptyName, j+1, exchange.JoinPath(vocabulary.Path()))
+			}
+
+			vocabulary.Terms = append(vocabulary.Terms, &exchange.Term{
+				Name:        term.Name,
+				Title:       term.Title,
+				Description: term.Description,
+				Labels:      labels(term.Labels),
+				Broader:     term.Broader,
+			})
+		}
+
+		taxonomy.Vocabularies = append(taxonomy.Vocabularies, vocabulary)
+
+		if err := flatten(taxonomy, vocabulary.Path(), v.Vocabularies); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func labels(translations map[string]Label) model.Labels {
+	if len(translations) == 0 {
+		return nil
+	}
+
+	var labels = make(model.Labels, len(translations))
+	for locale, label := range translations {
+		labels[model.NormalizeLocale(locale)] = model.Label{Title: label.Title, Description: label.Description}
+	}
+
+	return labels
+}
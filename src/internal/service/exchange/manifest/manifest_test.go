@@ -0,0 +1,70 @@
+package manifest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/internal/service/exchange/manifest"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	var expected = &exchange.Taxonomy{
+		Namespaces: []string{`products`},
+		Vocabularies: []*exchange.Vocabulary{
+			{Name: `catalog`, Terms: []*exchange.Term{}},
+			{Parent: []string{`catalog`}, Name: `colors`, Title: `Colors`, Terms: []*exchange.Term{
+				{Name: `red`, Description: `Color of blood`, Labels: model.Labels{`de-CH`: {Title: `Rot`}}},
+				{Name: `crimson`, Broader: []string{`red`}},
+			}},
+			{Parent: []string{`catalog`}, Name: `sizes`, Terms: []*exchange.Term{}},
+		},
+	}
+
+	t.Run(`yaml`, func(t *testing.T) {
+		taxonomy, err := manifest.Read(strings.NewReader(`
+namespaces: [products]
+vocabularies:
+  - name: catalog
+    vocabularies:
+      - name: colors
+        title: Colors
+        terms:
+          - name: red
+            description: Color of blood
+            labels:
+              de_ch: {title: Rot}
+          - name: crimson
+            broader: [red]
+      - name: sizes
+`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, taxonomy)
+	})
+
+	t.Run(`json`, func(t *testing.T) {
+		taxonomy, err := manifest.Read(strings.NewReader(`{"namespaces": ["products"], "vocabularies": [
+			{"name": "catalog", "vocabularies": [
+				{"name": "colors", "title": "Colors", "terms": [
+					{"name": "red", "description": "Color of blood", "labels": {"de-CH": {"title": "Rot"}}},
+					{"name": "crimson", "broader": ["red"]}
+				]},
+				{"name": "sizes"}
+			]}
+		]}`))
+		require.NoError(t, err)
+		assert.Equal(t, expected, taxonomy)
+	})
+}
+
+func TestRead_Errors(t *testing.T) {
+	_, err := manifest.Read(strings.NewReader("vocabularies:\n  - name: colors\n    term: [red]\n"))
+	assert.ErrorContains(t, err, `field term not found`)
+
+	_, err = manifest.Read(strings.NewReader("vocabularies:\n  - name: colors\n    terms:\n      - title: Red\n"))
+	assert.ErrorIs(t, err, manifest.ErrEmptyName)
+	assert.ErrorContains(t, err, `term #1 in colors`)
+}
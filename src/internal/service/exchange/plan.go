@@ -0,0 +1,583 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDuplicateTerm  = errors.New(`term is repeated in vocabulary`)
+	ErrUnknownBroader = errors.New(`broader term not found in vocabulary`)
+)
+
+type Action string
+
+const (
+	Create Action = `create`
+	Update Action = `update`
+	Skip   Action = `skip`
+	Delete Action = `delete`
+)
+
+// Change is a planned change of namespace, vocabulary or term. Namespace is set for changes of namespaces only, Term
+// is empty for changes of vocabularies.
+type Change struct {
+	Action     Action
+	Namespace  string
+	Vocabulary []string
+	Term       string
+}
+
+func (c *Change) String() string {
+	switch {
+	case c.Namespace != ``:
+		return `namespace ` + c.Namespace
+	case c.Term != ``:
+		return `term ` + c.Term + ` in ` + JoinPath(c.Vocabulary)
+	}
+
+	return `vocabulary ` + JoinPath(c.Vocabulary)
+}
+
+// Plan contains changes which make existing namespaces, vocabularies and terms match imported ones. They are matched
+// by names, nothing is removed unless the plan is pruned.
+type Plan struct {
+	// Changes are ordered as they are applied: namespaces go first, then vocabularies with parents before their
+	// children, then terms and deletions.
+	Changes []*Change
+
+	namespaces   []*Change
+	vocabularies []*plannedVocabulary
+	terms        []*plannedTerm
+	deletions    []*deletion
+	// listed namespaces are kept by pruning
+	listed []string
+	// existing vocabularies by key of path and their terms by name
+	existing      map[string]*model.Vocabulary
+	existingTerms map[string]map[string]*model.Term
+}
+
+type plannedVocabulary struct {
+	change *Change
+	data   *Vocabulary
+}
+
+type plannedTerm struct {
+	change *Change
+	data   *Term
+}
+
+type deletion struct {
+	change *Change
+	id     uint64
+}
+
+// Plan compares taxonomy with existing vocabularies and terms, nothing is changed.
+func (s *Service) Plan(ctx context.Context, t *Taxonomy) (*Plan, error) {
+	logger := s.log.With(zap.String(`method`, `Plan`), zap.Int(`vocabularies`, len(t.Vocabularies)))
+
+	plan, err := s.existing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.planNamespaces(ctx, plan, t.Namespaces); err != nil {
+		return nil, err
+	}
+
+	var listed = make(map[string]*Vocabulary, len(t.Vocabularies))
+	for _, v := range t.Vocabularies {
+		if _, ok := listed[key(v.Path())]; !ok {
+			listed[key(v.Path())] = v
+		}
+	}
+
+	var planned = make(map[string]struct{})
+
+	for _, v := range t.Vocabularies {
+		if err := s.planVocabulary(ctx, plan, v.Path(), listed, planned); err != nil {
+			return nil, err
+		}
+	}
+
+	var seen = make(map[string]map[string]struct{})
+
+	for _, v := range t.Vocabularies {
+		if err := plan.planTerms(v, seen); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Debug(`taxonomy planned`, zap.Int(`changes`, len(plan.Changes)))
+
+	return plan, nil
+}
+
+// existing returns plan with all existing vocabularies.
+func (s *Service) existing(ctx context.Context) (*Plan, error) {
+	vocabularies, err := s.vocabularyService.Get(ctx, &model.VocabularyFilter{})
+	if err != nil {
+		return nil, fmt.Errorf(`get vocabularies: %w`, err)
+	}
+
+	var (
+		byID = lo.KeyBy(vocabularies, func(v *model.Vocabulary) uint64 { return v.ID })
+		plan = &Plan{
+			existing:      make(map[string]*model.Vocabulary, len(vocabularies)),
+			existingTerms: make(map[string]map[string]*model.Term),
+		}
+	)
+
+	for _, v := range vocabularies {
+		plan.existing[key(vocabularyPath(v, byID))] = v
+	}
+
+	return plan, nil
+}
+
+// planNamespaces plans creation of listed namespaces which don't exist.
+func (s *Service) planNamespaces(ctx context.Context, plan *Plan, names []string) error {
+	plan.listed = lo.Uniq(names)
+	if len(plan.listed) == 0 {
+		return nil
+	}
+
+	namespaces, err := s.namespaceService.Get(ctx, 0, nil)
+	if err != nil {
+		return fmt.Errorf(`get namespaces: %w`, err)
+	}
+
+	var existing = lo.SliceToMap(namespaces, func(ns *model.Namespace) (string, struct{}) {
+		return ns.Data.Name, struct{}{}
+	})
+
+	for _, name := range plan.listed {
+		var change = &Change{Action: Create, Namespace: name}
+		if _, ok := existing[name]; ok {
+			change.Action = Skip
+		}
+
+		plan.Changes = append(plan.Changes, change)
+		plan.namespaces = append(plan.namespaces, change)
+	}
+
+	return nil
+}
+
+// vocabularyPath returns names of vocabulary's parents and the vocabulary.
+func vocabularyPath(v *model.Vocabulary, byID map[uint64]*model.Vocabulary) []string {
+	var path = []string{v.Data.Name}
+
+	// Count of steps is limited, so broken hierarchy doesn't make an endless loop
+	for parentID := v.Data.ParentID; parentID != nil && len(path) <= len(byID); {
+		parent, ok := byID[*parentID]
+		if !ok {
+			break
+		}
+
+		path = append(path, parent.Data.Name)
+		parentID = parent.Data.ParentID
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
+
+// planVocabulary plans the vocabulary after its parents. Parents which aren't listed are created with names only.
+func (s *Service) planVocabulary(ctx context.Context, plan *Plan, path []string, listed map[string]*Vocabulary,
+	planned map[string]struct{},
+) error {
+	if _, ok := planned[key(path)]; ok {
+		return nil
+	}
+
+	planned[key(path)] = struct{}{}
+
+	if len(path) > 1 {
+		if err := s.planVocabulary(ctx, plan, path[:len(path)-1], listed, planned); err != nil {
+			return err
+		}
+	}
+
+	data, ok := listed[key(path)]
+	if !ok {
+		data = &Vocabulary{Parent: path[:len(path)-1], Name: path[len(path)-1]}
+	}
+
+	var change = &Change{Action: Create, Vocabulary: path}
+
+	if existing, ok := plan.existing[key(path)]; ok {
+		change.Action = Skip
+		if data.Title != `` && data.Title != existing.Data.Title ||
+			data.Description != `` && data.Description != pointer.GetString(existing.Data.Description) ||
+			labelsChanged(data.Labels, existing.Data.Labels) {
+			change.Action = Update
+		}
+
+		terms, err := s.termService.Get(ctx, &model.TermFilter{VocabularyID: []uint64{existing.ID}})
+		if err != nil {
+			return fmt.Errorf(`get terms of vocabulary %s: %w`, JoinPath(path), err)
+		}
+
+		plan.existingTerms[key(path)] = lo.KeyBy(terms, func(term *model.Term) string { return term.Data.Name })
+	}
+
+	plan.Changes = append(plan.Changes, change)
+	plan.vocabularies = append(plan.vocabularies, &plannedVocabulary{change: change, data: data})
+
+	return nil
+}
+
+// planTerms plans terms of the vocabulary. Broader terms should be listed in the vocabulary or exist in it.
+func (p *Plan) planTerms(v *Vocabulary, seen map[string]map[string]struct{}) error {
+	var (
+		path     = v.Path()
+		existing = p.existingTerms[key(path)]
+		names    = make(map[string]*Term, len(v.Terms))
+	)
+
+	if seen[key(path)] == nil {
+		seen[key(path)] = make(map[string]struct{})
+	}
+
+	for _, term := range v.Terms {
+		if _, ok := seen[key(path)][term.Name]; ok {
+			return fmt.Errorf(`%w: %s in %s`, ErrDuplicateTerm, term.Name, JoinPath(path))
+		}
+
+		seen[key(path)][term.Name] = struct{}{}
+		names[term.Name] = term
+	}
+
+	for _, term := range v.Terms {
+		for _, broader := range term.Broader {
+			if _, ok := names[broader]; ok {
+				continue
+			}
+
+			if _, ok := existing[broader]; !ok {
+				return fmt.Errorf(`%w: %s of %s in %s`, ErrUnknownBroader, broader, term.Name, JoinPath(path))
+			}
+		}
+	}
+
+	var existingNames = termNames(existing)
+
+	if err := checkCycles(path, names, existing, existingNames); err != nil {
+		return err
+	}
+
+	for _, term := range v.Terms {
+		var change = &Change{Action: Create, Vocabulary: path, Term: term.Name}
+
+		if current, ok := existing[term.Name]; ok {
+			change.Action = Skip
+			if term.Title != `` && term.Title != current.Data.Title ||
+				term.Description != `` && term.Description != current.Data.Description ||
+				labelsChanged(term.Labels, current.Data.Labels) ||
+				len(term.Broader) > 0 && !sameNames(term.Broader, broaderNames(current, existingNames)) {
+				change.Action = Update
+			}
+		}
+
+		p.Changes = append(p.Changes, change)
+		p.terms = append(p.terms, &plannedTerm{change: change, data: term})
+	}
+
+	return nil
+}
+
+// broaderNames returns names of broader terms of the term in the same vocabulary, names contains names of the
+// vocabulary's terms by their ids.
+func broaderNames(term *model.Term, names map[uint64]string) []string {
+	var broader = make([]string, 0, len(term.Data.SuperID))
+
+	for _, id := range term.Data.SuperID {
+		if name, ok := names[id]; ok {
+			broader = append(broader, name)
+		}
+	}
+
+	return broader
+}
+
+// termNames returns names of terms by their ids.
+func termNames(terms map[string]*model.Term) map[uint64]string {
+	var names = make(map[uint64]string, len(terms))
+	for name, term := range terms {
+		names[term.ID] = name
+	}
+
+	return names
+}
+
+func sameNames(a, b []string) bool {
+	a, b = lo.Uniq(a), lo.Uniq(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
+
+// checkCycles checks that imported broader terms and broader terms of existing terms which aren't imported don't make
+// a cycle.
+func checkCycles(path []string, imported map[string]*Term, existing map[string]*model.Term,
+	existingNames map[uint64]string,
+) error {
+	var (
+		broader  = make(map[string][]string, len(imported)+len(existing))
+		visiting = make(map[string]bool)
+		visited  = make(map[string]bool)
+		visit    func(name string, chain []string) error
+	)
+
+	for name, term := range existing {
+		broader[name] = broaderNames(term, existingNames)
+	}
+
+	for name, term := range imported {
+		if len(term.Broader) > 0 {
+			broader[name] = term.Broader
+		}
+	}
+
+	visit = func(name string, chain []string) error {
+		if visiting[name] {
+			return fmt.Errorf(`%w: %s in %s`, taxonomy.ErrHierarchyCycle,
+				strings.Join(append(chain[slices.Index(chain, name):], name), ` -> `), JoinPath(path))
+		}
+
+		if visited[name] {
+			return nil
+		}
+
+		visiting[name] = true
+
+		for _, b := range broader[name] {
+			if err := visit(b, append(chain, name)); err != nil {
+				return err
+			}
+		}
+
+		visiting[name], visited[name] = false, true
+
+		return nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(broader)) {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply makes changes of the plan in one transaction.
+func (s *Service) Apply(ctx context.Context, plan *Plan) error {
+	return s.transaction.Run(ctx, func(ctx context.Context) error {
+		return s.apply(ctx, plan)
+	})
+}
+
+func (s *Service) apply(ctx context.Context, plan *Plan) error {
+	for _, ns := range plan.namespaces {
+		if ns.Action != Create {
+			continue
+		}
+
+		if _, err := s.namespaceService.Create(ctx, ns.Namespace); err != nil {
+			return fmt.Errorf(`create namespace %s: %w`, ns.Namespace, err)
+		}
+	}
+
+	var vocabularies = make(map[string]uint64, len(plan.existing))
+	for k, v := range plan.existing {
+		vocabularies[k] = v.ID
+	}
+
+	for _, v := range plan.vocabularies {
+		var data = &model.VocabularyData{Name: v.data.Name, Title: v.data.Title}
+		if existing, ok := plan.existing[key(v.change.Vocabulary)]; ok {
+			data.Labels = keepLabels(v.data.Labels, existing.Data.Labels)
+		} else {
+			data.Labels = keepLabels(v.data.Labels, nil)
+		}
+
+		if v.data.Description != `` {
+			data.Description = pointer.ToString(v.data.Description)
+		}
+
+		switch v.change.Action {
+		case Create:
+			if len(v.data.Parent) > 0 {
+				data.ParentID = pointer.ToUint64(vocabularies[key(v.data.Parent)])
+			}
+
+			created, err := s.vocabularyService.Create(ctx, data)
+			if err != nil {
+				return fmt.Errorf(`create vocabulary %s: %w`, JoinPath(v.change.Vocabulary), err)
+			}
+
+			vocabularies[key(v.change.Vocabulary)] = created.ID
+		case Update:
+			if _, err := s.vocabularyService.Update(ctx, vocabularies[key(v.change.Vocabulary)], data); err != nil {
+				return fmt.Errorf(`update vocabulary %s: %w`, JoinPath(v.change.Vocabulary), err)
+			}
+		case Skip:
+		}
+	}
+
+	terms, err := s.createTerms(ctx, plan, vocabularies)
+	if err != nil {
+		return err
+	}
+
+	if err := s.updateTerms(ctx, plan, terms); err != nil {
+		return err
+	}
+
+	return s.delete(ctx, plan)
+}
+
+// createTerms creates new terms without links, because their broader terms could be created in the same bulk. It
+// returns ids of existing and created terms by vocabulary's key and term's name.
+func (s *Service) createTerms(ctx context.Context, plan *Plan, vocabularies map[string]uint64,
+) (map[string]map[string]uint64, error) {
+	var terms = make(map[string]map[string]uint64)
+
+	for k, existing := range plan.existingTerms {
+		terms[k] = make(map[string]uint64, len(existing))
+		for name, term := range existing {
+			terms[k][name] = term.ID
+		}
+	}
+
+	var (
+		created []*plannedTerm
+		data    []*model.TermData
+	)
+
+	for _, t := range plan.terms {
+		if t.change.Action == Create {
+			created = append(created, t)
+			data = append(data, &model.TermData{
+				Name:         t.data.Name,
+				Title:        t.data.Title,
+				Description:  t.data.Description,
+				Labels:       keepLabels(t.data.Labels, nil),
+				VocabularyID: []uint64{vocabularies[key(t.change.Vocabulary)]},
+			})
+		}
+	}
+
+	if len(data) == 0 {
+		return terms, nil
+	}
+
+	result, err := s.termService.CreateBulk(ctx, data...)
+	if err != nil {
+		return nil, bulkErr(`create`, created, err)
+	}
+
+	for i, term := range result {
+		k := key(created[i].change.Vocabulary)
+		if terms[k] == nil {
+			terms[k] = make(map[string]uint64)
+		}
+
+		terms[k][term.Data.Name] = term.ID
+	}
+
+	return terms, nil
+}
+
+// updateTerms updates changed terms and links new terms with their broader ones. Imported broader terms replace
+// broader terms of the same vocabulary, broader terms from other vocabularies are kept.
+func (s *Service) updateTerms(ctx context.Context, plan *Plan, terms map[string]map[string]uint64) error {
+	var (
+		updated []*plannedTerm
+		updates []*model.Term
+		// ids of terms by vocabulary's key
+		own = make(map[string]map[uint64]struct{}, len(terms))
+	)
+
+	for k, names := range terms {
+		own[k] = make(map[uint64]struct{}, len(names))
+		for _, id := range names {
+			own[k][id] = struct{}{}
+		}
+	}
+
+	for _, t := range plan.terms {
+		if t.change.Action == Skip || t.change.Action == Create && len(t.data.Broader) == 0 {
+			continue
+		}
+
+		var (
+			k      = key(t.change.Vocabulary)
+			update = &model.Term{ID: terms[k][t.data.Name]}
+		)
+
+		if t.change.Action == Update {
+			update.Data.Title = t.data.Title
+			update.Data.Description = t.data.Description
+			update.Data.Labels = keepLabels(t.data.Labels, plan.existingTerms[k][t.data.Name].Data.Labels)
+		}
+
+		if len(t.data.Broader) > 0 {
+			update.Data.SuperID = make([]uint64, 0, len(t.data.Broader))
+			for _, name := range lo.Uniq(t.data.Broader) {
+				update.Data.SuperID = append(update.Data.SuperID, terms[k][name])
+			}
+
+			if current, ok := plan.existingTerms[k][t.data.Name]; ok {
+				for _, id := range current.Data.SuperID {
+					if _, ok := own[k][id]; !ok {
+						update.Data.SuperID = append(update.Data.SuperID, id)
+					}
+				}
+			}
+		}
+
+		updated = append(updated, t)
+		updates = append(updates, update)
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	if _, err := s.termService.UpdateBulk(ctx, updates...); err != nil {
+		return bulkErr(`update`, updated, err)
+	}
+
+	return nil
+}
+
+// bulkErr replaces indexes of failed terms by their vocabularies and names.
+func bulkErr(action string, terms []*plannedTerm, err error) error {
+	var bulk *taxonomy.BulkError
+	if !errors.As(err, &bulk) {
+		return fmt.Errorf(`%s terms: %w`, action, err)
+	}
+
+	var errs = make([]error, 0, len(bulk.Errors))
+	for _, i := range bulk.Indexes() {
+		errs = append(errs, fmt.Errorf(`%s term %s in %s: %w`, action, terms[i].data.Name,
+			JoinPath(terms[i].change.Vocabulary), bulk.Errors[i]))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,178 @@
+package exchange_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// catalog mocks vocabularies catalog and catalog/colors, the last one has terms red and green which is narrower
+// than red.
+func catalog(ctx context.Context) (taxonomy.Vocabulary, taxonomy.Term) {
+	vocabularyService := mock.Mock[taxonomy.Vocabulary]()
+	mock.When(vocabularyService.Get(mock.Exact[context.Context](ctx), mock.Any[*model.VocabularyFilter]())).
+		ThenReturn([]*model.Vocabulary{
+			{ID: 2, Data: model.VocabularyData{Name: `colors`, ParentID: pointer.ToUint64(1)}},
+			{ID: 1, Data: model.VocabularyData{Name: `catalog`}},
+		}, nil)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.Get(mock.Exact[context.Context](ctx), mock.Any[*model.TermFilter]())).
+		ThenAnswer(func(args []any) []any {
+			if slices.Contains(args[1].(*model.TermFilter).VocabularyID, 2) {
+				return []any{[]*model.Term{
+					{ID: 10, Data: model.TermData{Name: `red`, Title: `Red`, VocabularyID: []uint64{2},
+						Labels: model.Labels{`de`: {Title: `Rot`}}}},
+					{ID: 11, Data: model.TermData{Name: `green`, VocabularyID: []uint64{2}, SuperID: []uint64{10, 99},
+						Labels: model.Labels{`de`: {Title: `Grün`}}}},
+				}, nil}
+			}
+
+			return []any{[]*model.Term{}, nil}
+		})
+
+	return vocabularyService, termService
+}
+
+func TestService_Plan(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	vocabularyService, termService := catalog(ctx)
+	s := exchange.New(&exchange.Config{
+		VocabularyService: vocabularyService,
+		TermService:       termService,
+		Logger:            zap.NewNop(),
+	})
+
+	plan, err := s.Plan(ctx, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Parent: []string{`catalog`}, Name: `colors`, Terms: []*exchange.Term{
+			{Name: `red`, Title: `Red`, Labels: model.Labels{`de`: {Title: `Rot`}}},
+			{Name: `green`, Title: `Green`, Labels: model.Labels{`de`: {Description: `Farbe`}}},
+			{Name: `crimson`, Broader: []string{`red`}},
+		}},
+		{Parent: []string{`catalog`, `clothes`}, Name: `sizes`, Terms: []*exchange.Term{
+			{Name: `xl`, Labels: model.Labels{`fr`: {Title: `TG`}}},
+		}},
+	}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*exchange.Change{
+		{Action: exchange.Skip, Vocabulary: []string{`catalog`}},
+		{Action: exchange.Skip, Vocabulary: []string{`catalog`, `colors`}},
+		{Action: exchange.Create, Vocabulary: []string{`catalog`, `clothes`}},
+		{Action: exchange.Create, Vocabulary: []string{`catalog`, `clothes`, `sizes`}},
+		{Action: exchange.Skip, Vocabulary: []string{`catalog`, `colors`}, Term: `red`},
+		{Action: exchange.Update, Vocabulary: []string{`catalog`, `colors`}, Term: `green`},
+		{Action: exchange.Create, Vocabulary: []string{`catalog`, `colors`}, Term: `crimson`},
+		{Action: exchange.Create, Vocabulary: []string{`catalog`, `clothes`, `sizes`}, Term: `xl`},
+	}, plan.Changes)
+
+	mock.When(vocabularyService.Create(mock.Exact[context.Context](ctx), mock.Any[*model.VocabularyData]())).
+		ThenAnswer(func(args []any) []any {
+			data := args[1].(*model.VocabularyData)
+			if data.Name == `clothes` {
+				assert.Equal(t, pointer.ToUint64(1), data.ParentID)
+
+				return []any{&model.Vocabulary{ID: 3, Data: *data}, nil}
+			}
+
+			assert.Equal(t, pointer.ToUint64(3), data.ParentID)
+
+			return []any{&model.Vocabulary{ID: 4, Data: *data}, nil}
+		})
+
+	var created []*model.TermData
+
+	mock.When(termService.CreateBulk(mock.Exact[context.Context](ctx), mock.Any[[]*model.TermData]()...)).
+		ThenAnswer(func(args []any) []any {
+			created = args[1].([]*model.TermData)
+
+			return []any{[]*model.Term{
+				{ID: 12, Data: *created[0]},
+				{ID: 13, Data: *created[1]},
+			}, nil}
+		})
+
+	var updated []*model.Term
+
+	mock.When(termService.UpdateBulk(mock.Exact[context.Context](ctx), mock.Any[[]*model.Term]()...)).
+		ThenAnswer(func(args []any) []any {
+			updated = args[1].([]*model.Term)
+
+			return []any{updated, nil}
+		})
+
+	require.NoError(t, s.Apply(ctx, plan))
+
+	assert.Equal(t, []*model.TermData{
+		{Name: `crimson`, VocabularyID: []uint64{2}},
+		{Name: `xl`, Labels: model.Labels{`fr`: {Title: `TG`}}, VocabularyID: []uint64{4}},
+	}, created)
+
+	// Green keeps its broader terms, because none of them are imported, and its translated title
+	assert.Equal(t, []*model.Term{
+		{ID: 11, Data: model.TermData{Title: `Green`, Labels: model.Labels{`de`: {Title: `Grün`, Description: `Farbe`}}}},
+		{ID: 12, Data: model.TermData{SuperID: []uint64{10}}},
+	}, updated)
+}
+
+func TestService_PlanErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		terms []*exchange.Term
+		err   error
+	}{
+		{
+			name:  `unknown broader`,
+			terms: []*exchange.Term{{Name: `crimson`, Broader: []string{`scarlet`}}},
+			err:   exchange.ErrUnknownBroader,
+		},
+		{
+			name:  `repeated term`,
+			terms: []*exchange.Term{{Name: `blue`}, {Name: `blue`}},
+			err:   exchange.ErrDuplicateTerm,
+		},
+		{
+			name:  `cycle with existing terms`,
+			terms: []*exchange.Term{{Name: `red`, Broader: []string{`green`}}},
+			err:   taxonomy.ErrHierarchyCycle,
+		},
+		{
+			name: `cycle of imported terms`,
+			terms: []*exchange.Term{
+				{Name: `crimson`, Broader: []string{`scarlet`}},
+				{Name: `scarlet`, Broader: []string{`crimson`}},
+			},
+			err: taxonomy.ErrHierarchyCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			var ctx = context.Background()
+
+			vocabularyService, termService := catalog(ctx)
+			_, err := exchange.New(&exchange.Config{
+				VocabularyService: vocabularyService,
+				TermService:       termService,
+				Logger:            zap.NewNop(),
+			}).Plan(ctx, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+				{Parent: []string{`catalog`}, Name: `colors`, Terms: tt.terms},
+			}})
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
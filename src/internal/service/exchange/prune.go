@@ -0,0 +1,217 @@
+package exchange
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// Prune adds deletions of existing namespaces, vocabularies and terms which aren't in the plan to the plan. It
+// refuses to delete terms and namespaces with references and vocabularies with kept terms, all of them are reported
+// in one error. Terms are deleted before their vocabularies, children vocabularies before their parents.
+func (s *Service) Prune(ctx context.Context, plan *Plan) error {
+	logger := s.log.With(zap.String(`method`, `Prune`))
+
+	var (
+		errs []error
+		// count of kept terms by vocabulary's key
+		remaining = make(map[string]int)
+	)
+
+	blocked, err := s.pruneTerms(ctx, plan, remaining)
+	if err != nil {
+		return err
+	}
+
+	errs = append(errs, blocked...)
+	errs = append(errs, plan.pruneVocabularies(remaining)...)
+
+	blocked, err = s.pruneNamespaces(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	errs = append(errs, blocked...)
+
+	logger.Debug(`plan pruned`, zap.Int(`deletions`, len(plan.deletions)), zap.Int(`blocked`, len(errs)))
+
+	return errors.Join(errs...)
+}
+
+func (p *Plan) addDeletion(change *Change, id uint64) {
+	p.Changes = append(p.Changes, change)
+	p.deletions = append(p.deletions, &deletion{change: change, id: id})
+}
+
+// pruneTerms plans deletion of terms which aren't listed in any of their vocabularies. Kept terms are counted in
+// remaining, errors of terms with references are returned.
+func (s *Service) pruneTerms(ctx context.Context, plan *Plan, remaining map[string]int) ([]error, error) {
+	var (
+		errs   []error
+		kept   = make(map[uint64]struct{})
+		pruned = make(map[uint64]struct{})
+	)
+
+	for _, t := range plan.terms {
+		if term, ok := plan.existingTerms[key(t.change.Vocabulary)][t.data.Name]; ok {
+			kept[term.ID] = struct{}{}
+		}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(plan.existing)) {
+		path := splitKey(k)
+
+		terms, ok := plan.existingTerms[k]
+		if !ok {
+			list, err := s.termService.Get(ctx, &model.TermFilter{VocabularyID: []uint64{plan.existing[k].ID}})
+			if err != nil {
+				return nil, fmt.Errorf(`get terms of vocabulary %s: %w`, JoinPath(path), err)
+			}
+
+			terms = lo.KeyBy(list, func(term *model.Term) string { return term.Data.Name })
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(terms)) {
+			var term = terms[name]
+
+			if _, ok := kept[term.ID]; ok {
+				remaining[k]++
+
+				continue
+			}
+
+			// Term of several vocabularies is deleted once
+			if _, ok := pruned[term.ID]; ok {
+				continue
+			}
+
+			count, err := s.referenceService.Count(ctx, &model.ReferenceFilter{TermID: [][]uint64{{term.ID}}})
+			if err != nil {
+				return nil, fmt.Errorf(`count references of term %s in %s: %w`, name, JoinPath(path), err)
+			}
+
+			if count > 0 {
+				kept[term.ID] = struct{}{}
+				remaining[k]++
+				errs = append(errs, fmt.Errorf(`can't prune term %s in %s: %w`, name, JoinPath(path),
+					&taxonomy.BlockedError{Err: taxonomy.ErrReferenceExists, Count: count}))
+
+				continue
+			}
+
+			pruned[term.ID] = struct{}{}
+			plan.addDeletion(&Change{Action: Delete, Vocabulary: path, Term: name}, term.ID)
+		}
+	}
+
+	return errs, nil
+}
+
+// pruneVocabularies plans deletion of vocabularies which aren't planned, the deepest ones go first. Vocabularies with
+// remaining terms are reported, their parents are kept silently.
+func (p *Plan) pruneVocabularies(remaining map[string]int) []error {
+	var (
+		errs   []error
+		kept   = make(map[string]struct{}, len(p.vocabularies))
+		pruned []string
+	)
+
+	for _, v := range p.vocabularies {
+		kept[key(v.change.Vocabulary)] = struct{}{}
+	}
+
+	for k := range p.existing {
+		if _, ok := kept[k]; !ok {
+			pruned = append(pruned, k)
+		}
+	}
+
+	slices.SortFunc(pruned, func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(splitKey(b)), len(splitKey(a))), cmp.Compare(a, b))
+	})
+
+	var blocked = make(map[string]struct{})
+
+	for _, k := range pruned {
+		path := splitKey(k)
+
+		if _, ok := blocked[k]; ok || remaining[k] > 0 {
+			if !ok {
+				errs = append(errs, fmt.Errorf(`can't prune vocabulary %s: %w`, JoinPath(path),
+					&taxonomy.BlockedError{Err: taxonomy.ErrVocabularyHasTerms, Count: uint64(remaining[k])}))
+			}
+
+			if len(path) > 1 {
+				blocked[key(path[:len(path)-1])] = struct{}{}
+			}
+
+			continue
+		}
+
+		p.addDeletion(&Change{Action: Delete, Vocabulary: path}, p.existing[k].ID)
+	}
+
+	return errs
+}
+
+// pruneNamespaces plans deletion of namespaces which aren't listed, errors of namespaces with references are returned.
+func (s *Service) pruneNamespaces(ctx context.Context, plan *Plan) ([]error, error) {
+	namespaces, err := s.namespaceService.Get(ctx, 0, nil)
+	if err != nil {
+		return nil, fmt.Errorf(`get namespaces: %w`, err)
+	}
+
+	var errs []error
+
+	for _, ns := range namespaces {
+		if slices.Contains(plan.listed, ns.Data.Name) {
+			continue
+		}
+
+		count, err := s.referenceService.Count(ctx, &model.ReferenceFilter{Namespace: []string{ns.Data.Name}})
+		if err != nil {
+			return nil, fmt.Errorf(`count references of namespace %s: %w`, ns.Data.Name, err)
+		}
+
+		if count > 0 {
+			errs = append(errs, fmt.Errorf(`can't prune namespace %s: %w`, ns.Data.Name,
+				&taxonomy.BlockedError{Err: taxonomy.ErrReferenceExists, Count: count}))
+
+			continue
+		}
+
+		plan.addDeletion(&Change{Action: Delete, Namespace: ns.Data.Name}, ns.ID)
+	}
+
+	return errs, nil
+}
+
+// delete deletes pruned objects in planned order.
+func (s *Service) delete(ctx context.Context, plan *Plan) error {
+	for _, d := range plan.deletions {
+		var err error
+
+		switch {
+		case d.change.Namespace != ``:
+			err = s.namespaceService.Delete(ctx, d.id)
+		case d.change.Term != ``:
+			err = s.termService.Delete(ctx, d.id)
+		default:
+			err = s.vocabularyService.Delete(ctx, d.id)
+		}
+
+		if err != nil {
+			return fmt.Errorf(`delete %s: %w`, d.change, err)
+		}
+	}
+
+	return nil
+}
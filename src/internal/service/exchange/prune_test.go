@@ -0,0 +1,116 @@
+package exchange_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// pruned returns service with catalog, namespaces products and articles, counts contains count of references by
+// ids of terms and names of namespaces.
+func pruned(ctx context.Context, counts map[any]uint64) (*exchange.Service, taxonomy.Namespace, taxonomy.Term) {
+	vocabularyService, termService := catalog(ctx)
+
+	namespaceService := mock.Mock[taxonomy.Namespace]()
+	mock.When(namespaceService.Get(mock.Exact[context.Context](ctx), mock.Exact[uint](0), mock.Any[*uint64]())).
+		ThenReturn([]*model.Namespace{
+			{ID: 1, Data: model.NamespaceData{Name: `products`}},
+			{ID: 2, Data: model.NamespaceData{Name: `articles`}},
+		}, nil)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Count(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
+		ThenAnswer(func(args []any) []any {
+			filter := args[1].(*model.ReferenceFilter)
+			if len(filter.Namespace) > 0 {
+				return []any{counts[filter.Namespace[0]], nil}
+			}
+
+			return []any{counts[filter.TermID[0][0]], nil}
+		})
+
+	return exchange.New(&exchange.Config{
+		NamespaceService:  namespaceService,
+		VocabularyService: vocabularyService,
+		TermService:       termService,
+		ReferenceService:  referenceService,
+		Logger:            zap.NewNop(),
+	}), namespaceService, termService
+}
+
+func TestService_Prune(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	s, namespaceService, termService := pruned(ctx, map[any]uint64{})
+
+	plan, err := s.Plan(ctx, &exchange.Taxonomy{
+		Namespaces: []string{`products`, `reviews`},
+		Vocabularies: []*exchange.Vocabulary{
+			{Parent: []string{`catalog`}, Name: `colors`, Terms: []*exchange.Term{{Name: `red`}}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Prune(ctx, plan))
+
+	assert.Equal(t, []*exchange.Change{
+		{Action: exchange.Skip, Namespace: `products`},
+		{Action: exchange.Create, Namespace: `reviews`},
+		{Action: exchange.Skip, Vocabulary: []string{`catalog`}},
+		{Action: exchange.Skip, Vocabulary: []string{`catalog`, `colors`}},
+		{Action: exchange.Skip, Vocabulary: []string{`catalog`, `colors`}, Term: `red`},
+		{Action: exchange.Delete, Vocabulary: []string{`catalog`, `colors`}, Term: `green`},
+		{Action: exchange.Delete, Namespace: `articles`},
+	}, plan.Changes)
+
+	var deleted []uint64
+
+	mock.When(namespaceService.Create(mock.Exact[context.Context](ctx), mock.Exact(`reviews`))).
+		ThenReturn(&model.Namespace{ID: 3}, nil)
+	mock.When(termService.Delete(mock.Exact[context.Context](ctx), mock.Any[uint64]())).
+		ThenAnswer(func(args []any) []any {
+			deleted = append(deleted, args[1].(uint64))
+
+			return []any{nil}
+		})
+	mock.When(namespaceService.Delete(mock.Exact[context.Context](ctx), mock.Exact[uint64](2))).ThenReturn(nil)
+
+	require.NoError(t, s.Apply(ctx, plan))
+	assert.Equal(t, []uint64{11}, deleted)
+	mock.Verify(namespaceService, mock.Once()).Delete(mock.Exact[context.Context](ctx), mock.Exact[uint64](2))
+}
+
+func TestService_PruneBlocked(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	s, _, _ := pruned(ctx, map[any]uint64{uint64(10): 3, `articles`: 1})
+
+	plan, err := s.Plan(ctx, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{{Name: `catalog`}}})
+	require.NoError(t, err)
+
+	err = s.Prune(ctx, plan)
+	assert.ErrorIs(t, err, taxonomy.ErrReferenceExists)
+	assert.ErrorIs(t, err, taxonomy.ErrVocabularyHasTerms)
+	assert.ErrorContains(t, err, `can't prune term red in catalog/colors: references exists: 3`)
+	assert.ErrorContains(t, err, `can't prune vocabulary catalog/colors: vocabulary has terms, but should be empty: 1`)
+	assert.ErrorContains(t, err, `can't prune namespace articles: references exists: 1`)
+
+	// Objects which aren't blocked are deleted anyway
+	assert.True(t, slices.ContainsFunc(plan.Changes, func(change *exchange.Change) bool {
+		return change.Action == exchange.Delete && change.Term == `green`
+	}))
+	assert.True(t, slices.ContainsFunc(plan.Changes, func(change *exchange.Change) bool {
+		return change.Action == exchange.Delete && change.Namespace == `products`
+	}))
+}
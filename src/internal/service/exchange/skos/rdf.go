@@ -0,0 +1,150 @@
+package skos
+
+import (
+	"strings"
+)
+
+const (
+	rdfNS  = `http://www.w3.org/1999/02/22-rdf-syntax-ns#`
+	skosNS = `http://www.w3.org/2004/02/skos/core#`
+	dctNS  = `http://purl.org/dc/terms/`
+
+	rdfType = rdfNS + `type`
+	xsdNS   = `http://www.w3.org/2001/XMLSchema#`
+)
+
+// prefixes are used for writing, predicates and types of written triples belong to these namespaces.
+var prefixes = []struct {
+	prefix    string
+	namespace string
+}{
+	{`rdf`, rdfNS},
+	{`skos`, skosNS},
+	{`dct`, dctNS},
+}
+
+// node is an IRI, a blank node or a literal.
+type node struct {
+	value    string
+	blank    bool
+	literal  bool
+	language string
+	datatype string
+}
+
+func iri(value string) node {
+	return node{value: value}
+}
+
+func literal(value string) node {
+	return node{value: value, literal: true}
+}
+
+func (n node) resource() bool {
+	return !n.literal
+}
+
+type triple struct {
+	subject   node
+	predicate string
+	object    node
+}
+
+// graph indexes triples by subjects keeping order of objects.
+type graph struct {
+	subjects   []node
+	properties map[node]map[string][]node
+}
+
+func newGraph(triples []triple) *graph {
+	var g = &graph{properties: make(map[node]map[string][]node)}
+
+	for _, t := range triples {
+		properties, ok := g.properties[t.subject]
+		if !ok {
+			properties = make(map[string][]node)
+			g.properties[t.subject] = properties
+			g.subjects = append(g.subjects, t.subject)
+		}
+
+		properties[t.predicate] = append(properties[t.predicate], t.object)
+	}
+
+	return g
+}
+
+// objects returns objects of the subject's predicate.
+func (g *graph) objects(subject node, predicate string) []node {
+	return g.properties[subject][predicate]
+}
+
+// typed returns subjects of the type in order of appearance.
+func (g *graph) typed(typ string) []node {
+	var found []node
+
+	for _, subject := range g.subjects {
+		for _, t := range g.objects(subject, rdfType) {
+			if t == iri(typ) {
+				found = append(found, subject)
+
+				break
+			}
+		}
+	}
+
+	return found
+}
+
+// text returns value of the literal without language or the first literal if all of them have a language.
+func (g *graph) text(subject node, predicates ...string) string {
+	for _, predicate := range predicates {
+		var first *node
+
+		for _, object := range g.objects(subject, predicate) {
+			if !object.literal {
+				continue
+			}
+
+			if object.language == `` {
+				return object.value
+			}
+
+			if first == nil {
+				first = &object
+			}
+		}
+
+		if first != nil {
+			return first.value
+		}
+	}
+
+	return ``
+}
+
+// translations returns values of literals with language of the first predicate which has them, the first literal of
+// every language is used.
+func (g *graph) translations(subject node, predicates ...string) map[string]string {
+	for _, predicate := range predicates {
+		var found = make(map[string]string)
+
+		for _, object := range g.objects(subject, predicate) {
+			if _, ok := found[object.language]; object.literal && object.language != `` && !ok {
+				found[object.language] = object.value
+			}
+		}
+
+		if len(found) > 0 {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// split returns namespace and local name of IRI, namespace ends with '#' or '/'.
+func split(value string) (string, string) {
+	i := strings.LastIndexAny(value, `#/`)
+
+	return value[:i+1], value[i+1:]
+}
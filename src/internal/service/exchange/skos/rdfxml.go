@@ -0,0 +1,369 @@
+package skos
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// writeRDFXML writes triples of every subject as a typed node element when the subject has a type.
+func writeRDFXML(w io.Writer, triples []triple) error {
+	writer := bufio.NewWriter(w)
+
+	writer.WriteString(xml.Header + `<rdf:RDF`)
+
+	for _, p := range prefixes {
+		fmt.Fprintf(writer, "\n    xmlns:%s=\"%s\"", p.prefix, p.namespace)
+	}
+
+	writer.WriteString(">\n")
+
+	for start := 0; start < len(triples); {
+		end := start
+		for end < len(triples) && triples[end].subject == triples[start].subject {
+			end++
+		}
+
+		if err := writeDescription(writer, triples[start:end]); err != nil {
+			return err
+		}
+
+		start = end
+	}
+
+	writer.WriteString("</rdf:RDF>\n")
+
+	return writer.Flush() //nolint:wrapcheck
+}
+
+// writeDescription writes triples of one subject.
+func writeDescription(w *bufio.Writer, triples []triple) error {
+	var (
+		subject = triples[0].subject
+		element = `rdf:Description`
+	)
+
+	if triples[0].predicate == rdfType && triples[0].object.resource() {
+		if name, ok := qname(triples[0].object.value); ok {
+			element = name
+			triples = triples[1:]
+		}
+	}
+
+	w.WriteString(`  <` + element)
+
+	if subject.blank {
+		w.WriteString(` rdf:nodeID="` + escapeXML(subject.value) + `"`)
+	} else {
+		w.WriteString(` rdf:about="` + escapeXML(subject.value) + `"`)
+	}
+
+	w.WriteString(">\n")
+
+	for _, t := range triples {
+		name, ok := qname(t.predicate)
+		if !ok {
+			return fmt.Errorf(`predicate %s has unknown namespace`, t.predicate)
+		}
+
+		w.WriteString(`    <` + name)
+
+		switch {
+		case t.object.blank:
+			w.WriteString(` rdf:nodeID="` + escapeXML(t.object.value) + "\"/>\n")
+		case !t.object.literal:
+			w.WriteString(` rdf:resource="` + escapeXML(t.object.value) + "\"/>\n")
+		default:
+			if t.object.language != `` {
+				w.WriteString(` xml:lang="` + escapeXML(t.object.language) + `"`)
+			} else if t.object.datatype != `` {
+				w.WriteString(` rdf:datatype="` + escapeXML(t.object.datatype) + `"`)
+			}
+
+			w.WriteString(`>` + escapeXML(t.object.value) + `</` + name + ">\n")
+		}
+	}
+
+	w.WriteString(`  </` + element + ">\n")
+
+	return nil
+}
+
+// qname returns qualified name of IRI from known namespace.
+func qname(value string) (string, bool) {
+	namespace, local := split(value)
+
+	for _, p := range prefixes {
+		if p.namespace == namespace && local != `` {
+			return p.prefix + `:` + local, true
+		}
+	}
+
+	return ``, false
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
+
+// readRDFXML reads triples of RDF/XML document. Node elements could be nested into property elements, property
+// attributes and rdf:parseType="Resource" are supported, collections and reification aren't.
+func readRDFXML(r io.Reader) ([]triple, error) {
+	var p = &xmlParser{decoder: xml.NewDecoder(r)}
+
+	for {
+		token, err := p.decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return p.triples, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf(`%w: %s`, ErrSyntax, err.Error())
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			var s = p.scope(scope{}, start)
+
+			if start.Name.Space+start.Name.Local == rdfNS+`RDF` {
+				err = p.nodes(s)
+			} else {
+				_, err = p.node(s, start)
+			}
+
+			if err != nil {
+				line, _ := p.decoder.InputPos()
+
+				return nil, fmt.Errorf(`line %d: %w`, line, err)
+			}
+		}
+	}
+}
+
+type xmlParser struct {
+	decoder *xml.Decoder
+	blanks  int
+	triples []triple
+}
+
+// scope contains inherited xml:base and xml:lang.
+type scope struct {
+	base     *url.URL
+	language string
+}
+
+func (p *xmlParser) scope(parent scope, start xml.StartElement) scope {
+	var s = parent
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space != `xml` && attr.Name.Space != `http://www.w3.org/XML/1998/namespace` {
+			continue
+		}
+
+		switch attr.Name.Local {
+		case `lang`:
+			s.language = strings.ToLower(attr.Value)
+		case `base`:
+			if base, err := url.Parse(attr.Value); err == nil {
+				s.base = base
+			}
+		}
+	}
+
+	return s
+}
+
+func (s scope) resolve(value string) string {
+	if s.base == nil {
+		return value
+	}
+
+	ref, err := url.Parse(value)
+	if err != nil || ref.IsAbs() {
+		return value
+	}
+
+	return s.base.ResolveReference(ref).String()
+}
+
+// nodes reads node elements until the end of the parent element.
+func (p *xmlParser) nodes(s scope) error {
+	for {
+		token, err := p.decoder.Token()
+		if err != nil {
+			return fmt.Errorf(`%w: %s`, ErrSyntax, err.Error())
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if _, err := p.node(p.scope(s, token), token); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func (p *xmlParser) blank() node {
+	p.blanks++
+
+	// Generated labels have '#' which isn't allowed in rdf:nodeID
+	return node{value: `#` + strconv.Itoa(p.blanks), blank: true}
+}
+
+// node reads node element with its properties and returns its subject.
+func (p *xmlParser) node(s scope, start xml.StartElement) (node, error) {
+	var subject *node
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space != rdfNS {
+			continue
+		}
+
+		switch attr.Name.Local {
+		case `about`:
+			subject = &node{value: s.resolve(attr.Value)}
+		case `ID`:
+			subject = &node{value: s.resolve(`#` + attr.Value)}
+		case `nodeID`:
+			subject = &node{value: attr.Value, blank: true}
+		}
+	}
+
+	if subject == nil {
+		blank := p.blank()
+		subject = &blank
+	}
+
+	if name := start.Name.Space + start.Name.Local; name != rdfNS+`Description` {
+		p.triples = append(p.triples, triple{subject: *subject, predicate: rdfType, object: iri(name)})
+	}
+
+	p.attributes(s, *subject, start)
+
+	return *subject, p.properties(s, *subject)
+}
+
+// attributes adds property attributes of the element as literals.
+func (p *xmlParser) attributes(s scope, subject node, start xml.StartElement) {
+	for _, attr := range start.Attr {
+		switch attr.Name.Space {
+		case ``, `xmlns`, `xml`, `http://www.w3.org/XML/1998/namespace`, rdfNS:
+			continue
+		}
+
+		p.triples = append(p.triples, triple{
+			subject:   subject,
+			predicate: attr.Name.Space + attr.Name.Local,
+			object:    node{value: attr.Value, literal: true, language: s.language},
+		})
+	}
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space == rdfNS && attr.Name.Local == `type` {
+			p.triples = append(p.triples, triple{subject: subject, predicate: rdfType, object: iri(s.resolve(attr.Value))})
+		}
+	}
+}
+
+// properties reads property elements until the end of the node element.
+func (p *xmlParser) properties(s scope, subject node) error {
+	for {
+		token, err := p.decoder.Token()
+		if err != nil {
+			return fmt.Errorf(`%w: %s`, ErrSyntax, err.Error())
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if err := p.property(p.scope(s, token), subject, token); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// property reads property element, its object is a resource from attributes, a nested node or a literal.
+func (p *xmlParser) property(s scope, subject node, start xml.StartElement) error {
+	var (
+		predicate = start.Name.Space + start.Name.Local
+		object    *node
+		datatype  string
+		parseType string
+	)
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space != rdfNS {
+			continue
+		}
+
+		switch attr.Name.Local {
+		case `resource`:
+			object = &node{value: s.resolve(attr.Value)}
+		case `nodeID`:
+			object = &node{value: attr.Value, blank: true}
+		case `datatype`:
+			datatype = s.resolve(attr.Value)
+		case `parseType`:
+			parseType = attr.Value
+		}
+	}
+
+	switch {
+	case parseType == `Resource`:
+		blank := p.blank()
+		p.triples = append(p.triples, triple{subject: subject, predicate: predicate, object: blank})
+
+		return p.properties(s, blank)
+	case parseType != ``:
+		return fmt.Errorf(`%w: parseType %q isn't supported`, ErrSyntax, parseType)
+	case object != nil:
+		p.triples = append(p.triples, triple{subject: subject, predicate: predicate, object: *object})
+		p.attributes(s, *object, start)
+
+		return p.decoder.Skip() //nolint:wrapcheck
+	}
+
+	var text strings.Builder
+
+	for {
+		token, err := p.decoder.Token()
+		if err != nil {
+			return fmt.Errorf(`%w: %s`, ErrSyntax, err.Error())
+		}
+
+		switch token := token.(type) {
+		case xml.CharData:
+			text.Write(token)
+		case xml.StartElement:
+			nested, err := p.node(p.scope(s, token), token)
+			if err != nil {
+				return err
+			}
+
+			p.triples = append(p.triples, triple{subject: subject, predicate: predicate, object: nested})
+
+			return p.decoder.Skip() //nolint:wrapcheck
+		case xml.EndElement:
+			var object = node{value: text.String(), literal: true, datatype: datatype}
+			if datatype == `` {
+				object.language = s.language
+			}
+
+			p.triples = append(p.triples, triple{subject: subject, predicate: predicate, object: object})
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,367 @@
+// Package skos reads and writes taxonomies as SKOS concept schemes in Turtle or RDF/XML. Vocabulary is a
+// skos:ConceptScheme, its parent is linked by dct:isPartOf. Term is a skos:Concept: its name is skos:notation, title is
+// skos:prefLabel, description is skos:definition, vocabulary is skos:inScheme and broader terms are skos:broader.
+// Translations of titles and descriptions are literals with languages.
+package skos
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+var (
+	ErrUnknownFormat = errors.New(`unknown format`)
+	ErrNoScheme      = errors.New(`concept isn't in any scheme`)
+	ErrEmptyName     = errors.New(`name is empty`)
+	ErrSchemeCycle   = errors.New(`schemes are parts of each other`)
+)
+
+// Format is a serialization of RDF.
+type Format string
+
+const (
+	Turtle Format = `turtle`
+	RDFXML Format = `rdfxml`
+)
+
+// DefaultBase is a base of IRIs of written schemes and concepts.
+const DefaultBase = `urn:taxonomy:`
+
+// Read reads schemes and concepts. Name of a scheme or a concept without skos:notation is the local name of its IRI.
+// Concept of several schemes becomes a term of every vocabulary, skos:narrower is read as skos:broader of the
+// narrower concept, broader concepts from other schemes are ignored.
+func Read(r io.Reader, format Format) (*exchange.Taxonomy, error) {
+	triples, err := read(r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		g       = newGraph(triples)
+		schemes = make(map[node]*exchange.Vocabulary)
+		order   []node
+	)
+
+	addScheme := func(scheme node) {
+		if _, ok := schemes[scheme]; !ok {
+			schemes[scheme] = nil
+			order = append(order, scheme)
+		}
+	}
+
+	for _, scheme := range g.typed(skosNS + `ConceptScheme`) {
+		addScheme(scheme)
+	}
+
+	// Concepts are linked with their schemes from both sides
+	var (
+		concepts  []node
+		inSchemes = make(map[node][]node)
+		broader   = make(map[node][]node)
+	)
+
+	addConcept := func(concept node, schemesOf []node) {
+		if _, ok := inSchemes[concept]; !ok {
+			concepts = append(concepts, concept)
+		}
+
+		inSchemes[concept] = append(inSchemes[concept], schemesOf...)
+
+		for _, scheme := range schemesOf {
+			addScheme(scheme)
+		}
+	}
+
+	for _, subject := range g.subjects {
+		var schemesOf = slices.Concat(g.objects(subject, skosNS+`inScheme`), g.objects(subject, skosNS+`topConceptOf`))
+
+		if len(schemesOf) > 0 || slices.Contains(g.objects(subject, rdfType), iri(skosNS+`Concept`)) {
+			addConcept(subject, schemesOf)
+		}
+
+		for _, concept := range g.objects(subject, skosNS+`hasTopConcept`) {
+			addConcept(concept, []node{subject})
+		}
+
+		for _, narrower := range g.objects(subject, skosNS+`narrower`) {
+			broader[narrower] = append(broader[narrower], subject)
+		}
+	}
+
+	for _, concept := range concepts {
+		broader[concept] = slices.Concat(g.objects(concept, skosNS+`broader`), broader[concept])
+	}
+
+	var taxonomy = new(exchange.Taxonomy)
+
+	for _, scheme := range order {
+		v, err := vocabulary(g, scheme, schemes, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		taxonomy.Vocabularies = append(taxonomy.Vocabularies, v)
+	}
+
+	for _, concept := range concepts {
+		if len(inSchemes[concept]) == 0 {
+			return nil, fmt.Errorf(`%w: %s`, ErrNoScheme, concept.value)
+		}
+
+		term, err := conceptTerm(g, concept)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, scheme := range uniq(inSchemes[concept]) {
+			var inScheme = *term
+
+			for _, b := range uniq(broader[concept]) {
+				if slices.Contains(inSchemes[b], scheme) {
+					name, err := name(g, b)
+					if err != nil {
+						return nil, err
+					}
+
+					inScheme.Broader = append(inScheme.Broader, name)
+				}
+			}
+
+			schemes[scheme].Terms = append(schemes[scheme].Terms, &inScheme)
+		}
+	}
+
+	return taxonomy, nil
+}
+
+// vocabulary returns vocabulary of the scheme, parents of the scheme are read recursively.
+func vocabulary(g *graph, scheme node, schemes map[node]*exchange.Vocabulary, children []node,
+) (*exchange.Vocabulary, error) {
+	if v := schemes[scheme]; v != nil {
+		return v, nil
+	}
+
+	if slices.Contains(children, scheme) {
+		return nil, fmt.Errorf(`%w: %s`, ErrSchemeCycle, scheme.value)
+	}
+
+	name, err := name(g, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	var v = &exchange.Vocabulary{
+		Name:        name,
+		Title:       g.text(scheme, skosNS+`prefLabel`, dctNS+`title`),
+		Description: g.text(scheme, skosNS+`definition`, dctNS+`description`),
+		Labels: labels(g.translations(scheme, skosNS+`prefLabel`, dctNS+`title`),
+			g.translations(scheme, skosNS+`definition`, dctNS+`description`)),
+	}
+
+	for _, parent := range g.objects(scheme, dctNS+`isPartOf`) {
+		if _, ok := schemes[parent]; !ok {
+			continue
+		}
+
+		p, err := vocabulary(g, parent, schemes, append(children, scheme))
+		if err != nil {
+			return nil, err
+		}
+
+		v.Parent = p.Path()
+
+		break
+	}
+
+	schemes[scheme] = v
+
+	return v, nil
+}
+
+func conceptTerm(g *graph, concept node) (*exchange.Term, error) {
+	name, err := name(g, concept)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exchange.Term{
+		Name:        name,
+		Title:       g.text(concept, skosNS+`prefLabel`),
+		Description: g.text(concept, skosNS+`definition`),
+		Labels:      labels(g.translations(concept, skosNS+`prefLabel`), g.translations(concept, skosNS+`definition`)),
+	}, nil
+}
+
+// labels returns labels of translated titles and descriptions by their languages.
+func labels(titles, descriptions map[string]string) model.Labels {
+	if len(titles) == 0 && len(descriptions) == 0 {
+		return nil
+	}
+
+	var labels = make(model.Labels)
+
+	for language, title := range titles {
+		locale := model.NormalizeLocale(language)
+		labels[locale] = model.Label{Title: title, Description: labels[locale].Description}
+	}
+
+	for language, description := range descriptions {
+		locale := model.NormalizeLocale(language)
+		labels[locale] = model.Label{Title: labels[locale].Title, Description: description}
+	}
+
+	return labels
+}
+
+// name returns notation or local name of the resource.
+func name(g *graph, resource node) (string, error) {
+	if notation := g.text(resource, skosNS+`notation`); notation != `` {
+		return notation, nil
+	}
+
+	if !resource.blank {
+		local := resource.value[strings.LastIndexAny(resource.value, `#/:`)+1:]
+		if unescaped, err := url.PathUnescape(local); err == nil {
+			local = unescaped
+		}
+
+		if local != `` {
+			return local, nil
+		}
+	}
+
+	return ``, fmt.Errorf(`%w: %s`, ErrEmptyName, resource.value)
+}
+
+func uniq(nodes []node) []node {
+	var unique = make([]node, 0, len(nodes))
+
+	for _, n := range nodes {
+		if !slices.Contains(unique, n) {
+			unique = append(unique, n)
+		}
+	}
+
+	return unique
+}
+
+func read(r io.Reader, format Format) ([]triple, error) {
+	switch format {
+	case Turtle:
+		return readTurtle(r)
+	case RDFXML:
+		return readRDFXML(r)
+	}
+
+	return nil, fmt.Errorf(`%w: %s`, ErrUnknownFormat, format)
+}
+
+// Write writes vocabularies as schemes and terms as concepts. IRI of a scheme is the base followed by escaped names of
+// its path separated by '/', IRI of a concept is IRI of its scheme followed by '#' and escaped name.
+func Write(w io.Writer, taxonomy *exchange.Taxonomy, format Format, base string) error {
+	var triples []triple
+
+	for _, v := range taxonomy.Vocabularies {
+		scheme := schemeIRI(base, v.Path())
+
+		triples = append(triples,
+			triple{scheme, rdfType, iri(skosNS + `ConceptScheme`)},
+			triple{scheme, skosNS + `notation`, literal(v.Name)},
+		)
+		triples = appendText(triples, scheme, skosNS+`prefLabel`, v.Title)
+		triples = appendText(triples, scheme, skosNS+`definition`, v.Description)
+		triples = appendLabels(triples, scheme, v.Labels)
+
+		if len(v.Parent) > 0 {
+			triples = append(triples, triple{scheme, dctNS + `isPartOf`, schemeIRI(base, v.Parent)})
+		}
+
+		var narrower = make(map[string][]string)
+
+		for _, term := range v.Terms {
+			for _, b := range term.Broader {
+				narrower[b] = append(narrower[b], term.Name)
+			}
+		}
+
+		for _, term := range v.Terms {
+			concept := conceptIRI(scheme, term.Name)
+
+			triples = append(triples,
+				triple{concept, rdfType, iri(skosNS + `Concept`)},
+				triple{concept, skosNS + `inScheme`, scheme},
+			)
+
+			if len(term.Broader) == 0 {
+				triples = append(triples, triple{concept, skosNS + `topConceptOf`, scheme})
+			}
+
+			triples = append(triples, triple{concept, skosNS + `notation`, literal(term.Name)})
+			triples = appendText(triples, concept, skosNS+`prefLabel`, term.Title)
+			triples = appendText(triples, concept, skosNS+`definition`, term.Description)
+			triples = appendLabels(triples, concept, term.Labels)
+
+			for _, b := range term.Broader {
+				triples = append(triples, triple{concept, skosNS + `broader`, conceptIRI(scheme, b)})
+			}
+
+			for _, n := range narrower[term.Name] {
+				triples = append(triples, triple{concept, skosNS + `narrower`, conceptIRI(scheme, n)})
+			}
+		}
+	}
+
+	switch format {
+	case Turtle:
+		return writeTurtle(w, triples)
+	case RDFXML:
+		return writeRDFXML(w, triples)
+	}
+
+	return fmt.Errorf(`%w: %s`, ErrUnknownFormat, format)
+}
+
+func appendText(triples []triple, subject node, predicate, value string) []triple {
+	if value == `` {
+		return triples
+	}
+
+	return append(triples, triple{subject, predicate, literal(value)})
+}
+
+// appendLabels appends translated titles and descriptions as literals with languages.
+func appendLabels(triples []triple, subject node, labels model.Labels) []triple {
+	for _, locale := range labels.Locales() {
+		if title := labels[locale].Title; title != `` {
+			triples = append(triples, triple{subject, skosNS + `prefLabel`,
+				node{value: title, literal: true, language: locale}})
+		}
+
+		if description := labels[locale].Description; description != `` {
+			triples = append(triples, triple{subject, skosNS + `definition`,
+				node{value: description, literal: true, language: locale}})
+		}
+	}
+
+	return triples
+}
+
+func schemeIRI(base string, path []string) node {
+	var escaped = make([]string, len(path))
+	for i, name := range path {
+		escaped[i] = url.PathEscape(name)
+	}
+
+	return iri(base + strings.Join(escaped, `/`))
+}
+
+func conceptIRI(scheme node, name string) node {
+	return iri(scheme.value + `#` + url.PathEscape(name))
+}
@@ -0,0 +1,189 @@
+package skos_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/exchange"
+	"github.com/dmalykh/taxonomy/internal/service/exchange/skos"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite_RoundTrip(t *testing.T) {
+	var taxonomy = &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Name: `catalog`, Title: `Catalog`},
+		{Parent: []string{`catalog`}, Name: `colors`, Description: "Colors of <goods>\n& \"paints\"", Terms: []*exchange.Term{
+			{Name: `red`, Title: `Red`, Description: `Color of blood`, Labels: model.Labels{
+				`de`:    {Title: `Rot`, Description: `Farbe des Blutes`},
+				`pt-BR`: {Title: `Vermelho`},
+			}},
+			{Name: `crimson`, Title: `Crimson`, Broader: []string{`red`}},
+			{Name: `dark crimson/red #1`, Broader: []string{`crimson`, `red`}},
+		}},
+		{Parent: []string{`catalog`}, Name: `sizes/fits`, Terms: []*exchange.Term{{Name: `xl`}}},
+	}}
+
+	for _, format := range []skos.Format{skos.Turtle, skos.RDFXML} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, skos.Write(&buf, taxonomy, format, `http://example.com/taxonomy/`))
+
+			read, err := skos.Read(&buf, format)
+			require.NoError(t, err)
+			assert.Equal(t, taxonomy, read)
+		})
+	}
+}
+
+func TestWrite_Turtle(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, skos.Write(&buf, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Name: `colors`, Terms: []*exchange.Term{
+			{Name: `red`, Title: `Red`},
+			{Name: `crimson`, Broader: []string{`red`}},
+		}},
+	}}, skos.Turtle, skos.DefaultBase))
+
+	assert.Equal(t, `@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix skos: <http://www.w3.org/2004/02/skos/core#> .
+@prefix dct: <http://purl.org/dc/terms/> .
+
+<urn:taxonomy:colors> a skos:ConceptScheme ;
+    skos:notation "colors" .
+
+<urn:taxonomy:colors#red> a skos:Concept ;
+    skos:inScheme <urn:taxonomy:colors> ;
+    skos:topConceptOf <urn:taxonomy:colors> ;
+    skos:notation "red" ;
+    skos:prefLabel "Red" ;
+    skos:narrower <urn:taxonomy:colors#crimson> .
+
+<urn:taxonomy:colors#crimson> a skos:Concept ;
+    skos:inScheme <urn:taxonomy:colors> ;
+    skos:notation "crimson" ;
+    skos:broader <urn:taxonomy:colors#red> .
+`, buf.String())
+}
+
+func TestRead_Turtle(t *testing.T) {
+	const document = `
+BASE <http://example.com/>
+@prefix skos: <http://www.w3.org/2004/02/skos/core#> .
+@prefix ex: <http://example.com/colors/> .
+
+# Scheme without notation is named by local name
+<colors> a skos:ConceptScheme ;
+    skos:prefLabel "Colours"@en-GB, "Colors" ;
+    skos:hasTopConcept ex:red .
+
+ex:red skos:prefLabel 'Red'@en ;
+    skos:definition """Color of
+"blood!"""" ;
+    skos:narrower ex:crimson, ex:scarlet ; .
+
+ex:crimson a skos:Concept ; skos:inScheme <colors>, <shades> ; skos:notation "crimson" ;
+    skos:broader [ skos:prefLabel "anonymous" ] .
+ex:scarlet skos:inScheme <colors> ; skos:prefLabel "Scarlet"@en, "Scharlachrot"@de.
+`
+
+	taxonomy, err := skos.Read(strings.NewReader(document), skos.Turtle)
+	require.NoError(t, err)
+	assert.Equal(t, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Name: `colors`, Title: `Colors`, Labels: model.Labels{`en-GB`: {Title: `Colours`}}, Terms: []*exchange.Term{
+			{Name: `red`, Title: `Red`, Description: "Color of\n\"blood!\"", Labels: model.Labels{`en`: {Title: `Red`}}},
+			{Name: `crimson`, Broader: []string{`red`}},
+			{Name: `scarlet`, Title: `Scarlet`, Broader: []string{`red`}, Labels: model.Labels{
+				`en`: {Title: `Scarlet`},
+				`de`: {Title: `Scharlachrot`},
+			}},
+		}},
+		{Name: `shades`, Terms: []*exchange.Term{
+			{Name: `crimson`},
+		}},
+	}}, taxonomy)
+}
+
+func TestRead_RDFXML(t *testing.T) {
+	const document = `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+    xmlns:skos="http://www.w3.org/2004/02/skos/core#"
+    xmlns:dct="http://purl.org/dc/terms/"
+    xml:base="http://example.com/" xml:lang="en">
+  <skos:ConceptScheme rdf:about="catalog" dct:title="Catalog"/>
+  <rdf:Description rdf:about="colors">
+    <rdf:type rdf:resource="http://www.w3.org/2004/02/skos/core#ConceptScheme"/>
+    <dct:isPartOf rdf:resource="catalog"/>
+  </rdf:Description>
+  <skos:Concept rdf:about="colors#red">
+    <skos:prefLabel xml:lang="de">Rot</skos:prefLabel>
+    <skos:prefLabel>Red</skos:prefLabel>
+    <skos:inScheme rdf:resource="colors"/>
+    <skos:narrower>
+      <skos:Concept rdf:about="colors#crimson">
+        <skos:notation rdf:datatype="http://example.com/code">crimson</skos:notation>
+        <skos:topConceptOf rdf:resource="colors"/>
+      </skos:Concept>
+    </skos:narrower>
+  </skos:Concept>
+</rdf:RDF>`
+
+	taxonomy, err := skos.Read(strings.NewReader(document), skos.RDFXML)
+	require.NoError(t, err)
+	assert.Equal(t, &exchange.Taxonomy{Vocabularies: []*exchange.Vocabulary{
+		{Name: `catalog`, Title: `Catalog`, Labels: model.Labels{`en`: {Title: `Catalog`}}},
+		{Parent: []string{`catalog`}, Name: `colors`, Terms: []*exchange.Term{
+			{Name: `red`, Title: `Rot`, Labels: model.Labels{`de`: {Title: `Rot`}, `en`: {Title: `Red`}}},
+			{Name: `crimson`, Broader: []string{`red`}},
+		}},
+	}}, taxonomy)
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   skos.Format
+		document string
+		err      error
+	}{
+		{
+			name:     `concept without scheme`,
+			format:   skos.Turtle,
+			document: `<urn:red> a <http://www.w3.org/2004/02/skos/core#Concept> .`,
+			err:      skos.ErrNoScheme,
+		},
+		{
+			name:     `unknown prefix`,
+			format:   skos.Turtle,
+			document: `ex:red a ex:Concept .`,
+			err:      skos.ErrSyntax,
+		},
+		{
+			name:     `unterminated string`,
+			format:   skos.Turtle,
+			document: `<urn:red> <urn:label> "red .`,
+			err:      skos.ErrSyntax,
+		},
+		{
+			name:     `invalid xml`,
+			format:   skos.RDFXML,
+			document: `<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"><rdf:Description>`,
+			err:      skos.ErrSyntax,
+		},
+		{
+			name:     `unknown format`,
+			format:   `json-ld`,
+			document: `{}`,
+			err:      skos.ErrUnknownFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := skos.Read(strings.NewReader(tt.document), tt.format)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
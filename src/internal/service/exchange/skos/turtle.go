@@ -0,0 +1,689 @@
+package skos
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrSyntax = errors.New(`syntax error`)
+
+// writeTurtle writes triples grouping consecutive triples of the same subject and predicate.
+func writeTurtle(w io.Writer, triples []triple) error {
+	writer := bufio.NewWriter(w)
+
+	for _, p := range prefixes {
+		fmt.Fprintf(writer, "@prefix %s: <%s> .\n", p.prefix, p.namespace)
+	}
+
+	for i, t := range triples {
+		switch {
+		case i > 0 && t.subject == triples[i-1].subject && t.predicate == triples[i-1].predicate:
+			writer.WriteString(`, `)
+		case i > 0 && t.subject == triples[i-1].subject:
+			writer.WriteString(" ;\n    " + turtlePredicate(t.predicate) + ` `)
+		default:
+			if i > 0 {
+				writer.WriteString(" .\n")
+			}
+
+			writer.WriteString("\n" + turtleNode(t.subject) + ` ` + turtlePredicate(t.predicate) + ` `)
+		}
+
+		writer.WriteString(turtleNode(t.object))
+	}
+
+	if len(triples) > 0 {
+		writer.WriteString(" .\n")
+	}
+
+	return writer.Flush() //nolint:wrapcheck
+}
+
+func turtlePredicate(predicate string) string {
+	if predicate == rdfType {
+		return `a`
+	}
+
+	return turtleIRI(predicate)
+}
+
+// turtleIRI returns prefixed name when the namespace is known.
+func turtleIRI(value string) string {
+	namespace, local := split(value)
+
+	for _, p := range prefixes {
+		if p.namespace == namespace && local != `` && strings.IndexFunc(local, func(r rune) bool {
+			return !(r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r))
+		}) < 0 {
+			return p.prefix + `:` + local
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('<')
+
+	for _, r := range value {
+		if r <= ' ' || strings.ContainsRune(`<>"{}|^`+"`\\", r) {
+			fmt.Fprintf(&b, `\u%04X`, r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte('>')
+
+	return b.String()
+}
+
+func turtleNode(n node) string {
+	switch {
+	case n.blank:
+		return `_:` + n.value
+	case !n.literal:
+		return turtleIRI(n.value)
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('"')
+
+	for _, r := range n.value {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < ' ':
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte('"')
+
+	var s = b.String()
+
+	switch {
+	case n.language != ``:
+		s += `@` + n.language
+	case n.datatype != ``:
+		s += `^^` + turtleIRI(n.datatype)
+	}
+
+	return s
+}
+
+// readTurtle reads triples of Turtle document. Collections aren't supported because they aren't used by SKOS.
+func readTurtle(r io.Reader) ([]triple, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf(`read: %w`, err)
+	}
+
+	p := &turtleParser{in: []rune(string(data)), prefixes: make(map[string]string)}
+
+	for {
+		p.skip()
+
+		if p.eof() {
+			return p.triples, nil
+		}
+
+		if err := p.statement(); err != nil {
+			return nil, fmt.Errorf(`line %d: %w`, p.line(), err)
+		}
+	}
+}
+
+type turtleParser struct {
+	in       []rune
+	pos      int
+	base     *url.URL
+	prefixes map[string]string
+	blanks   int
+	triples  []triple
+}
+
+func (p *turtleParser) eof() bool {
+	return p.pos >= len(p.in)
+}
+
+func (p *turtleParser) peek() rune {
+	if p.eof() {
+		return 0
+	}
+
+	return p.in[p.pos]
+}
+
+func (p *turtleParser) line() int {
+	return strings.Count(string(p.in[:min(p.pos, len(p.in))]), "\n") + 1
+}
+
+// skip skips white spaces and comments.
+func (p *turtleParser) skip() {
+	for !p.eof() {
+		switch r := p.peek(); {
+		case unicode.IsSpace(r):
+			p.pos++
+		case r == '#':
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// expect skips spaces and the rune.
+func (p *turtleParser) expect(r rune) error {
+	p.skip()
+
+	if p.peek() != r {
+		return fmt.Errorf(`%w: expected %q`, ErrSyntax, r)
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// keyword reads case-insensitive word followed by a space.
+func (p *turtleParser) keyword(word string) bool {
+	end := p.pos + len(word)
+	if end >= len(p.in) || !strings.EqualFold(string(p.in[p.pos:end]), word) || !unicode.IsSpace(p.in[end]) {
+		return false
+	}
+
+	p.pos = end
+
+	return true
+}
+
+func (p *turtleParser) statement() error {
+	switch {
+	case p.keyword(`@prefix`):
+		if err := p.prefix(); err != nil {
+			return err
+		}
+
+		return p.expect('.')
+	case p.keyword(`@base`):
+		if err := p.baseIRI(); err != nil {
+			return err
+		}
+
+		return p.expect('.')
+	case p.keyword(`prefix`):
+		return p.prefix()
+	case p.keyword(`base`):
+		return p.baseIRI()
+	}
+
+	subject, err := p.subject()
+	if err != nil {
+		return err
+	}
+
+	// Blank node with properties could be a whole statement
+	if p.skip(); !(subject.blank && p.peek() == '.') {
+		if err := p.predicateObjects(subject); err != nil {
+			return err
+		}
+	}
+
+	return p.expect('.')
+}
+
+func (p *turtleParser) prefix() error {
+	p.skip()
+
+	start := p.pos
+	for !p.eof() && p.peek() != ':' && !unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+
+	name := string(p.in[start:p.pos])
+
+	if err := p.expect(':'); err != nil {
+		return err
+	}
+
+	p.skip()
+
+	namespace, err := p.iriRef()
+	if err != nil {
+		return err
+	}
+
+	p.prefixes[name] = namespace
+
+	return nil
+}
+
+func (p *turtleParser) baseIRI() error {
+	p.skip()
+
+	base, err := p.iriRef()
+	if err != nil {
+		return err
+	}
+
+	p.base, err = url.Parse(base)
+	if err != nil {
+		return fmt.Errorf(`%w: base %s`, ErrSyntax, err.Error())
+	}
+
+	return nil
+}
+
+func (p *turtleParser) subject() (node, error) {
+	p.skip()
+
+	switch p.peek() {
+	case '<':
+		value, err := p.iriRef()
+
+		return iri(value), err
+	case '[':
+		return p.blankProperties()
+	case '(':
+		return node{}, fmt.Errorf(`%w: collections aren't supported`, ErrSyntax)
+	}
+
+	if p.startsWith(`_:`) {
+		return p.blankLabel(), nil
+	}
+
+	value, err := p.prefixedName()
+
+	return iri(value), err
+}
+
+func (p *turtleParser) startsWith(s string) bool {
+	return p.pos+len(s) <= len(p.in) && string(p.in[p.pos:p.pos+len(s)]) == s
+}
+
+// predicateObjects reads predicates with objects separated by ';' until '.' or ']'.
+func (p *turtleParser) predicateObjects(subject node) error {
+	for {
+		p.skip()
+
+		predicate, err := p.verb()
+		if err != nil {
+			return err
+		}
+
+		for {
+			object, err := p.object()
+			if err != nil {
+				return err
+			}
+
+			p.triples = append(p.triples, triple{subject: subject, predicate: predicate, object: object})
+
+			if p.skip(); p.peek() != ',' {
+				break
+			}
+
+			p.pos++
+		}
+
+		if p.peek() != ';' {
+			return nil
+		}
+
+		for p.peek() == ';' {
+			p.pos++
+			p.skip()
+		}
+
+		if p.peek() == '.' || p.peek() == ']' {
+			return nil
+		}
+	}
+}
+
+func (p *turtleParser) verb() (string, error) {
+	if p.peek() == 'a' && p.pos+1 < len(p.in) &&
+		(unicode.IsSpace(p.in[p.pos+1]) || strings.ContainsRune(`<["'_`, p.in[p.pos+1])) {
+		p.pos++
+
+		return rdfType, nil
+	}
+
+	if p.peek() == '<' {
+		return p.iriRef()
+	}
+
+	return p.prefixedName()
+}
+
+func (p *turtleParser) object() (node, error) {
+	p.skip()
+
+	switch r := p.peek(); {
+	case r == '"' || r == '\'':
+		return p.literal()
+	case r == '+' || r == '-' || r == '.' || unicode.IsDigit(r):
+		return p.number(), nil
+	case p.boolean(`true`) || p.boolean(`false`):
+		value := `true`
+		if r == 'f' {
+			value = `false`
+		}
+
+		p.pos += len(value)
+
+		return node{value: value, literal: true, datatype: xsdNS + `boolean`}, nil
+	}
+
+	return p.subject()
+}
+
+// boolean checks that the keyword isn't a prefix of a name.
+func (p *turtleParser) boolean(word string) bool {
+	end := p.pos + len(word)
+
+	return p.startsWith(word) && (end == len(p.in) || !nameRune(p.in[end]) && p.in[end] != ':')
+}
+
+// blankProperties reads blank node with properties in square brackets.
+func (p *turtleParser) blankProperties() (node, error) {
+	p.pos++
+	p.blanks++
+
+	// Generated labels have '#' which isn't allowed in labels of a document
+	blank := node{value: `#` + strconv.Itoa(p.blanks), blank: true}
+
+	if p.skip(); p.peek() != ']' {
+		if err := p.predicateObjects(blank); err != nil {
+			return node{}, err
+		}
+	}
+
+	return blank, p.expect(']')
+}
+
+func (p *turtleParser) blankLabel() node {
+	p.pos += 2
+
+	start := p.pos
+	for !p.eof() && nameRune(p.peek()) {
+		p.pos++
+	}
+
+	p.trimDots(start)
+
+	return node{value: string(p.in[start:p.pos]), blank: true}
+}
+
+func nameRune(r rune) bool {
+	return r == '_' || r == '-' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// trimDots returns trailing dots of the name which end the statement.
+func (p *turtleParser) trimDots(start int) {
+	for p.pos > start && p.in[p.pos-1] == '.' {
+		p.pos--
+	}
+}
+
+func (p *turtleParser) iriRef() (string, error) {
+	if p.peek() != '<' {
+		return ``, fmt.Errorf(`%w: expected IRI`, ErrSyntax)
+	}
+
+	p.pos++
+
+	var b strings.Builder
+
+	for {
+		if p.eof() {
+			return ``, fmt.Errorf(`%w: unterminated IRI`, ErrSyntax)
+		}
+
+		r := p.peek()
+		p.pos++
+
+		switch r {
+		case '>':
+			return p.resolve(b.String())
+		case '\\':
+			escaped, err := p.escape()
+			if err != nil {
+				return ``, err
+			}
+
+			b.WriteString(escaped)
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
+
+// resolve resolves relative IRI against the base.
+func (p *turtleParser) resolve(value string) (string, error) {
+	if p.base == nil {
+		return value, nil
+	}
+
+	ref, err := url.Parse(value)
+	if err != nil {
+		return ``, fmt.Errorf(`%w: IRI %s`, ErrSyntax, err.Error())
+	}
+
+	if ref.IsAbs() {
+		return value, nil
+	}
+
+	return p.base.ResolveReference(ref).String(), nil
+}
+
+func (p *turtleParser) prefixedName() (string, error) {
+	start := p.pos
+	for !p.eof() && p.peek() != ':' && nameRune(p.peek()) {
+		p.pos++
+	}
+
+	if p.peek() != ':' {
+		return ``, fmt.Errorf(`%w: unexpected %q`, ErrSyntax, p.peek())
+	}
+
+	namespace, ok := p.prefixes[string(p.in[start:p.pos])]
+	if !ok {
+		return ``, fmt.Errorf(`%w: unknown prefix %q`, ErrSyntax, string(p.in[start:p.pos]))
+	}
+
+	p.pos++
+
+	var local strings.Builder
+
+	for !p.eof() {
+		switch r := p.peek(); {
+		case r == '\\' && p.pos+1 < len(p.in):
+			local.WriteRune(p.in[p.pos+1])
+			p.pos += 2
+		case nameRune(r) || r == ':' || r == '%':
+			local.WriteRune(r)
+			p.pos++
+		default:
+			return namespace + strings.TrimRight(p.unread(local.String()), `.`), nil
+		}
+	}
+
+	return namespace + strings.TrimRight(p.unread(local.String()), `.`), nil
+}
+
+// unread returns trailing dots of the local name to input.
+func (p *turtleParser) unread(local string) string {
+	p.pos -= len(local) - len(strings.TrimRight(local, `.`))
+
+	return local
+}
+
+func (p *turtleParser) literal() (node, error) {
+	var (
+		quote = p.peek()
+		long  = p.startsWith(strings.Repeat(string(quote), 3))
+		b     strings.Builder
+	)
+
+	if long {
+		p.pos += 3
+	} else {
+		p.pos++
+	}
+
+	for {
+		if p.eof() || !long && (p.peek() == '\n' || p.peek() == '\r') {
+			return node{}, fmt.Errorf(`%w: unterminated string`, ErrSyntax)
+		}
+
+		// Quotes before the closing ones belong to the string
+		if long && p.startsWith(strings.Repeat(string(quote), 3)) {
+			if p.pos+3 < len(p.in) && p.in[p.pos+3] == quote {
+				b.WriteRune(quote)
+				p.pos++
+
+				continue
+			}
+
+			p.pos += 3
+
+			break
+		}
+
+		r := p.peek()
+		p.pos++
+
+		if !long && r == quote {
+			break
+		}
+
+		if r != '\\' {
+			b.WriteRune(r)
+
+			continue
+		}
+
+		escaped, err := p.escape()
+		if err != nil {
+			return node{}, err
+		}
+
+		b.WriteString(escaped)
+	}
+
+	var n = literal(b.String())
+
+	switch {
+	case p.peek() == '@':
+		p.pos++
+
+		start := p.pos
+		for !p.eof() && (p.peek() == '-' || unicode.IsLetter(p.peek()) || unicode.IsDigit(p.peek())) {
+			p.pos++
+		}
+
+		n.language = strings.ToLower(string(p.in[start:p.pos]))
+	case p.startsWith(`^^`):
+		p.pos += 2
+
+		var err error
+		if p.peek() == '<' {
+			n.datatype, err = p.iriRef()
+		} else {
+			n.datatype, err = p.prefixedName()
+		}
+
+		if err != nil {
+			return node{}, err
+		}
+	}
+
+	return n, nil
+}
+
+// escape reads escape sequence after backslash.
+func (p *turtleParser) escape() (string, error) {
+	if p.eof() {
+		return ``, fmt.Errorf(`%w: unterminated escape`, ErrSyntax)
+	}
+
+	r := p.peek()
+	p.pos++
+
+	switch r {
+	case 't':
+		return "\t", nil
+	case 'b':
+		return "\b", nil
+	case 'n':
+		return "\n", nil
+	case 'r':
+		return "\r", nil
+	case 'f':
+		return "\f", nil
+	case '"', '\'', '\\':
+		return string(r), nil
+	case 'u', 'U':
+		size := 4
+		if r == 'U' {
+			size = 8
+		}
+
+		if p.pos+size > len(p.in) {
+			return ``, fmt.Errorf(`%w: short unicode escape`, ErrSyntax)
+		}
+
+		code, err := strconv.ParseUint(string(p.in[p.pos:p.pos+size]), 16, 32)
+		if err != nil {
+			return ``, fmt.Errorf(`%w: unicode escape %s`, ErrSyntax, err.Error())
+		}
+
+		p.pos += size
+
+		return string(rune(code)), nil
+	}
+
+	return ``, fmt.Errorf(`%w: unknown escape \%c`, ErrSyntax, r)
+}
+
+// number reads numeric literal, its datatype depends on its form.
+func (p *turtleParser) number() node {
+	start := p.pos
+	for !p.eof() && strings.ContainsRune(`+-.eE0123456789`, p.peek()) {
+		p.pos++
+	}
+
+	p.trimDots(start)
+
+	var (
+		value    = string(p.in[start:p.pos])
+		datatype = xsdNS + `integer`
+	)
+
+	switch {
+	case strings.ContainsAny(value, `eE`):
+		datatype = xsdNS + `double`
+	case strings.Contains(value, `.`):
+		datatype = xsdNS + `decimal`
+	}
+
+	return node{value: value, literal: true, datatype: datatype}
+}
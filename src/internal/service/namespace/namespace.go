@@ -11,22 +11,31 @@ import (
 )
 
 type Config struct {
+	// Transaction makes deletion with checks atomic, repository.NoTransaction is used when it's nil.
+	Transaction         repository.Transaction
 	NamespaceRepository repository.Namespace
-	ReferenceService    taxonomy.Reference
+	ReferenceRepository repository.Reference
 	Logger              *zap.Logger
 }
 
 func New(config *Config) taxonomy.Namespace {
+	var transaction = config.Transaction
+	if transaction == nil {
+		transaction = repository.NoTransaction{}
+	}
+
 	return &NamespaceService{
+		transaction:         transaction,
 		namespaceRepository: config.NamespaceRepository,
-		referenceService:    config.ReferenceService,
+		referenceRepository: config.ReferenceRepository,
 		log:                 config.Logger,
 	}
 }
 
 type NamespaceService struct {
+	transaction         repository.Transaction
 	namespaceRepository repository.Namespace
-	referenceService    taxonomy.Reference
+	referenceRepository repository.Reference
 	log                 *zap.Logger
 }
 
@@ -79,6 +88,12 @@ func (n *NamespaceService) Update(ctx context.Context, id uint64, name string) (
 
 // Delete namespace and it's dependencies.
 func (n *NamespaceService) Delete(ctx context.Context, id uint64) error {
+	return n.transaction.Run(ctx, func(ctx context.Context) error {
+		return n.delete(ctx, id)
+	})
+}
+
+func (n *NamespaceService) delete(ctx context.Context, id uint64) error {
 	logger := n.log.With(zap.String(`method`, `Delete`), zap.Uint64("id", id))
 
 	nss, err := n.namespaceRepository.Get(ctx, &repository.NamespaceFilter{
@@ -101,17 +116,18 @@ func (n *NamespaceService) Delete(ctx context.Context, id uint64) error {
 	// Reference exists check
 	logger.Debug(`check references`)
 
-	ref, err := n.referenceService.Get(ctx, &model.ReferenceFilter{
-		Namespace: []string{nss[0].Data.Name},
+	count, err := n.referenceRepository.Count(ctx, &repository.ReferenceFilter{
+		NamespaceID: []uint64{nss[0].ID},
 	})
 	if err != nil {
-		logger.Error(`get references by namespace`, zap.Uint64(`namespace_id`, id), zap.Error(err))
+		logger.Error(`count references by namespace`, zap.Uint64(`namespace_id`, id), zap.Error(err))
 
 		return fmt.Errorf(`get references by term error: %w`, err)
 	}
 
-	if len(ref) > 0 {
-		return errors.Join(taxonomy.ErrReferenceExists, fmt.Errorf(`%d has %d references`, id, len(ref)))
+	if count > 0 {
+		return fmt.Errorf(`can't remove namespace %d: %w`, id,
+			&taxonomy.BlockedError{Err: taxonomy.ErrReferenceExists, Count: count})
 	}
 
 	// Delete namespace
@@ -116,13 +116,13 @@ func TestNamespaceService_Delete(t *testing.T) {
 						}, nil}
 					})
 
-				var ref = mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn(nil, errunknown)
+				var ref = mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(0), errunknown)
 
 				return namespace.New(&namespace.Config{
 					Logger:              zap.NewNop(),
-					ReferenceService:    ref,
+					ReferenceRepository: ref,
 					NamespaceRepository: namespacerepo,
 				})
 			},
@@ -141,15 +141,13 @@ func TestNamespaceService_Delete(t *testing.T) {
 						}, nil}
 					})
 
-				var ref = mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn([]*model.Reference{
-						{ID: 444},
-					}, nil)
+				var ref = mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(1), nil)
 
 				return namespace.New(&namespace.Config{
 					Logger:              zap.NewNop(),
-					ReferenceService:    ref,
+					ReferenceRepository: ref,
 					NamespaceRepository: namespacerepo,
 				})
 			},
@@ -173,13 +171,13 @@ func TestNamespaceService_Delete(t *testing.T) {
 						return []any{errunknown}
 					})
 
-				var ref = mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn(nil, nil)
+				var ref = mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(0), nil)
 
 				return namespace.New(&namespace.Config{
 					Logger:              zap.NewNop(),
-					ReferenceService:    ref,
+					ReferenceRepository: ref,
 					NamespaceRepository: namespacerepo,
 				})
 			},
@@ -203,13 +201,13 @@ func TestNamespaceService_Delete(t *testing.T) {
 						return []any{nil}
 					})
 
-				var ref = mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn(nil, nil)
+				var ref = mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(0), nil)
 
 				return namespace.New(&namespace.Config{
 					Logger:              zap.NewNop(),
-					ReferenceService:    ref,
+					ReferenceRepository: ref,
 					NamespaceRepository: namespacerepo,
 				})
 			},
@@ -1,6 +1,7 @@
 package reference
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -9,9 +10,12 @@ import (
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"slices"
 )
 
 type Config struct {
+	// Transaction makes replacing of entity's terms atomic, repository.NoTransaction is used when it's nil.
+	Transaction         repository.Transaction
 	NamespaceService    taxonomy.Namespace
 	ReferenceRepository repository.Reference
 	TermService         taxonomy.Term
@@ -19,6 +23,7 @@ type Config struct {
 }
 
 type Service struct {
+	transaction         repository.Transaction
 	log                 *zap.Logger
 	namespaceService    taxonomy.Namespace
 	referenceRepository repository.Reference
@@ -26,7 +31,13 @@ type Service struct {
 }
 
 func New(config *Config) taxonomy.Reference {
+	var transaction = config.Transaction
+	if transaction == nil {
+		transaction = repository.NoTransaction{}
+	}
+
 	return &Service{
+		transaction:         transaction,
 		namespaceService:    config.NamespaceService,
 		termService:         config.TermService,
 		referenceRepository: config.ReferenceRepository,
@@ -102,67 +113,151 @@ func (r *Service) Delete(ctx context.Context, termID uint64, namespace string, e
 	return nil
 }
 
-// Todo: Do we need this method?
-//func (t *Service) GetTerms(ctx context.Context, namespace string, entities ...model.EntityID) ([]model.Term, error) {
-//	logger := t.log.With(zap.String(`method`, `GetTerms`),
-//		zap.String(`namespace`, namespace), zap.Any(`entities`, entities))
-//
-//	ns, err := t.namespaceService.GetByName(ctx, namespace)
-//	if err != nil {
-//		return nil, taxonomy.ErrNamespaceNotFound
-//	}
-//
-//	references, err := t.referenceRepository.Get(ctx, &repository.ReferenceFilter{
-//		NamespaceID: []uint64{ns.ID},
-//		EntityID:    entities,
-//	})
-//	logger.Debug(`got references`, zap.Any(`references`, references), zap.Error(err))
-//
-//	if err != nil {
-//		return nil, fmt.Errorf(`unknown error %w`, err)
-//	}
-//
-//	terms := make([]model.Term, 0, len(references))
-//
-//	for _, reference := range references {
-//		// Change for one request if there is a lot of reference will be found
-//		term, err := t.termService.GetByID(ctx, reference.TermID)
-//		logger.Debug(`got term`, zap.Uint64(`id`, reference.TermID), zap.Any(`term`, term), zap.Error(err))
-//
-//		if err != nil {
-//			logger.DPanic(`unknown term in reference`, zap.Error(err))
-//
-//			continue
-//		}
-//
-//		//terms = append(terms, term)
-//	}
-//
-//	return terms, nil
-//}
-
-func (t *Service) Get(ctx context.Context, filter *model.ReferenceFilter) ([]*model.Reference, error) {
-	logger := t.log.With(zap.String(`method`, `GetReferences`), zap.Any(`filter`, filter))
-
-	// Get ids of references
-	namespaces := make(map[uint64]*model.Namespace, len(filter.Namespace))
-
-	for _, ns := range filter.Namespace {
-		ns, err := t.namespaceService.GetByName(ctx, ns)
+func (r *Service) GetTerms(ctx context.Context, namespace string, entitiesID ...model.EntityID,
+) ([]*model.EntityTerms, error) {
+	r.log.With(zap.String(`method`, `GetTerms`), zap.String(`namespace`, namespace),
+		zap.Any(`entitiesID`, entitiesID)).Debug(`get terms`)
+
+	ns, err := r.namespaceService.GetByName(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf(`%w: %w`, taxonomy.ErrNamespaceNotFound, err)
+	}
+
+	terms, err := r.referenceRepository.Terms(ctx, ns.ID, entitiesID...)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var entities = make([]*model.EntityTerms, 0, len(entitiesID))
+
+	for _, entityID := range entitiesID {
+		var (
+			entity = &model.EntityTerms{EntityID: entityID, Vocabularies: make([]*model.VocabularyTerms, 0)}
+			groups = make(map[uint64]*model.VocabularyTerms)
+		)
+
+		for _, term := range terms[entityID] {
+			for _, vocabularyID := range term.Data.VocabularyID {
+				group, ok := groups[vocabularyID]
+				if !ok {
+					group = &model.VocabularyTerms{VocabularyID: vocabularyID}
+					groups[vocabularyID] = group
+					entity.Vocabularies = append(entity.Vocabularies, group)
+				}
+
+				group.Terms = append(group.Terms, term)
+			}
+		}
+
+		slices.SortFunc(entity.Vocabularies, func(a, b *model.VocabularyTerms) int {
+			return cmp.Compare(a.VocabularyID, b.VocabularyID)
+		})
+
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+func (r *Service) Replace(ctx context.Context, namespace string, entityID model.EntityID, termsID []uint64,
+) (*model.ReferenceChanges, error) {
+	return repository.InTransaction(ctx, r.transaction, func(ctx context.Context) (*model.ReferenceChanges, error) {
+		return r.replace(ctx, namespace, entityID, nil, termsID)
+	})
+}
+
+func (r *Service) ReplaceInVocabulary(ctx context.Context, namespace string, entityID model.EntityID,
+	vocabularyID uint64, termsID []uint64,
+) (*model.ReferenceChanges, error) {
+	return repository.InTransaction(ctx, r.transaction, func(ctx context.Context) (*model.ReferenceChanges, error) {
+		return r.replace(ctx, namespace, entityID, &vocabularyID, termsID)
+	})
+}
+
+// replace computes difference between current and given terms of the entity and applies it. When vocabularyID is
+// set, only terms of the vocabulary are compared.
+func (r *Service) replace(ctx context.Context, namespace string, entityID model.EntityID, vocabularyID *uint64,
+	termsID []uint64,
+) (*model.ReferenceChanges, error) {
+	logger := r.log.With(zap.String(`method`, `Replace`), zap.String(`namespace`, namespace),
+		zap.Any(`entityID`, entityID), zap.Uint64p(`vocabularyID`, vocabularyID), zap.Uint64s(`termsID`, termsID))
+
+	ns, err := r.namespaceService.GetByName(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf(`%w: %w`, taxonomy.ErrNamespaceNotFound, err)
+	}
+
+	terms, err := r.referenceRepository.Terms(ctx, ns.ID, entityID)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var current = make([]uint64, 0, len(terms[entityID]))
+
+	for _, term := range terms[entityID] {
+		if vocabularyID == nil || slices.Contains(term.Data.VocabularyID, *vocabularyID) {
+			current = append(current, term.ID)
+		}
+	}
+
+	var changes = new(model.ReferenceChanges)
+	changes.Added, changes.Removed = lo.Difference(lo.Uniq(termsID), current)
+
+	// Check new terms
+	for _, termID := range changes.Added {
+		term, err := r.termService.GetByID(ctx, termID)
 		if err != nil {
-			return nil, fmt.Errorf(`%w: %w`, taxonomy.ErrNamespaceNotFound, err)
+			return nil, err
 		}
 
-		namespaces[ns.ID] = ns
+		if vocabularyID != nil && !slices.Contains(term.Data.VocabularyID, *vocabularyID) {
+			return nil, fmt.Errorf(`%w: term %d, vocabulary %d`, taxonomy.ErrTermNotInVocabulary, termID, *vocabularyID)
+		}
+	}
+
+	if len(changes.Removed) > 0 {
+		if err := r.referenceRepository.Delete(ctx, &repository.ReferenceFilter{
+			TermID:      [][]uint64{changes.Removed},
+			NamespaceID: []uint64{ns.ID},
+			EntityID:    []model.EntityID{entityID},
+		}); err != nil {
+			return nil, fmt.Errorf(`can't remove reference %w: %w`, taxonomy.ErrReferenceNotRemoved, err)
+		}
+	}
+
+	if len(changes.Added) > 0 {
+		references := lo.Map(changes.Added, func(termID uint64, _ int) *repository.ReferenceModel {
+			return &repository.ReferenceModel{TermID: termID, NamespaceID: ns.ID, EntityID: entityID}
+		})
+
+		if err := r.referenceRepository.Set(ctx, references...); err != nil {
+			return nil, fmt.Errorf(`can't create reference %w: %w`, taxonomy.ErrReferenceNotCreated, err)
+		}
+	}
+
+	logger.Debug(`references replaced`, zap.Uint64s(`added`, changes.Added), zap.Uint64s(`removed`, changes.Removed))
+
+	return changes, nil
+}
+
+func (r *Service) Get(ctx context.Context, filter *model.ReferenceFilter) ([]*model.Reference, error) {
+	logger := r.log.With(zap.String(`method`, `GetReferences`), zap.Any(`filter`, filter))
+
+	namespaces, err := r.namespaces(ctx, filter.Namespace)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get references
-	references, err := t.referenceRepository.Get(ctx, &repository.ReferenceFilter{
-		TermID:      filter.TermID,
-		EntityID:    filter.EntityID,
-		NamespaceID: lo.Keys[uint64, *model.Namespace](namespaces),
-		AfterID:     filter.AfterID,
-		Limit:       filter.Limit,
+	references, err := r.referenceRepository.Get(ctx, &repository.ReferenceFilter{
+		TermID:                    filter.TermID,
+		ExcludeTermID:             filter.ExcludeTermID,
+		EntityID:                  filter.EntityID,
+		NamespaceID:               lo.Keys[uint64, *model.Namespace](namespaces),
+		AfterID:                   filter.AfterID,
+		Limit:                     filter.Limit,
+		WithSubterms:              filter.WithSubterms,
+		WithVocabularyDescendants: filter.WithVocabularyDescendants,
 	})
 	logger.Debug(`got references`, zap.Any(`references`, references), zap.Error(err))
 
@@ -170,17 +265,104 @@ func (t *Service) Get(ctx context.Context, filter *model.ReferenceFilter) ([]*mo
 		return nil, fmt.Errorf(`unknown error %w`, err)
 	}
 
-	return func(references []*repository.ReferenceModel) []*model.Reference {
-		var models = make([]*model.Reference, 0, len(references))
-		for _, ref := range references {
-			models = append(models, &model.Reference{
-				ID:        ref.ID,
-				TermID:    ref.TermID,
-				Namespace: namespaces[ref.NamespaceID].Data.Name,
-				EntityID:  ref.EntityID,
-			})
+	var models = make([]*model.Reference, 0, len(references))
+	for _, ref := range references {
+		models = append(models, reference2model(ref, namespaces))
+	}
+
+	return models, nil
+}
+
+func (r *Service) Count(ctx context.Context, filter *model.ReferenceFilter) (uint64, error) {
+	namespaces, err := r.namespaces(ctx, filter.Namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.referenceRepository.Count(ctx, &repository.ReferenceFilter{
+		TermID:                    filter.TermID,
+		ExcludeTermID:             filter.ExcludeTermID,
+		EntityID:                  filter.EntityID,
+		NamespaceID:               lo.Keys[uint64, *model.Namespace](namespaces),
+		AfterID:                   filter.AfterID,
+		WithSubterms:              filter.WithSubterms,
+		WithVocabularyDescendants: filter.WithVocabularyDescendants,
+	})
+	if err != nil {
+		return 0, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return count, nil
+}
+
+func (r *Service) Iterate(ctx context.Context, filter *model.ReferenceFilter, batchSize uint,
+	fn func(reference *model.Reference) error,
+) error {
+	r.log.With(zap.String(`method`, `Iterate`), zap.Any(`filter`, filter), zap.Uint(`batchSize`, batchSize)).
+		Debug(`iterate references`)
+
+	namespaces, err := r.namespaces(ctx, filter.Namespace)
+	if err != nil {
+		return err
+	}
+
+	return r.referenceRepository.Iterate(ctx, &repository.ReferenceFilter{
+		TermID:                    filter.TermID,
+		ExcludeTermID:             filter.ExcludeTermID,
+		EntityID:                  filter.EntityID,
+		NamespaceID:               lo.Keys[uint64, *model.Namespace](namespaces),
+		AfterID:                   filter.AfterID,
+		WithSubterms:              filter.WithSubterms,
+		WithVocabularyDescendants: filter.WithVocabularyDescendants,
+	}, batchSize, func(reference *repository.ReferenceModel) error {
+		return fn(reference2model(reference, namespaces))
+	})
+}
+
+func (r *Service) Facets(ctx context.Context, filter *model.ReferenceFilter) (*model.Facets, error) {
+	r.log.With(zap.String(`method`, `Facets`), zap.Any(`filter`, filter)).Debug(`get facets`)
+
+	namespaces, err := r.namespaces(ctx, filter.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := r.referenceRepository.Facets(ctx, &repository.ReferenceFilter{
+		TermID:                    filter.TermID,
+		ExcludeTermID:             filter.ExcludeTermID,
+		EntityID:                  filter.EntityID,
+		NamespaceID:               lo.Keys[uint64, *model.Namespace](namespaces),
+		WithSubterms:              filter.WithSubterms,
+		WithVocabularyDescendants: filter.WithVocabularyDescendants,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return facets, nil
+}
+
+// namespaces returns namespaces by names with ids as keys
+func (r *Service) namespaces(ctx context.Context, names []string) (map[uint64]*model.Namespace, error) {
+	namespaces := make(map[uint64]*model.Namespace, len(names))
+
+	for _, name := range names {
+		ns, err := r.namespaceService.GetByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf(`%w: %w`, taxonomy.ErrNamespaceNotFound, err)
 		}
 
-		return models
-	}(references), nil
+		namespaces[ns.ID] = ns
+	}
+
+	return namespaces, nil
+}
+
+func reference2model(reference *repository.ReferenceModel, namespaces map[uint64]*model.Namespace) *model.Reference {
+	return &model.Reference{
+		ID:        reference.ID,
+		TermID:    reference.TermID,
+		Namespace: namespaces[reference.NamespaceID].Data.Name,
+		EntityID:  reference.EntityID,
+	}
 }
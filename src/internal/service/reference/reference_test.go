@@ -8,7 +8,9 @@ import (
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
 	"github.com/jaswdr/faker"
 	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"io"
 	"testing"
@@ -386,48 +388,313 @@ func TestService_Get(t *testing.T) {
 	}
 }
 
-//
-//func TestService_GetTermsByEntities(t1 *testing.T) {
-//	type fields struct {
-//		log                 *zap.Logger
-//		namespaceService    taxonomy.Namespace
-//		referenceRepository repository.Reference
-//		termService         taxonomy.Term
-//	}
-//	type args struct {
-//		ctx       context.Context
-//		namespace string
-//		entities  []model.EntityID
-//	}
-//	tests := []struct {
-//		name    string
-//		fields  fields
-//		args    args
-//		want    []model.Term
-//		wantErr bool
-//	}{
-//		// TODO: Add test cases.
-//	}
-//	for _, tt := range tests {
-//		t1.Run(tt.name, func(t1 *testing.T) {
-//			t := &Service{
-//				log:                 tt.fields.log,
-//				namespaceService:    tt.fields.namespaceService,
-//				referenceRepository: tt.fields.referenceRepository,
-//				termService:         tt.fields.termService,
-//			}
-//			got, err := t.GetTermsByEntities(tt.args.ctx, tt.args.namespace, tt.args.entities...)
-//			if (err != nil) != tt.wantErr {
-//				t1.Errorf("GetTermsByEntities() error = %v, wantErr %v", err, tt.wantErr)
-//				return
-//			}
-//			if !reflect.DeepEqual(got, tt.want) {
-//				t1.Errorf("GetTermsByEntities() got = %v, want %v", got, tt.want)
-//			}
-//		})
-//	}
-//}
-//
+func TestService_Iterate(t *testing.T) {
+	mock.SetUp(t)
+
+	ns := mock.Mock[taxonomy.Namespace]()
+	mock.When(ns.GetByName(mock.Any[context.Context](), mock.Exact[string](`laptops`))).
+		ThenReturn(&model.Namespace{ID: 2, Data: model.NamespaceData{Name: `laptops`}}, nil)
+
+	ref := mock.Mock[repository.Reference]()
+	captor := mock.Captor[*repository.ReferenceFilter]()
+	mock.When(ref.Iterate(mock.Any[context.Context](), captor.Capture(), mock.Equal[uint](50),
+		mock.Any[func(reference *repository.ReferenceModel) error]())).
+		ThenAnswer(func(args []any) []any {
+			var fn = args[3].(func(reference *repository.ReferenceModel) error)
+			for id := uint64(11); id <= 13; id++ {
+				if err := fn(&repository.ReferenceModel{ID: id, TermID: 92, NamespaceID: 2, EntityID: `dell`}); err != nil {
+					return []any{err}
+				}
+			}
+
+			return []any{nil}
+		})
+
+	r := reference.New(&reference.Config{
+		NamespaceService:    ns,
+		ReferenceRepository: ref,
+		Logger:              zap.NewNop(),
+	})
+
+	var (
+		after = uint64(10)
+		got   []*model.Reference
+	)
+	err := r.Iterate(context.Background(), &model.ReferenceFilter{
+		Namespace: []string{`laptops`},
+		TermID:    [][]uint64{{92}},
+		AfterID:   &after,
+	}, 50, func(reference *model.Reference) error {
+		got = append(got, reference)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got, 3)
+	assert.Equal(t, `laptops`, got[2].Namespace)
+	assert.Equal(t, uint64(13), got[2].ID)
+	assert.Equal(t, []uint64{2}, captor.Last().NamespaceID)
+	assert.Equal(t, after, *captor.Last().AfterID)
+}
+
+func TestService_Facets(t *testing.T) {
+	mock.SetUp(t)
+
+	ns := mock.Mock[taxonomy.Namespace]()
+	mock.When(ns.GetByName(mock.Any[context.Context](), mock.Exact[string](`laptops`))).
+		ThenReturn(&model.Namespace{ID: 2, Data: model.NamespaceData{Name: `laptops`}}, nil)
+
+	ref := mock.Mock[repository.Reference]()
+	captor := mock.Captor[*repository.ReferenceFilter]()
+	mock.When(ref.Facets(mock.Any[context.Context](), captor.Capture())).
+		ThenReturn(&model.Facets{Terms: []*model.TermFacet{{TermID: 92, Count: 3}}}, nil)
+
+	r := reference.New(&reference.Config{
+		NamespaceService:    ns,
+		ReferenceRepository: ref,
+		Logger:              zap.NewNop(),
+	})
+
+	var limit uint = 1
+	facets, err := r.Facets(context.Background(), &model.ReferenceFilter{
+		Namespace:     []string{`laptops`},
+		TermID:        [][]uint64{{92, 93}},
+		ExcludeTermID: [][]uint64{{94}},
+		Limit:         &limit,
+		WithSubterms:  true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), facets.Terms[0].Count)
+	assert.Equal(t, []uint64{2}, captor.Last().NamespaceID)
+	assert.Equal(t, [][]uint64{{92, 93}}, captor.Last().TermID)
+	assert.Equal(t, [][]uint64{{94}}, captor.Last().ExcludeTermID)
+	assert.True(t, captor.Last().WithSubterms)
+	assert.Nil(t, captor.Last().Limit)
+}
+
+func TestService_GetTerms(t *testing.T) {
+	mock.SetUp(t)
+
+	ns := mock.Mock[taxonomy.Namespace]()
+	mock.When(ns.GetByName(mock.Any[context.Context](), mock.Exact[string](`laptops`))).
+		ThenReturn(&model.Namespace{ID: 2, Data: model.NamespaceData{Name: `laptops`}}, nil)
+
+	var (
+		apple  = &model.Term{ID: 10, Data: model.TermData{Name: `apple`, VocabularyID: []uint64{5}}}
+		laptop = &model.Term{ID: 11, Data: model.TermData{Name: `laptop`, VocabularyID: []uint64{7, 5}}}
+	)
+
+	ref := mock.Mock[repository.Reference]()
+	mock.When(ref.Terms(mock.Any[context.Context](), mock.Equal[uint64](2), mock.Any[[]model.EntityID]()...)).
+		ThenReturn(map[model.EntityID][]*model.Term{`macbook`: {apple, laptop}}, nil)
+
+	r := reference.New(&reference.Config{
+		NamespaceService:    ns,
+		ReferenceRepository: ref,
+		Logger:              zap.NewNop(),
+	})
+
+	entities, err := r.GetTerms(context.Background(), `laptops`, `xps`, `macbook`)
+	require.NoError(t, err)
+	require.Len(t, entities, 2)
+	assert.Equal(t, model.EntityID(`xps`), entities[0].EntityID)
+	assert.Empty(t, entities[0].Vocabularies)
+	assert.Equal(t, model.EntityID(`macbook`), entities[1].EntityID)
+	assert.Equal(t, []*model.VocabularyTerms{
+		{VocabularyID: 5, Terms: []*model.Term{apple, laptop}},
+		{VocabularyID: 7, Terms: []*model.Term{laptop}},
+	}, entities[1].Vocabularies)
+}
+
+func TestService_Replace(t *testing.T) {
+	// Entity has terms 10 and 11 from vocabulary 5 and term 20 from vocabulary 7
+	var current = []*model.Term{
+		{ID: 10, Data: model.TermData{VocabularyID: []uint64{5}}},
+		{ID: 11, Data: model.TermData{VocabularyID: []uint64{5}}},
+		{ID: 20, Data: model.TermData{VocabularyID: []uint64{7}}},
+	}
+
+	tests := []struct {
+		name         string
+		vocabularyID *uint64
+		termsID      []uint64
+		added        []uint64
+		removed      []uint64
+		err          error
+	}{
+		{
+			name:    `replace all`,
+			termsID: []uint64{11, 12, 12},
+			added:   []uint64{12},
+			removed: []uint64{10, 20},
+		},
+		{
+			name:    `nothing changed`,
+			termsID: []uint64{20, 11, 10},
+			added:   []uint64{},
+			removed: []uint64{},
+		},
+		{
+			name:         `replace in vocabulary`,
+			vocabularyID: lo.ToPtr[uint64](5),
+			termsID:      []uint64{12},
+			added:        []uint64{12},
+			removed:      []uint64{10, 11},
+		},
+		{
+			name:         `term from other vocabulary`,
+			vocabularyID: lo.ToPtr[uint64](5),
+			termsID:      []uint64{21},
+			err:          taxonomy.ErrTermNotInVocabulary,
+		},
+		{
+			name:    `unknown term`,
+			termsID: []uint64{99},
+			err:     taxonomy.ErrTermNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+			var ctx = context.Background()
+
+			ns := mock.Mock[taxonomy.Namespace]()
+			mock.When(ns.GetByName(mock.Any[context.Context](), mock.Exact[string](`laptops`))).
+				ThenReturn(&model.Namespace{ID: 2}, nil)
+
+			termService := mock.Mock[taxonomy.Term]()
+			if len(tt.added) > 0 || tt.err != nil {
+				mock.When(termService.GetByID(mock.Any[context.Context](), mock.Any[uint64]())).
+					ThenAnswer(func(args []any) []any {
+						switch id := args[1].(uint64); id {
+						case 12:
+							return []any{&model.Term{ID: id, Data: model.TermData{VocabularyID: []uint64{5}}}, nil}
+						case 21:
+							return []any{&model.Term{ID: id, Data: model.TermData{VocabularyID: []uint64{7}}}, nil}
+						default:
+							return []any{nil, taxonomy.ErrTermNotFound}
+						}
+					})
+			}
+
+			ref := mock.Mock[repository.Reference]()
+			mock.When(ref.Terms(mock.Any[context.Context](), mock.Equal[uint64](2), mock.Any[[]model.EntityID]()...)).
+				ThenReturn(map[model.EntityID][]*model.Term{`xps`: current}, nil)
+
+			deleted := mock.Captor[*repository.ReferenceFilter]()
+			if len(tt.removed) > 0 {
+				mock.When(ref.Delete(mock.Any[context.Context](), deleted.Capture())).ThenReturn(nil)
+			}
+
+			var set []*repository.ReferenceModel
+			if len(tt.added) > 0 {
+				mock.When(ref.Set(mock.Any[context.Context](), mock.Any[[]*repository.ReferenceModel]()...)).
+					ThenAnswer(func(args []any) []any {
+						set = args[1].([]*repository.ReferenceModel)
+
+						return []any{nil}
+					})
+			}
+
+			r := reference.New(&reference.Config{
+				NamespaceService:    ns,
+				ReferenceRepository: ref,
+				TermService:         termService,
+				Logger:              zap.NewNop(),
+			})
+
+			var (
+				changes *model.ReferenceChanges
+				err     error
+			)
+			if tt.vocabularyID != nil {
+				changes, err = r.ReplaceInVocabulary(ctx, `laptops`, `xps`, *tt.vocabularyID, tt.termsID)
+			} else {
+				changes, err = r.Replace(ctx, `laptops`, `xps`, tt.termsID)
+			}
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.added, changes.Added)
+			assert.Equal(t, tt.removed, changes.Removed)
+
+			if len(tt.removed) > 0 {
+				assert.Equal(t, [][]uint64{tt.removed}, deleted.Last().TermID)
+				assert.Equal(t, []model.EntityID{`xps`}, deleted.Last().EntityID)
+			}
+
+			if len(tt.added) > 0 {
+				require.Len(t, set, len(tt.added))
+				assert.Equal(t, tt.added[0], set[0].TermID)
+				assert.Equal(t, uint64(2), set[0].NamespaceID)
+			}
+		})
+	}
+}
+
+type txKey struct{}
+
+// transaction puts marker into context, so tests could check repositories are called inside transaction.
+type transaction struct {
+	runs int
+	err  error
+}
+
+func (tx *transaction) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx.runs++
+	tx.err = fn(context.WithValue(ctx, txKey{}, tx))
+
+	return tx.err
+}
+
+func TestService_ReplaceInTransaction(t *testing.T) {
+	mock.SetUp(t)
+
+	var (
+		ctx = context.Background()
+		tx  = new(transaction)
+	)
+
+	inTx := func() context.Context {
+		return mock.Match(mock.CreateMatcher(`in transaction`, func(_ []any, ctx context.Context) bool {
+			return ctx.Value(txKey{}) == tx
+		}))
+	}
+
+	ns := mock.Mock[taxonomy.Namespace]()
+	mock.When(ns.GetByName(inTx(), mock.Exact[string](`laptops`))).
+		ThenReturn(&model.Namespace{ID: 2}, nil)
+
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.GetByID(inTx(), mock.Equal[uint64](12))).
+		ThenReturn(&model.Term{ID: 12}, nil)
+
+	ref := mock.Mock[repository.Reference]()
+	mock.When(ref.Terms(inTx(), mock.Equal[uint64](2), mock.Any[[]model.EntityID]()...)).
+		ThenReturn(map[model.EntityID][]*model.Term{`xps`: {{ID: 10}}}, nil)
+	mock.When(ref.Delete(inTx(), mock.Any[*repository.ReferenceFilter]())).ThenReturn(nil)
+	mock.When(ref.Set(inTx(), mock.Any[[]*repository.ReferenceModel]()...)).ThenReturn(io.EOF)
+
+	_, err := reference.New(&reference.Config{
+		Transaction:         tx,
+		NamespaceService:    ns,
+		ReferenceRepository: ref,
+		TermService:         termService,
+		Logger:              zap.NewNop(),
+	}).Replace(ctx, `laptops`, `xps`, []uint64{12})
+
+	assert.ErrorIs(t, err, taxonomy.ErrReferenceNotCreated)
+	assert.Equal(t, 1, tx.runs)
+	// Error is returned from the transaction to roll back removed references
+	assert.ErrorIs(t, tx.err, io.EOF)
+}
+
 //func TestTermService_GetReferences(t *testing.T) {
 //	//	t.Parallel()
 //	//
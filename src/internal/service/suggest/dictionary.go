@@ -0,0 +1,235 @@
+package suggest
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+// compactSize is a count of changed terms after which they are merged into sorted keys.
+const compactSize = 1000
+
+// entry is a name, a synonym or a title of the term, rank orders fields of the same term.
+type entry struct {
+	term  *model.Term
+	text  string
+	field model.SearchField
+	rank  int
+}
+
+// key is normalized text of the entry starting at one of its words, so "dark red" is found by "red" too.
+type key struct {
+	text  string
+	entry *entry
+	first bool // the key starts at the beginning of the text
+}
+
+// dictionary keeps keys of all terms sorted like leaves of a trie: keys with the same prefix are neighbours, so
+// completions of a prefix are found by binary search and read sequentially. Keys of changed terms are kept aside
+// until there are compactSize of them, then they are merged into sorted keys. It isn't safe for concurrent use.
+type dictionary struct {
+	terms map[uint64]*model.Term
+	keys  []key
+	// changed terms aren't in keys yet, deleted terms are nil
+	changed     map[uint64]*model.Term
+	changedKeys []key
+}
+
+func newDictionary(terms []*model.Term) *dictionary {
+	var d = &dictionary{
+		terms:   make(map[uint64]*model.Term, len(terms)),
+		changed: make(map[uint64]*model.Term),
+	}
+
+	for _, term := range terms {
+		d.terms[term.ID] = term
+		d.keys = append(d.keys, keys(term)...)
+	}
+
+	slices.SortFunc(d.keys, func(a, b key) int {
+		return strings.Compare(a.text, b.text)
+	})
+
+	return d
+}
+
+// put replaces keys of the term with keys of its name, synonyms, titles and translated titles.
+func (d *dictionary) put(term *model.Term) {
+	d.change(term.ID, term)
+}
+
+func (d *dictionary) remove(id uint64) {
+	d.change(id, nil)
+}
+
+func (d *dictionary) change(id uint64, term *model.Term) {
+	d.changed[id] = term
+	d.changedKeys = slices.DeleteFunc(d.changedKeys, func(k key) bool {
+		return k.entry.term.ID == id
+	})
+
+	if term != nil {
+		d.changedKeys = append(d.changedKeys, keys(term)...)
+	}
+
+	if len(d.changed) >= compactSize {
+		*d = *d.compact()
+	}
+}
+
+// compact returns dictionary with changed keys merged into sorted keys, keys of terms aren't built again.
+func (d *dictionary) compact() *dictionary {
+	var compacted = &dictionary{
+		terms:   make(map[uint64]*model.Term, len(d.terms)),
+		keys:    make([]key, 0, len(d.keys)+len(d.changedKeys)),
+		changed: make(map[uint64]*model.Term),
+	}
+
+	for _, term := range d.all() {
+		compacted.terms[term.ID] = term
+	}
+
+	slices.SortFunc(d.changedKeys, func(a, b key) int {
+		return strings.Compare(a.text, b.text)
+	})
+
+	var i, j int
+
+	for i < len(d.keys) || j < len(d.changedKeys) {
+		if i < len(d.keys) {
+			if _, ok := d.changed[d.keys[i].entry.term.ID]; ok {
+				i++
+
+				continue
+			}
+		}
+
+		if j == len(d.changedKeys) || i < len(d.keys) && d.keys[i].text <= d.changedKeys[j].text {
+			compacted.keys = append(compacted.keys, d.keys[i])
+			i++
+		} else {
+			compacted.keys = append(compacted.keys, d.changedKeys[j])
+			j++
+		}
+	}
+
+	return compacted
+}
+
+// all returns current terms including changed ones.
+func (d *dictionary) all() []*model.Term {
+	var terms = make([]*model.Term, 0, len(d.terms)+len(d.changed))
+
+	for id, term := range d.terms {
+		if _, ok := d.changed[id]; !ok {
+			terms = append(terms, term)
+		}
+	}
+
+	for _, term := range d.changed {
+		if term != nil {
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
+
+// find calls fn for every key starting with the normalized prefix, exact is true when the key equals the prefix.
+func (d *dictionary) find(prefix string, fn func(k *key, exact bool)) {
+	for i := sort.Search(len(d.keys), func(i int) bool {
+		return d.keys[i].text >= prefix
+	}); i < len(d.keys) && strings.HasPrefix(d.keys[i].text, prefix); i++ {
+		if _, ok := d.changed[d.keys[i].entry.term.ID]; ok {
+			continue
+		}
+
+		fn(&d.keys[i], len(d.keys[i].text) == len(prefix))
+	}
+
+	for i := range d.changedKeys {
+		if strings.HasPrefix(d.changedKeys[i].text, prefix) {
+			fn(&d.changedKeys[i], len(d.changedKeys[i].text) == len(prefix))
+		}
+	}
+}
+
+// keys returns keys of every word of the term's name, synonyms and titles. The same key is returned once for the
+// entry with the best rank.
+func keys(term *model.Term) []key {
+	var entries = []*entry{{term: term, text: term.Data.Name, field: model.SearchName}}
+
+	for _, synonym := range term.Data.Synonyms {
+		var rank = 1
+		if synonym.Hidden {
+			rank = 3 //nolint:gomnd
+		}
+
+		entries = append(entries, &entry{term: term, text: synonym.Name, field: model.SearchSynonym, rank: rank})
+	}
+
+	for _, title := range append([]string{term.Data.Title}, titles(term.Data.Labels)...) {
+		entries = append(entries, &entry{term: term, text: title, field: model.SearchTitle, rank: 2}) //nolint:gomnd
+	}
+
+	slices.SortStableFunc(entries, func(a, b *entry) int {
+		return a.rank - b.rank
+	})
+
+	var (
+		seen = make(map[string]bool)
+		keys = make([]key, 0, len(entries))
+	)
+
+	for _, e := range entries {
+		text, starts := normalize(e.text)
+
+		// Keys are substrings of the normalized text, so they share its memory
+		for i, start := range starts {
+			if seen[text[start:]] {
+				continue
+			}
+
+			seen[text[start:]] = true
+			keys = append(keys, key{text: text[start:], entry: e, first: i == 0})
+		}
+	}
+
+	return keys
+}
+
+func titles(labels model.Labels) []string {
+	var titles = make([]string, 0, len(labels))
+
+	for _, label := range labels {
+		if label.Title != `` {
+			titles = append(titles, label.Title)
+		}
+	}
+
+	return titles
+}
+
+// normalize returns lowercase words of letters and digits separated by spaces and offsets of the words.
+func normalize(text string) (string, []int) {
+	var (
+		b      strings.Builder
+		starts []int
+	)
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+
+		starts = append(starts, b.Len())
+		b.WriteString(word)
+	}
+
+	return b.String(), starts
+}
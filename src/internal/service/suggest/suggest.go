@@ -0,0 +1,380 @@
+package suggest
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultRefreshInterval = 5 * time.Minute
+	DefaultLimit           = 10
+	// loadBatch is a count of terms loaded into the index by one request.
+	loadBatch = 1000
+)
+
+type Config struct {
+	// TermService loads terms into the index, it shouldn't be the one returned by Watch.
+	TermService taxonomy.Term
+	// ReferenceService counts entities of namespaces related with terms to order suggestions by popularity, and
+	// relates entities with terms mentioned in their texts.
+	ReferenceService taxonomy.Reference
+	Logger           *zap.Logger
+	// RefreshInterval is an age of the index and of popularity of terms after which they are reloaded in background,
+	// so terms changed by other instances and rolled back changes are caught up. DefaultRefreshInterval is used when
+	// it's zero.
+	RefreshInterval time.Duration
+}
+
+func New(config *Config) *Service {
+	var refreshInterval = config.RefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+
+	return &Service{
+		termService:      config.TermService,
+		referenceService: config.ReferenceService,
+		log:              config.Logger,
+		refreshInterval:  refreshInterval,
+		popularity:       make(map[string]*popularity),
+	}
+}
+
+// Service keeps in-memory index of terms. The index is loaded by Load or by the first suggestion, so commands which
+// don't suggest terms don't pay for it.
+type Service struct {
+	termService      taxonomy.Term
+	referenceService taxonomy.Reference
+	log              *zap.Logger
+	refreshInterval  time.Duration
+
+	// loading allows one load of the index at once
+	loading sync.Mutex
+
+	mu         sync.RWMutex
+	dictionary *dictionary // nil until the index is loaded
+	loadedAt   time.Time
+	// changes made while the index is loaded are applied to the loaded index too, because it could be read before
+	rebuilding bool
+	pending    []func(d *dictionary)
+	// version is changed by every change of the index, so the tagger is rebuilt
+	version uint64
+
+	taggerMu sync.Mutex
+	tagger   *tagger
+	tagged   uint64 // version of the index the tagger is built from
+
+	popularityMu sync.Mutex
+	popularity   map[string]*popularity
+}
+
+// popularity keeps counts of entities of the namespace related with every term.
+type popularity struct {
+	counts     map[uint64]uint64
+	loadedAt   time.Time
+	refreshing bool
+}
+
+// Load loads all terms into a new index and replaces the current one.
+func (s *Service) Load(ctx context.Context) error {
+	s.loading.Lock()
+	defer s.loading.Unlock()
+
+	return s.load(ctx)
+}
+
+func (s *Service) load(ctx context.Context) error {
+	logger := s.log.With(zap.String(`method`, `Load`))
+
+	s.mu.Lock()
+	s.rebuilding = true
+	s.mu.Unlock()
+
+	var (
+		terms   = make([]*model.Term, 0)
+		afterID *uint64
+	)
+
+	for {
+		batch, err := s.termService.Get(ctx, &model.TermFilter{AfterID: afterID, Limit: loadBatch})
+		if err != nil {
+			s.mu.Lock()
+			s.rebuilding, s.pending = false, nil
+			s.mu.Unlock()
+
+			return fmt.Errorf(`load terms: %w`, err)
+		}
+
+		terms = append(terms, batch...)
+
+		if len(batch) < loadBatch {
+			break
+		}
+
+		afterID = &batch[len(batch)-1].ID
+	}
+
+	var loaded = newDictionary(terms)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, change := range s.pending {
+		change(loaded)
+	}
+
+	s.dictionary, s.loadedAt = loaded, time.Now()
+	s.rebuilding, s.pending = false, nil
+	s.version++
+
+	logger.Debug(`index loaded`, zap.Int(`terms`, len(terms)))
+
+	return nil
+}
+
+// index loads the index when it isn't loaded and reloads it in background when it's older than refreshInterval.
+func (s *Service) index(ctx context.Context) error {
+	s.mu.RLock()
+	loaded, stale := s.dictionary != nil, time.Since(s.loadedAt) > s.refreshInterval
+	s.mu.RUnlock()
+
+	if !loaded {
+		s.loading.Lock()
+		defer s.loading.Unlock()
+
+		// Index could be loaded while waiting for the lock
+		s.mu.RLock()
+		loaded = s.dictionary != nil
+		s.mu.RUnlock()
+
+		if loaded {
+			return nil
+		}
+
+		return s.load(ctx)
+	}
+
+	if stale && s.loading.TryLock() {
+		go func() {
+			defer s.loading.Unlock()
+
+			if err := s.load(context.WithoutCancel(ctx)); err != nil {
+				s.log.Error(`index isn't refreshed`, zap.Error(err))
+			}
+		}()
+	}
+
+	return nil
+}
+
+// apply changes the loaded index, the index which isn't loaded yet will get changes from the repository.
+func (s *Service) apply(change func(d *dictionary)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dictionary != nil {
+		change(s.dictionary)
+		s.version++
+	}
+
+	if s.rebuilding {
+		s.pending = append(s.pending, change)
+	}
+}
+
+func (s *Service) put(terms ...*model.Term) {
+	s.apply(func(d *dictionary) {
+		for _, term := range terms {
+			// Copy keeps the index unchanged when the caller changes the term
+			var indexed = *term
+			d.put(&indexed)
+		}
+	})
+}
+
+func (s *Service) remove(id uint64) {
+	s.apply(func(d *dictionary) {
+		d.remove(id)
+	})
+}
+
+// counts returns popularity of terms in the namespace, it's reloaded in background when it's older than
+// refreshInterval.
+func (s *Service) counts(ctx context.Context, namespace string) (map[uint64]uint64, error) {
+	s.popularityMu.Lock()
+	defer s.popularityMu.Unlock()
+
+	p, ok := s.popularity[namespace]
+	if !ok {
+		counts, err := s.loadCounts(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		s.popularity[namespace] = &popularity{counts: counts, loadedAt: time.Now()}
+
+		return counts, nil
+	}
+
+	if time.Since(p.loadedAt) > s.refreshInterval && !p.refreshing {
+		p.refreshing = true
+
+		go func() {
+			counts, err := s.loadCounts(context.WithoutCancel(ctx), namespace)
+
+			s.popularityMu.Lock()
+			defer s.popularityMu.Unlock()
+
+			p.refreshing = false
+
+			if err != nil {
+				s.log.Error(`popularity isn't refreshed`, zap.String(`namespace`, namespace), zap.Error(err))
+
+				return
+			}
+
+			p.counts, p.loadedAt = counts, time.Now()
+		}()
+	}
+
+	return p.counts, nil
+}
+
+func (s *Service) loadCounts(ctx context.Context, namespace string) (map[uint64]uint64, error) {
+	facets, err := s.referenceService.Facets(ctx, &model.ReferenceFilter{Namespace: []string{namespace}})
+	if err != nil {
+		return nil, fmt.Errorf(`count references of namespace %q: %w`, namespace, err)
+	}
+
+	var counts = make(map[uint64]uint64, len(facets.Terms))
+	for _, facet := range facets.Terms {
+		counts[facet.TermID] = facet.Count
+	}
+
+	return counts, nil
+}
+
+// candidate is a found key, the key is copied because changed keys are moved by later changes.
+type candidate struct {
+	key        key
+	exact      bool
+	popularity uint64
+}
+
+// better orders candidates: exact matches, popular terms, matches from the beginning of texts, names before
+// synonyms and titles, shorter texts.
+func (c *candidate) better(other *candidate) bool {
+	switch {
+	case c.exact != other.exact:
+		return c.exact
+	case c.popularity != other.popularity:
+		return c.popularity > other.popularity
+	case c.key.first != other.key.first:
+		return c.key.first
+	case c.key.entry.rank != other.key.entry.rank:
+		return c.key.entry.rank < other.key.entry.rank
+	case len(c.key.entry.text) != len(other.key.entry.text):
+		return len(c.key.entry.text) < len(other.key.entry.text)
+	default:
+		return c.key.entry.term.ID < other.key.entry.term.ID
+	}
+}
+
+func (s *Service) Suggest(ctx context.Context, suggest *model.TermSuggest) ([]*model.TermSuggestion, error) {
+	logger := s.log.With(zap.String(`method`, `Suggest`), zap.Any(`suggest`, suggest))
+
+	var prefix, _ = normalize(suggest.Prefix)
+	if prefix == `` {
+		return []*model.TermSuggestion{}, nil
+	}
+
+	if err := s.index(ctx); err != nil {
+		logger.Error(`index isn't loaded`, zap.Error(err))
+
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var counts map[uint64]uint64
+
+	if suggest.Namespace != `` {
+		var err error
+		if counts, err = s.counts(ctx, suggest.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	var matches = new(candidates)
+
+	s.mu.RLock()
+	s.dictionary.find(prefix, func(k *key, exact bool) {
+		if len(suggest.VocabularyID) > 0 && !slices.ContainsFunc(k.entry.term.Data.VocabularyID, func(id uint64) bool {
+			return slices.Contains(suggest.VocabularyID, id)
+		}) {
+			return
+		}
+
+		// Only the whole text is an exact match, "red" is a completion of "dark red"
+		matches.items = append(matches.items, candidate{
+			key:        *k,
+			exact:      exact && k.first,
+			popularity: counts[k.entry.term.ID],
+		})
+	})
+	s.mu.RUnlock()
+
+	var limit = int(suggest.Limit)
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	// Short prefixes match a lot of keys, so only the best ones are taken from the heap. The first key of every
+	// term is the best one, others are skipped.
+	var (
+		suggestions = make([]*model.TermSuggestion, 0, min(limit, len(matches.items)))
+		seen        = make(map[uint64]bool)
+	)
+
+	heap.Init(matches)
+
+	for matches.Len() > 0 && len(suggestions) < limit {
+		c := heap.Pop(matches).(candidate) //nolint:forcetypeassert
+		if seen[c.key.entry.term.ID] {
+			continue
+		}
+
+		seen[c.key.entry.term.ID] = true
+		suggestions = append(suggestions, &model.TermSuggestion{
+			Term:       c.key.entry.term,
+			Text:       c.key.entry.text,
+			Field:      c.key.entry.field,
+			Popularity: c.popularity,
+		})
+	}
+
+	return suggestions, nil
+}
+
+// candidates implements heap.Interface, the best candidate is popped first.
+type candidates struct {
+	items []candidate
+}
+
+func (c *candidates) Len() int           { return len(c.items) }
+func (c *candidates) Less(i, j int) bool { return c.items[i].better(&c.items[j]) }
+func (c *candidates) Swap(i, j int)      { c.items[i], c.items[j] = c.items[j], c.items[i] }
+func (c *candidates) Push(x any)         { c.items = append(c.items, x.(candidate)) } //nolint:forcetypeassert
+
+func (c *candidates) Pop() any {
+	last := c.items[len(c.items)-1]
+	c.items = c.items[:len(c.items)-1]
+
+	return last
+}
@@ -0,0 +1,230 @@
+package suggest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/suggest"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var dictionary = []*model.Term{
+	{ID: 1, Data: model.TermData{Name: `red`, Title: `Red`, VocabularyID: []uint64{1},
+		Labels: model.Labels{`de`: {Title: `Rot`}}}},
+	{ID: 2, Data: model.TermData{Name: `dark-red`, Title: `Dark red`, VocabularyID: []uint64{1}}},
+	{ID: 3, Data: model.TermData{Name: `reddish`, VocabularyID: []uint64{1},
+		Synonyms: []model.Synonym{{Name: `rosy`}, {Name: `redish`, Hidden: true}}}},
+	{ID: 4, Data: model.TermData{Name: `rectangle`, VocabularyID: []uint64{2}}},
+}
+
+// terms mocks term service which returns the dictionary.
+func terms() taxonomy.Term {
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.Get(mock.Any[context.Context](), mock.Any[*model.TermFilter]())).
+		ThenReturn(dictionary, nil)
+
+	return termService
+}
+
+func names(suggestions []*model.TermSuggestion) []string {
+	var names = make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		names = append(names, s.Term.Data.Name)
+	}
+
+	return names
+}
+
+func TestService_Suggest(t *testing.T) {
+	tests := []struct {
+		name    string
+		suggest *model.TermSuggest
+		names   []string
+		text    string
+		field   model.SearchField
+	}{
+		{
+			name:    `exact goes first`,
+			suggest: &model.TermSuggest{Prefix: `Red`},
+			names:   []string{`red`, `reddish`, `dark-red`},
+			text:    `red`,
+			field:   model.SearchName,
+		},
+		{
+			name:    `beginning of text goes before word`,
+			suggest: &model.TermSuggest{Prefix: `re`},
+			names:   []string{`red`, `reddish`, `rectangle`, `dark-red`},
+		},
+		{
+			name:    `vocabulary`,
+			suggest: &model.TermSuggest{Prefix: `re`, VocabularyID: []uint64{2}},
+			names:   []string{`rectangle`},
+		},
+		{
+			name:    `limit`,
+			suggest: &model.TermSuggest{Prefix: `re`, Limit: 1},
+			names:   []string{`red`},
+		},
+		{
+			name:    `words`,
+			suggest: &model.TermSuggest{Prefix: `dark r`},
+			names:   []string{`dark-red`},
+			text:    `dark-red`,
+			field:   model.SearchName,
+		},
+		{
+			name:    `synonym`,
+			suggest: &model.TermSuggest{Prefix: `ros`},
+			names:   []string{`reddish`},
+			text:    `rosy`,
+			field:   model.SearchSynonym,
+		},
+		{
+			name:    `translated title`,
+			suggest: &model.TermSuggest{Prefix: `ROT`},
+			names:   []string{`red`},
+			text:    `Rot`,
+			field:   model.SearchTitle,
+		},
+		{
+			name:    `not found`,
+			suggest: &model.TermSuggest{Prefix: `blue`},
+			names:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			found, err := suggest.New(&suggest.Config{
+				TermService: terms(),
+				Logger:      zap.NewNop(),
+			}).Suggest(context.Background(), tt.suggest)
+			require.NoError(t, err)
+			assert.Equal(t, tt.names, names(found))
+
+			if tt.text != `` {
+				assert.Equal(t, tt.text, found[0].Text)
+				assert.Equal(t, tt.field, found[0].Field)
+			}
+		})
+	}
+}
+
+func TestService_SuggestPopular(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	filter := mock.Captor[*model.ReferenceFilter]()
+	mock.When(referenceService.Facets(mock.Any[context.Context](), filter.Capture())).
+		ThenReturn(&model.Facets{Terms: []*model.TermFacet{{TermID: 2, Count: 7}, {TermID: 4, Count: 3}}}, nil)
+
+	service := suggest.New(&suggest.Config{
+		TermService:      terms(),
+		ReferenceService: referenceService,
+		Logger:           zap.NewNop(),
+	})
+
+	// Popularity is loaded once
+	for range 2 {
+		found, err := service.Suggest(context.Background(), &model.TermSuggest{Prefix: `re`, Namespace: `products`})
+		require.NoError(t, err)
+		assert.Equal(t, []string{`dark-red`, `rectangle`, `red`, `reddish`}, names(found))
+		assert.Equal(t, uint64(7), found[0].Popularity)
+	}
+
+	assert.Len(t, filter.Values(), 1)
+	assert.Equal(t, []string{`products`}, filter.Last().Namespace)
+}
+
+func TestService_Watch(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	termService := terms()
+	mock.When(termService.Create(mock.Any[context.Context](), mock.Any[*model.TermData]())).
+		ThenReturn(&model.Term{ID: 5, Data: model.TermData{Name: `ruby`}}, nil)
+	mock.When(termService.Update(mock.Any[context.Context](), mock.Equal[uint64](1), mock.Any[*model.TermData]())).
+		ThenReturn(&model.Term{ID: 1, Data: model.TermData{Name: `scarlet`}}, nil)
+	mock.When(termService.Delete(mock.Any[context.Context](), mock.Equal[uint64](3))).
+		ThenReturn(nil)
+
+	service := suggest.New(&suggest.Config{TermService: termService, Logger: zap.NewNop()})
+	watched := service.Watch(termService)
+
+	require.NoError(t, service.Load(ctx))
+
+	_, err := watched.Create(ctx, &model.TermData{Name: `ruby`})
+	require.NoError(t, err)
+	_, err = watched.Update(ctx, 1, &model.TermData{Name: `scarlet`})
+	require.NoError(t, err)
+	require.NoError(t, watched.Delete(ctx, 3))
+
+	found, err := service.Suggest(ctx, &model.TermSuggest{Prefix: `r`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`ruby`, `rectangle`, `dark-red`}, names(found))
+
+	found, err = service.Suggest(ctx, &model.TermSuggest{Prefix: `sc`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`scarlet`}, names(found))
+}
+
+func TestService_SuggestEmpty(t *testing.T) {
+	mock.SetUp(t)
+
+	// Index isn't loaded for prefix without words
+	found, err := suggest.New(&suggest.Config{
+		TermService: mock.Mock[taxonomy.Term](),
+		Logger:      zap.NewNop(),
+	}).Suggest(context.Background(), &model.TermSuggest{Prefix: ` - `})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestService_WatchCompact(t *testing.T) {
+	mock.SetUp(t)
+
+	var (
+		ctx     = context.Background()
+		created = make([]*model.Term, 0)
+	)
+
+	for i := range 1500 {
+		created = append(created, &model.Term{ID: uint64(10 + i), Data: model.TermData{Name: fmt.Sprintf(`blue %d`, i)}})
+	}
+
+	termService := terms()
+	mock.When(termService.CreateBulk(mock.Any[context.Context](), mock.Any[[]*model.TermData]()...)).
+		ThenReturn(created, nil)
+	mock.When(termService.Delete(mock.Any[context.Context](), mock.Equal[uint64](10))).
+		ThenReturn(nil)
+
+	service := suggest.New(&suggest.Config{TermService: termService, Logger: zap.NewNop()})
+	watched := service.Watch(termService)
+
+	require.NoError(t, service.Load(ctx))
+
+	// Changes are merged into sorted keys after a thousand of them
+	_, err := watched.CreateBulk(ctx, &model.TermData{})
+	require.NoError(t, err)
+	require.NoError(t, watched.Delete(ctx, 10))
+
+	found, err := service.Suggest(ctx, &model.TermSuggest{Prefix: `blue 1`, Limit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`blue 1`, `blue 10`, `blue 11`}, names(found))
+
+	found, err = service.Suggest(ctx, &model.TermSuggest{Prefix: `blue 0`})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	found, err = service.Suggest(ctx, &model.TermSuggest{Prefix: `re`, Limit: 100})
+	require.NoError(t, err)
+	assert.Len(t, found, 4)
+}
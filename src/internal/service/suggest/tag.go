@@ -0,0 +1,136 @@
+package suggest
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"go.uber.org/zap"
+)
+
+// current returns the tagger built from the current index, it's rebuilt by the first tagging after the index is
+// changed.
+func (s *Service) current() *tagger {
+	s.taggerMu.Lock()
+	defer s.taggerMu.Unlock()
+
+	s.mu.RLock()
+	if s.tagger != nil && s.tagged == s.version {
+		s.mu.RUnlock()
+
+		return s.tagger
+	}
+
+	var version, terms = s.version, s.dictionary.all()
+	s.mu.RUnlock()
+
+	s.tagger, s.tagged = newTagger(terms), version
+
+	return s.tagger
+}
+
+func (s *Service) Tag(ctx context.Context, tagging *model.TextTagging) ([]*model.TextTag, error) {
+	logger := s.log.With(zap.String(`method`, `Tag`), zap.Any(`reference`, tagging.Reference))
+
+	var tokens = tokenize(tagging.Text)
+	if len(tokens) == 0 {
+		return []*model.TextTag{}, nil
+	}
+
+	if err := s.index(ctx); err != nil {
+		logger.Error(`index isn't loaded`, zap.Error(err))
+
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var mentions = slices.DeleteFunc(s.current().find(tokens), func(m mention) bool {
+		return len(tagging.VocabularyID) > 0 && !slices.ContainsFunc(m.phrase.term.Data.VocabularyID,
+			func(id uint64) bool {
+				return slices.Contains(tagging.VocabularyID, id)
+			})
+	})
+
+	// Mentions are ordered by offsets, longer mentions go first
+	slices.SortFunc(mentions, func(a, b mention) int {
+		switch {
+		case a.start != b.start:
+			return a.start - b.start
+		case a.end != b.end:
+			return b.end - a.end
+		default:
+			return cmp.Compare(a.phrase.term.ID, b.phrase.term.ID)
+		}
+	})
+
+	if !tagging.Overlapping {
+		mentions = longest(mentions)
+	}
+
+	var tags = make([]*model.TextTag, 0, len(mentions))
+
+	for _, m := range mentions {
+		first, last := tokens[m.start], tokens[m.end-1]
+		tags = append(tags, &model.TextTag{
+			Term:  m.phrase.term,
+			Text:  tagging.Text[first.start:last.end],
+			Start: first.offset,
+			End:   last.offset + last.length,
+			Field: m.phrase.field,
+		})
+	}
+
+	if tagging.Reference != nil {
+		if err := s.reference(ctx, tagging.Reference, tags); err != nil {
+			logger.Error(`references aren't created`, zap.Error(err))
+
+			return nil, err
+		}
+	}
+
+	return tags, nil
+}
+
+// longest returns leftmost longest mentions which don't overlap, so "dark red" hides "red". All terms mentioned by
+// the same words are kept.
+func longest(mentions []mention) []mention {
+	var (
+		selected = make([]mention, 0, len(mentions))
+		end      int
+	)
+
+	for _, m := range mentions {
+		if m.start >= end {
+			selected = append(selected, m)
+			end = m.end
+
+			continue
+		}
+
+		if last := selected[len(selected)-1]; m.start == last.start && m.end == last.end {
+			selected = append(selected, m)
+		}
+	}
+
+	return selected
+}
+
+// reference relates the entity with every mentioned term.
+func (s *Service) reference(ctx context.Context, reference *model.TagReference, tags []*model.TextTag) error {
+	var seen = make(map[uint64]bool)
+
+	for _, tag := range tags {
+		if seen[tag.Term.ID] {
+			continue
+		}
+
+		seen[tag.Term.ID] = true
+
+		if err := s.referenceService.Create(ctx, tag.Term.ID, reference.Namespace, reference.EntityID); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	return nil
+}
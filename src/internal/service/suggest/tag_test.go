@@ -0,0 +1,191 @@
+package suggest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/suggest"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// products are terms mentioned in descriptions of products.
+var products = []*model.Term{
+	{ID: 1, Data: model.TermData{Name: `red`, VocabularyID: []uint64{1}}},
+	{ID: 2, Data: model.TermData{Name: `dark-red`, VocabularyID: []uint64{1},
+		Synonyms: []model.Synonym{{Name: `Bordeaux`}}}},
+	{ID: 3, Data: model.TermData{Name: `red wine`, VocabularyID: []uint64{2}}},
+	{ID: 4, Data: model.TermData{Name: `crème brûlée`, VocabularyID: []uint64{2},
+		Synonyms: []model.Synonym{{Name: `burnt cream`, Hidden: true}}}},
+	{ID: 5, Data: model.TermData{Name: `wine`, VocabularyID: []uint64{2}}},
+	{ID: 6, Data: model.TermData{Name: `Red`, VocabularyID: []uint64{3}}},
+}
+
+func TestService_Tag(t *testing.T) {
+	type tag struct {
+		id         uint64
+		text       string
+		start, end int
+		field      model.SearchField
+	}
+
+	tests := []struct {
+		name    string
+		tagging *model.TextTagging
+		tags    []tag
+	}{
+		{
+			name:    `longest mention`,
+			tagging: &model.TextTagging{Text: `Dark red wine.`},
+			tags:    []tag{{2, `Dark red`, 0, 8, model.SearchName}, {5, `wine`, 9, 13, model.SearchName}},
+		},
+		{
+			name:    `overlapping`,
+			tagging: &model.TextTagging{Text: `Dark red wine`, Overlapping: true},
+			tags: []tag{
+				{2, `Dark red`, 0, 8, model.SearchName},
+				{3, `red wine`, 5, 13, model.SearchName},
+				{1, `red`, 5, 8, model.SearchName},
+				{6, `red`, 5, 8, model.SearchName},
+				{5, `wine`, 9, 13, model.SearchName},
+			},
+		},
+		{
+			name:    `same words of several terms`,
+			tagging: &model.TextTagging{Text: `RED`},
+			tags:    []tag{{1, `RED`, 0, 3, model.SearchName}, {6, `RED`, 0, 3, model.SearchName}},
+		},
+		{
+			name:    `vocabulary`,
+			tagging: &model.TextTagging{Text: `Dark red wine`, VocabularyID: []uint64{2}},
+			tags:    []tag{{3, `red wine`, 5, 13, model.SearchName}},
+		},
+		{
+			name:    `diacritics and offsets in characters`,
+			tagging: &model.TextTagging{Text: `Crème brûlée or creme brulee`},
+			tags: []tag{
+				{4, `Crème brûlée`, 0, 12, model.SearchName},
+				{4, `creme brulee`, 16, 28, model.SearchName},
+			},
+		},
+		{
+			name:    `synonyms`,
+			tagging: &model.TextTagging{Text: `Bordeaux with burnt  cream`},
+			tags: []tag{
+				{2, `Bordeaux`, 0, 8, model.SearchSynonym},
+				{4, `burnt  cream`, 14, 26, model.SearchSynonym},
+			},
+		},
+		{
+			name:    `word boundaries`,
+			tagging: &model.TextTagging{Text: `reddish redwine`},
+		},
+		{
+			name:    `punctuation breaks mention`,
+			tagging: &model.TextTagging{Text: `red, wine`},
+			tags: []tag{
+				{1, `red`, 0, 3, model.SearchName},
+				{6, `red`, 0, 3, model.SearchName},
+				{5, `wine`, 5, 9, model.SearchName},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termService := mock.Mock[taxonomy.Term]()
+			mock.When(termService.Get(mock.Any[context.Context](), mock.Any[*model.TermFilter]())).
+				ThenReturn(products, nil)
+
+			found, err := suggest.New(&suggest.Config{
+				TermService: termService,
+				Logger:      zap.NewNop(),
+			}).Tag(context.Background(), tt.tagging)
+			require.NoError(t, err)
+
+			var tags = make([]tag, 0)
+			for _, f := range found {
+				tags = append(tags, tag{f.Term.ID, f.Text, f.Start, f.End, f.Field})
+			}
+
+			assert.Equal(t, append(make([]tag, 0), tt.tags...), tags)
+		})
+	}
+}
+
+func TestService_TagWatch(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	termService := terms()
+	mock.When(termService.Create(mock.Any[context.Context](), mock.Any[*model.TermData]())).
+		ThenReturn(&model.Term{ID: 5, Data: model.TermData{Name: `ruby`}}, nil)
+
+	service := suggest.New(&suggest.Config{TermService: termService, Logger: zap.NewNop()})
+	watched := service.Watch(termService)
+
+	found, err := service.Tag(ctx, &model.TextTagging{Text: `ruby`})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	// Tagger is rebuilt after the index is changed
+	_, err = watched.Create(ctx, &model.TermData{Name: `ruby`})
+	require.NoError(t, err)
+
+	found, err = service.Tag(ctx, &model.TextTagging{Text: `ruby`})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, uint64(5), found[0].Term.ID)
+}
+
+func TestService_TagReference(t *testing.T) {
+	mock.SetUp(t)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	termID := mock.Captor[uint64]()
+	mock.When(referenceService.Create(mock.Any[context.Context](), termID.Capture(), mock.Equal(`products`),
+		mock.Equal([]model.EntityID{`42`})...)).
+		ThenReturn(nil)
+
+	found, err := suggest.New(&suggest.Config{
+		TermService:      terms(),
+		ReferenceService: referenceService,
+		Logger:           zap.NewNop(),
+	}).Tag(context.Background(), &model.TextTagging{
+		Text:      `Red, rosy and red again`,
+		Reference: &model.TagReference{Namespace: `products`, EntityID: `42`},
+	})
+	require.NoError(t, err)
+	assert.Len(t, found, 3)
+
+	// Every mentioned term is referenced once
+	assert.Equal(t, []uint64{1, 3}, termID.Values())
+}
+
+func TestService_TagReferenceError(t *testing.T) {
+	mock.SetUp(t)
+
+	var expected = errors.New(`namespace not found`)
+
+	referenceService := mock.Mock[taxonomy.Reference]()
+	mock.When(referenceService.Create(mock.Any[context.Context](), mock.Any[uint64](), mock.Any[string](),
+		mock.Any[[]model.EntityID]()...)).
+		ThenReturn(expected)
+
+	_, err := suggest.New(&suggest.Config{
+		TermService:      terms(),
+		ReferenceService: referenceService,
+		Logger:           zap.NewNop(),
+	}).Tag(context.Background(), &model.TextTagging{
+		Text:      `red`,
+		Reference: &model.TagReference{Namespace: `products`, EntityID: `42`},
+	})
+	assert.ErrorIs(t, err, expected)
+}
@@ -0,0 +1,249 @@
+package suggest
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// breaks are punctuation marks which end a mention, so "red. Wine" doesn't mention "red wine".
+const breaks = ".,;:!?()[]{}\"\n"
+
+// letters are folded to latin ones which aren't decomposed to a letter and a diacritic.
+var letters = strings.NewReplacer(`ß`, `ss`, `æ`, `ae`, `œ`, `oe`, `ø`, `o`, `ł`, `l`, `đ`, `d`, `ı`, `i`)
+
+// tagger is Aho-Corasick automaton which letters are words of names and synonyms of terms, so all mentions of terms
+// are found by one pass over words of the text and they always start and end at word boundaries. It's built from
+// a snapshot of the index and it's safe for concurrent use.
+type tagger struct {
+	words map[string]int32
+	nodes []node
+}
+
+type node struct {
+	next  map[int32]int32
+	fail  int32
+	out   int32 // the nearest node by fail links having phrases, -1 when there isn't one
+	depth int   // count of words
+	// phrases are terms having the name or a synonym ending at the node
+	phrases []phrase
+}
+
+type phrase struct {
+	term  *model.Term
+	field model.SearchField
+}
+
+// mention is a phrase found in words [start, end) of the text.
+type mention struct {
+	phrase     phrase
+	start, end int
+}
+
+// token is a folded word of the text with its offsets.
+type token struct {
+	word           string
+	start, end     int // bytes
+	offset, length int // runes
+	// follows is true when a break precedes the word
+	follows bool
+}
+
+func newTagger(terms []*model.Term) *tagger {
+	var t = &tagger{
+		words: make(map[string]int32),
+		nodes: []node{{out: -1}},
+	}
+
+	for _, term := range terms {
+		// The same phrase of the term is kept once, the name goes before synonyms
+		var seen = make(map[int32]bool)
+
+		t.add(phrase{term: term, field: model.SearchName}, term.Data.Name, seen)
+
+		for _, synonym := range term.Data.Synonyms {
+			t.add(phrase{term: term, field: model.SearchSynonym}, synonym.Name, seen)
+		}
+	}
+
+	t.link()
+
+	return t
+}
+
+func (t *tagger) add(p phrase, text string, seen map[int32]bool) {
+	var tokens = tokenize(text)
+	if len(tokens) == 0 {
+		return
+	}
+
+	var current int32
+
+	for _, token := range tokens {
+		word, ok := t.words[token.word]
+		if !ok {
+			word = int32(len(t.words))
+			t.words[token.word] = word
+		}
+
+		next, ok := t.nodes[current].next[word]
+		if !ok {
+			next = int32(len(t.nodes))
+			if t.nodes[current].next == nil {
+				t.nodes[current].next = make(map[int32]int32)
+			}
+
+			t.nodes[current].next[word] = next
+			t.nodes = append(t.nodes, node{out: -1, depth: t.nodes[current].depth + 1})
+		}
+
+		current = next
+	}
+
+	if !seen[current] {
+		seen[current] = true
+		t.nodes[current].phrases = append(t.nodes[current].phrases, p)
+	}
+}
+
+// link sets fail links by breadth-first traversal, fail link of a node leads to the longest suffix of its words
+// which is a prefix of some phrase.
+func (t *tagger) link() {
+	var queue = make([]int32, 0, len(t.nodes))
+
+	for _, child := range t.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		fail := t.nodes[current].fail
+		if len(t.nodes[fail].phrases) > 0 {
+			t.nodes[current].out = fail
+		} else {
+			t.nodes[current].out = t.nodes[fail].out
+		}
+
+		for word, child := range t.nodes[current].next {
+			t.nodes[child].fail = t.step(fail, word)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// step returns the node following the word from the current one, fail links are followed until the word is found.
+func (t *tagger) step(current, word int32) int32 {
+	for {
+		if next, ok := t.nodes[current].next[word]; ok {
+			return next
+		}
+
+		if current == 0 {
+			return 0
+		}
+
+		current = t.nodes[current].fail
+	}
+}
+
+// find returns all mentions including overlapping ones ordered by their ends.
+func (t *tagger) find(tokens []token) []mention {
+	var (
+		mentions = make([]mention, 0)
+		current  int32
+	)
+
+	for i, token := range tokens {
+		word, ok := t.words[token.word]
+		if token.follows || !ok {
+			current = 0
+		}
+
+		if !ok {
+			continue
+		}
+
+		current = t.step(current, word)
+
+		found := current
+		if len(t.nodes[found].phrases) == 0 {
+			found = t.nodes[found].out
+		}
+
+		for ; found != -1; found = t.nodes[found].out {
+			for _, p := range t.nodes[found].phrases {
+				mentions = append(mentions, mention{phrase: p, start: i + 1 - t.nodes[found].depth, end: i + 1})
+			}
+		}
+	}
+
+	return mentions
+}
+
+// tokenize returns words of letters and digits folded by fold.
+func tokenize(text string) []token {
+	var (
+		tokens   = make([]token, 0)
+		start    = -1
+		position int
+		current  token
+	)
+
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			if start == -1 {
+				start, current.start, current.offset = i, i, position
+			}
+
+			position++
+
+			continue
+		}
+
+		if start != -1 {
+			current.word, current.end, current.length = fold(text[start:i]), i, position-current.offset
+			tokens = append(tokens, current)
+			start, current = -1, token{}
+		}
+
+		if strings.ContainsRune(breaks, r) {
+			current.follows = true
+		}
+
+		position++
+	}
+
+	if start != -1 {
+		current.word, current.end, current.length = fold(text[start:]), len(text), position-current.offset
+		tokens = append(tokens, current)
+	}
+
+	return tokens
+}
+
+// fold returns lowercase word without diacritics, so "Crème" and "creme" are the same word.
+func fold(word string) string {
+	word = strings.ToLower(word)
+
+	for i := range len(word) {
+		if word[i] >= utf8.RuneSelf {
+			// Transformer keeps a state, so a new one is used for every word
+			stripped, _, err := transform.String(
+				transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), word)
+			if err == nil {
+				word = stripped
+			}
+
+			return letters.Replace(word)
+		}
+	}
+
+	return word
+}
@@ -0,0 +1,64 @@
+package suggest
+
+import (
+	"context"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+// Watch returns the term service which keeps the index fresh: created and updated terms are put into the index and
+// deleted terms are removed from it. Other methods are called as is.
+func (s *Service) Watch(termService taxonomy.Term) taxonomy.Term {
+	return &watched{Term: termService, index: s}
+}
+
+type watched struct {
+	taxonomy.Term
+	index *Service
+}
+
+func (w *watched) Create(ctx context.Context, data *model.TermData) (*model.Term, error) {
+	term, err := w.Term.Create(ctx, data)
+	if err == nil {
+		w.index.put(term)
+	}
+
+	return term, err //nolint:wrapcheck
+}
+
+func (w *watched) Update(ctx context.Context, id uint64, data *model.TermData) (*model.Term, error) {
+	term, err := w.Term.Update(ctx, id, data)
+	if err == nil {
+		w.index.put(term)
+	}
+
+	return term, err //nolint:wrapcheck
+}
+
+func (w *watched) Delete(ctx context.Context, id uint64) error {
+	err := w.Term.Delete(ctx, id)
+	if err == nil {
+		w.index.remove(id)
+	}
+
+	return err //nolint:wrapcheck
+}
+
+func (w *watched) CreateBulk(ctx context.Context, data ...*model.TermData) ([]*model.Term, error) {
+	terms, err := w.Term.CreateBulk(ctx, data...)
+	if err == nil {
+		w.index.put(terms...)
+	}
+
+	return terms, err //nolint:wrapcheck
+}
+
+func (w *watched) UpdateBulk(ctx context.Context, terms ...*model.Term) ([]*model.Term, error) {
+	updated, err := w.Term.UpdateBulk(ctx, terms...)
+	if err == nil {
+		w.index.put(updated...)
+	}
+
+	return updated, err //nolint:wrapcheck
+}
@@ -0,0 +1,278 @@
+package term
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// CreateBulk checks all terms with a few queries instead of queries per term and creates them at once.
+func (t *TermService) CreateBulk(ctx context.Context, data ...*model.TermData) ([]*model.Term, error) {
+	return repository.InTransaction(ctx, t.transaction, func(ctx context.Context) ([]*model.Term, error) {
+		return t.createBulk(ctx, data)
+	})
+}
+
+func (t *TermService) createBulk(ctx context.Context, data []*model.TermData) ([]*model.Term, error) {
+	logger := t.log.With(zap.String(`method`, `CreateBulk`), zap.Int(`count`, len(data)))
+
+	if len(data) == 0 {
+		return []*model.Term{}, nil
+	}
+
+	var failed = make(map[int]error)
+
+	for i, d := range data {
+		if d.Name == `` {
+			failed[i] = fmt.Errorf(`%w: name is empty`, taxonomy.ErrTermNotCreated)
+		}
+	}
+
+	if err := t.checkBulk(ctx, make([]uint64, len(data)), data, failed); err != nil {
+		return nil, err
+	}
+
+	if len(failed) > 0 {
+		logger.Debug(`terms are invalid`, zap.Int(`failed`, len(failed)))
+
+		return nil, &taxonomy.BulkError{Errors: failed}
+	}
+
+	terms, err := t.termRepository.CreateBulk(ctx, data...)
+	logger.Debug(`terms created`, zap.Error(err))
+
+	if err != nil {
+		return nil, fmt.Errorf(`%w %s`, taxonomy.ErrTermNotCreated, err.Error())
+	}
+
+	return terms, nil
+}
+
+// UpdateBulk gets all updated terms with one query, omitted fields keep current values like in Update.
+func (t *TermService) UpdateBulk(ctx context.Context, terms ...*model.Term) ([]*model.Term, error) {
+	return repository.InTransaction(ctx, t.transaction, func(ctx context.Context) ([]*model.Term, error) {
+		return t.updateBulk(ctx, terms)
+	})
+}
+
+func (t *TermService) updateBulk(ctx context.Context, terms []*model.Term) ([]*model.Term, error) {
+	logger := t.log.With(zap.String(`method`, `UpdateBulk`), zap.Int(`count`, len(terms)))
+
+	if len(terms) == 0 {
+		return []*model.Term{}, nil
+	}
+
+	var ids = make([]uint64, len(terms))
+	for i, term := range terms {
+		ids[i] = term.ID
+	}
+
+	current, err := t.termRepository.Get(ctx, &repository.TermFilter{ID: lo.Uniq(ids)})
+	if err != nil {
+		logger.Error(`get terms by id`, zap.Error(err))
+
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var (
+		found  = lo.KeyBy(current, func(term *model.Term) uint64 { return term.ID })
+		seen   = make(map[uint64]struct{}, len(terms))
+		data   = make([]*model.TermData, len(terms))
+		failed = make(map[int]error)
+	)
+
+	for i, term := range terms {
+		existing, ok := found[term.ID]
+		if !ok {
+			failed[i] = fmt.Errorf(`%w %d`, taxonomy.ErrTermNotFound, term.ID)
+
+			continue
+		}
+
+		if _, ok := seen[term.ID]; ok {
+			failed[i] = fmt.Errorf(`%w: term %d is repeated`, taxonomy.ErrTermNotUpdated, term.ID)
+
+			continue
+		}
+
+		seen[term.ID] = struct{}{}
+
+		var merged = term.Data
+		keepCurrent(&merged, &existing.Data)
+		data[i] = &merged
+	}
+
+	if err := t.checkBulk(ctx, ids, data, failed); err != nil {
+		return nil, err
+	}
+
+	if len(failed) > 0 {
+		logger.Debug(`terms are invalid`, zap.Int(`failed`, len(failed)))
+
+		return nil, &taxonomy.BulkError{Errors: failed}
+	}
+
+	var updates = make([]*model.Term, len(terms))
+	for i := range terms {
+		updates[i] = &model.Term{ID: ids[i], Data: *data[i]}
+	}
+
+	updated, err := t.termRepository.UpdateBulk(ctx, updates...)
+	logger.Debug(`terms updated`, zap.Error(err))
+
+	if err != nil {
+		return nil, errors.Join(taxonomy.ErrTermNotUpdated, err)
+	}
+
+	return updated, nil
+}
+
+// bulkName is a name of a term in one of its vocabularies.
+type bulkName struct {
+	name         string
+	vocabularyID uint64
+}
+
+// checkBulk checks terms of bulk operation and puts errors of invalid terms into failed. Vocabularies, linked terms
+// and terms with the same names are got with one query for all terms. Zero id stands for a term which is being
+// created, terms failed before are skipped.
+func (t *TermService) checkBulk(ctx context.Context, ids []uint64, data []*model.TermData, failed map[int]error,
+) error {
+	var (
+		vocabulariesID []uint64
+		termsID        []uint64
+		names          []string
+	)
+
+	for i, d := range data {
+		if _, ok := failed[i]; ok {
+			continue
+		}
+
+		vocabulariesID = append(vocabulariesID, d.VocabularyID...)
+		termsID = append(append(termsID, d.SuperID...), d.SubID...)
+		names = append(names, termNames(d)...)
+	}
+
+	// Empty filters would return everything, so objects are got only when they are referred
+	var (
+		vocabularies []*model.Vocabulary
+		linked       []*model.Term
+		namesakes    []*model.Term
+		err          error
+	)
+
+	if len(vocabulariesID) > 0 {
+		vocabularies, err = t.vocabularyRepository.Get(ctx, &repository.VocabularyFilter{ID: lo.Uniq(vocabulariesID)})
+		if err != nil && !errors.Is(err, repository.ErrFindVocabulary) {
+			return fmt.Errorf(`unknown vocabulary error %w`, err)
+		}
+	}
+
+	if len(termsID) > 0 {
+		linked, err = t.termRepository.Get(ctx, &repository.TermFilter{ID: lo.Uniq(termsID)})
+		if err != nil && !errors.Is(err, repository.ErrFindTerm) {
+			return fmt.Errorf(`unknown term error %w`, err)
+		}
+	}
+
+	if len(names) > 0 {
+		namesakes, err = t.termRepository.Get(ctx, &repository.TermFilter{NameIn: lo.Uniq(names), WithSynonyms: true})
+		if err != nil && !errors.Is(err, repository.ErrFindTerm) {
+			return fmt.Errorf(`unknown term error %w`, err)
+		}
+	}
+
+	var (
+		foundVocabularies = lo.SliceToMap(vocabularies, func(v *model.Vocabulary) (uint64, struct{}) {
+			return v.ID, struct{}{}
+		})
+		foundTerms = lo.SliceToMap(linked, func(term *model.Term) (uint64, struct{}) {
+			return term.ID, struct{}{}
+		})
+		updated = lo.SliceToMap(ids, func(id uint64) (uint64, struct{}) {
+			return id, struct{}{}
+		})
+		used = make(map[bulkName]struct{})
+	)
+
+	// Updated terms get their names from the bulk
+	for _, term := range namesakes {
+		if _, ok := updated[term.ID]; !ok {
+			for _, vocabularyID := range term.Data.VocabularyID {
+				for _, name := range termNames(&term.Data) {
+					used[bulkName{name, vocabularyID}] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for i, d := range data {
+		if _, ok := failed[i]; ok {
+			continue
+		}
+
+		if err := checkBulkItem(d, foundVocabularies, foundTerms, used); err != nil {
+			failed[i] = err
+
+			continue
+		}
+
+		if len(d.SuperID) > 0 || len(d.SubID) > 0 {
+			if err := t.checkHierarchy(ctx, ids[i], d.SuperID, d.SubID); err != nil {
+				failed[i] = err
+
+				continue
+			}
+		}
+
+		for _, vocabularyID := range d.VocabularyID {
+			for _, name := range termNames(d) {
+				used[bulkName{name, vocabularyID}] = struct{}{}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkBulkItem checks that vocabularies and linked terms of the term exist and neither its name nor its synonyms are
+// used in any of its vocabularies.
+func checkBulkItem(d *model.TermData, vocabularies, terms map[uint64]struct{}, used map[bulkName]struct{}) error {
+	if len(d.VocabularyID) == 0 {
+		return fmt.Errorf(`%w: term has no vocabulary`, taxonomy.ErrVocabularyNotFound)
+	}
+
+	for _, id := range d.VocabularyID {
+		if _, ok := vocabularies[id]; !ok {
+			return fmt.Errorf(`%w %d`, taxonomy.ErrVocabularyNotFound, id)
+		}
+	}
+
+	for _, id := range append(slices.Clone(d.SuperID), d.SubID...) {
+		if _, ok := terms[id]; !ok {
+			return fmt.Errorf(`%w %d`, taxonomy.ErrTermNotFound, id)
+		}
+	}
+
+	if err := checkSynonyms(d); err != nil {
+		return err
+	}
+
+	for _, vocabularyID := range d.VocabularyID {
+		for _, name := range termNames(d) {
+			if _, ok := used[bulkName{name, vocabularyID}]; ok {
+				return fmt.Errorf(`%w: %s in vocabulary %d`, taxonomy.ErrTermNotUnique, name, vocabularyID)
+			}
+		}
+	}
+
+	return nil
+}
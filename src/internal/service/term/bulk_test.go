@@ -0,0 +1,182 @@
+package term_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/term"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// colors returns service with terms red with synonym crimson and green in vocabulary 1, vocabularies 1 and 2 exist.
+func colors(ctx context.Context) (taxonomy.Term, repository.Term) {
+	var existing = []*model.Term{
+		{ID: 1, Data: model.TermData{Name: `red`, VocabularyID: []uint64{1},
+			Synonyms: []model.Synonym{{Name: `crimson`}}}},
+		{ID: 2, Data: model.TermData{Name: `green`, VocabularyID: []uint64{1}}},
+	}
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
+		ThenAnswer(func(args []any) []any {
+			filter := args[1].(*repository.TermFilter)
+			found := make([]*model.Term, 0)
+
+			for _, trm := range existing {
+				names := []string{trm.Data.Name}
+				if filter.WithSynonyms {
+					for _, synonym := range trm.Data.Synonyms {
+						names = append(names, synonym.Name)
+					}
+				}
+
+				if len(filter.ID) > 0 && !slices.Contains(filter.ID, trm.ID) ||
+					len(filter.VocabularyID) > 0 && !slices.ContainsFunc(trm.Data.VocabularyID, func(id uint64) bool {
+						return slices.Contains(filter.VocabularyID, id)
+					}) ||
+					len(filter.NameIn) > 0 && !slices.ContainsFunc(names, func(name string) bool {
+						return slices.Contains(filter.NameIn, name)
+					}) ||
+					len(filter.SuperID) > 0 || len(filter.SubID) > 0 {
+					continue
+				}
+
+				found = append(found, trm)
+			}
+
+			return []any{found, nil}
+		})
+
+	vocabularyRepository := mock.Mock[repository.Vocabulary]()
+	mock.When(vocabularyRepository.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.VocabularyFilter]())).
+		ThenAnswer(func(args []any) []any {
+			found := make([]*model.Vocabulary, 0)
+			for _, id := range args[1].(*repository.VocabularyFilter).ID {
+				if id == 1 || id == 2 {
+					found = append(found, &model.Vocabulary{ID: id})
+				}
+			}
+
+			return []any{found, nil}
+		})
+
+	return term.New(&term.Config{
+		TermRepository:       termrepo,
+		VocabularyRepository: vocabularyRepository,
+		Logger:               zap.NewNop(),
+	}), termrepo
+}
+
+func TestTermService_CreateBulk(t *testing.T) {
+	t.Run(`invalid terms`, func(t *testing.T) {
+		mock.SetUp(t)
+		var ctx = context.Background()
+		s, termrepo := colors(ctx)
+
+		_, err := s.CreateBulk(ctx,
+			&model.TermData{Name: `blue`, VocabularyID: []uint64{1}},
+			&model.TermData{Name: ``, VocabularyID: []uint64{1}},
+			&model.TermData{Name: `red`, VocabularyID: []uint64{1}},
+			&model.TermData{Name: `red`, VocabularyID: []uint64{2}},
+			&model.TermData{Name: `blue`, VocabularyID: []uint64{2, 1}},
+			&model.TermData{Name: `cyan`, VocabularyID: []uint64{7}},
+			&model.TermData{Name: `teal`, VocabularyID: []uint64{1}, SuperID: []uint64{99}},
+			&model.TermData{Name: `crimson`, VocabularyID: []uint64{1}},
+			&model.TermData{Name: `navy`, VocabularyID: []uint64{1}, Synonyms: []model.Synonym{{Name: `blue`}}},
+		)
+
+		var bulk *taxonomy.BulkError
+		require.ErrorAs(t, err, &bulk)
+		assert.Equal(t, []int{1, 2, 4, 5, 6, 7, 8}, bulk.Indexes())
+		assert.ErrorIs(t, bulk.Errors[1], taxonomy.ErrTermNotCreated)
+		assert.ErrorIs(t, bulk.Errors[2], taxonomy.ErrTermNotUnique)
+		assert.ErrorIs(t, bulk.Errors[4], taxonomy.ErrTermNotUnique)
+		assert.ErrorIs(t, bulk.Errors[5], taxonomy.ErrVocabularyNotFound)
+		assert.ErrorIs(t, bulk.Errors[6], taxonomy.ErrTermNotFound)
+		assert.ErrorIs(t, bulk.Errors[7], taxonomy.ErrTermNotUnique)
+		assert.ErrorIs(t, bulk.Errors[8], taxonomy.ErrTermNotUnique)
+		assert.ErrorIs(t, err, taxonomy.ErrTermNotUnique)
+		mock.Verify(termrepo, mock.Never()).CreateBulk(mock.Any[context.Context](), mock.Any[[]*model.TermData]()...)
+	})
+
+	t.Run(`created`, func(t *testing.T) {
+		mock.SetUp(t)
+		var ctx = context.Background()
+		s, termrepo := colors(ctx)
+
+		var data = []*model.TermData{
+			{Name: `blue`, VocabularyID: []uint64{1}},
+			{Name: `scarlet`, VocabularyID: []uint64{2}, SuperID: []uint64{1}},
+		}
+
+		mock.When(termrepo.CreateBulk(mock.Exact[context.Context](ctx), mock.Any[[]*model.TermData]()...)).
+			ThenAnswer(func(args []any) []any {
+				created := make([]*model.Term, 0)
+				for i, d := range args[1].([]*model.TermData) {
+					created = append(created, &model.Term{ID: uint64(i + 3), Data: *d})
+				}
+
+				return []any{created, nil}
+			})
+
+		terms, err := s.CreateBulk(ctx, data...)
+		require.NoError(t, err)
+		require.Len(t, terms, 2)
+		assert.Equal(t, `scarlet`, terms[1].Data.Name)
+	})
+}
+
+func TestTermService_UpdateBulk(t *testing.T) {
+	t.Run(`invalid terms`, func(t *testing.T) {
+		mock.SetUp(t)
+		var ctx = context.Background()
+		s, termrepo := colors(ctx)
+
+		_, err := s.UpdateBulk(ctx,
+			&model.Term{ID: 1, Data: model.TermData{Title: `Red`}},
+			&model.Term{ID: 5, Data: model.TermData{Title: `Blue`}},
+			&model.Term{ID: 1, Data: model.TermData{Title: `Crimson`}},
+			&model.Term{ID: 2, Data: model.TermData{Name: `red`}},
+		)
+
+		var bulk *taxonomy.BulkError
+		require.ErrorAs(t, err, &bulk)
+		assert.Equal(t, []int{1, 2, 3}, bulk.Indexes())
+		assert.ErrorIs(t, bulk.Errors[1], taxonomy.ErrTermNotFound)
+		assert.ErrorIs(t, bulk.Errors[2], taxonomy.ErrTermNotUpdated)
+		assert.ErrorIs(t, bulk.Errors[3], taxonomy.ErrTermNotUnique)
+		mock.Verify(termrepo, mock.Never()).UpdateBulk(mock.Any[context.Context](), mock.Any[[]*model.Term]()...)
+	})
+
+	t.Run(`names are swapped`, func(t *testing.T) {
+		mock.SetUp(t)
+		var ctx = context.Background()
+		s, termrepo := colors(ctx)
+
+		var updates []*model.Term
+
+		mock.When(termrepo.UpdateBulk(mock.Exact[context.Context](ctx), mock.Any[[]*model.Term]()...)).
+			ThenAnswer(func(args []any) []any {
+				updates = args[1].([]*model.Term)
+
+				return []any{updates, nil}
+			})
+
+		_, err := s.UpdateBulk(ctx,
+			&model.Term{ID: 2, Data: model.TermData{Name: `red`}},
+			&model.Term{ID: 1, Data: model.TermData{Name: `green`, Title: `Green`}},
+		)
+		require.NoError(t, err)
+		require.Len(t, updates, 2)
+		assert.Equal(t, model.TermData{Name: `red`, VocabularyID: []uint64{1}}, updates[0].Data)
+		assert.Equal(t, model.TermData{Name: `green`, Title: `Green`, VocabularyID: []uint64{1},
+			Synonyms: []model.Synonym{{Name: `crimson`}}}, updates[1].Data)
+	})
+}
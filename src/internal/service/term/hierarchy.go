@@ -0,0 +1,269 @@
+package term
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"slices"
+)
+
+// DefaultMaxDepth is used when maximum depth of terms hierarchy isn't set in Config.
+const DefaultMaxDepth = 32
+
+// level is a set of terms on the same distance from the checked term, every term is linked with the term from the
+// previous level it was reached from.
+type level map[uint64]uint64
+
+func (l level) ids() []uint64 {
+	ids := make([]uint64, 0, len(l))
+	for id := range l {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	return ids
+}
+
+// checkHierarchy checks that term with given id linked with superID broader terms and subID narrower terms doesn't
+// make a cycle and the hierarchy depth doesn't exceed the maximum. Zero id is used for a term which is being created.
+func (t *TermService) checkHierarchy(ctx context.Context, id uint64, superID, subID []uint64) error {
+	for _, sub := range subID {
+		if sub == id || slices.Contains(superID, sub) {
+			return &taxonomy.HierarchyError{Err: taxonomy.ErrHierarchyCycle, Path: []uint64{sub, id, sub}}
+		}
+	}
+
+	if slices.Contains(superID, id) {
+		return &taxonomy.HierarchyError{Err: taxonomy.ErrHierarchyCycle, Path: []uint64{id, id}}
+	}
+
+	down, err := t.descend(ctx, id, superID, subID)
+	if err != nil {
+		return err
+	}
+
+	up, err := t.ascend(ctx, id, superID)
+	if err != nil {
+		return err
+	}
+
+	if depth := up + 1 + down; depth > t.maxDepth {
+		return fmt.Errorf(`%w: %d levels, maximum is %d`, taxonomy.ErrHierarchyTooDeep, depth, t.maxDepth)
+	}
+
+	return nil
+}
+
+// descend walks through narrower terms starting from subID and returns count of levels below the term. Reaching any
+// of its new broader terms means a cycle.
+func (t *TermService) descend(ctx context.Context, id uint64, superID, subID []uint64) (uint, error) {
+	var levels = make([]level, 0)
+
+	current := make(level, len(subID))
+	for _, sub := range subID {
+		current[sub] = id
+	}
+
+	for len(current) > 0 {
+		levels = append(levels, current)
+		if uint(len(levels)) >= t.maxDepth {
+			break
+		}
+
+		ids := current.ids()
+
+		terms, err := t.termRepository.Get(ctx, &repository.TermFilter{SuperID: ids})
+		if err != nil {
+			return 0, fmt.Errorf(`unknown term error %w`, err)
+		}
+
+		next := make(level)
+
+		for _, term := range terms {
+			// Existing links of the term are replaced, so the term reached by its old broader link isn't a cycle
+			if id != 0 && term.ID == id {
+				continue
+			}
+
+			for _, parent := range term.Data.SuperID {
+				if _, ok := current[parent]; !ok {
+					continue
+				}
+
+				if slices.Contains(superID, term.ID) {
+					return 0, &taxonomy.HierarchyError{
+						Err:  taxonomy.ErrHierarchyCycle,
+						Path: cyclePath(levels, id, parent, term.ID),
+					}
+				}
+
+				next[term.ID] = parent
+
+				break
+			}
+		}
+
+		current = next
+	}
+
+	return uint(len(levels)), nil
+}
+
+// ascend walks through broader terms starting from superID and returns count of levels above the term.
+func (t *TermService) ascend(ctx context.Context, id uint64, superID []uint64) (uint, error) {
+	var (
+		count   uint
+		current = slices.Clone(superID)
+	)
+
+	for len(current) > 0 {
+		count++
+		if count >= t.maxDepth {
+			break
+		}
+
+		terms, err := t.termRepository.Get(ctx, &repository.TermFilter{SubID: current})
+		if err != nil {
+			return 0, fmt.Errorf(`unknown term error %w`, err)
+		}
+
+		next := make(level)
+
+		for _, term := range terms {
+			// Existing links of the term are replaced, so the term itself isn't a part of hierarchy above it
+			if term.ID != id && slices.ContainsFunc(term.Data.SubID, func(sub uint64) bool {
+				return slices.Contains(current, sub)
+			}) {
+				next[term.ID] = 0
+			}
+		}
+
+		current = next.ids()
+	}
+
+	return count, nil
+}
+
+// cyclePath restores path from the term to the last reached one through visited levels.
+func cyclePath(levels []level, id, parent, last uint64) []uint64 {
+	var path = []uint64{last, parent}
+
+	for i := len(levels) - 1; i >= 0; i-- {
+		if path[len(path)-1] == id {
+			break
+		}
+
+		path = append(path, levels[i][path[len(path)-1]])
+	}
+
+	slices.Reverse(path)
+
+	// The term is narrower than its new broader one which closes the cycle
+	if path[0] != last {
+		path = append([]uint64{last}, path...)
+	}
+
+	return path
+}
+
+// checkLinks checks new links of the term.
+func (t *TermService) checkLinks(ctx context.Context, id uint64, data *model.TermData) error {
+	if err := t.checkTerms(ctx, append(slices.Clone(data.SuperID), data.SubID...)); err != nil {
+		return err
+	}
+
+	return t.checkHierarchy(ctx, id, data.SuperID, data.SubID)
+}
+
+func (t *TermService) Ancestors(ctx context.Context, id uint64) ([]*model.Term, error) {
+	if _, err := t.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	ancestors, err := t.termRepository.Ancestors(ctx, id, t.maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return ancestors, nil
+}
+
+func (t *TermService) Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Term, error) {
+	if _, err := t.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if depth == 0 || depth > t.maxDepth {
+		depth = t.maxDepth
+	}
+
+	descendants, err := t.termRepository.Descendants(ctx, id, depth)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return descendants, nil
+}
+
+func (t *TermService) Paths(ctx context.Context, id uint64) ([][]*model.Term, error) {
+	term, err := t.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors, err := t.termRepository.Ancestors(ctx, id, t.maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var found = make(map[uint64]*model.Term, len(ancestors))
+	for _, ancestor := range ancestors {
+		found[ancestor.ID] = ancestor
+	}
+
+	// Walk up from the term through every broader term, paths are collected from the leaf and reversed at the end
+	var (
+		paths [][]*model.Term
+		walk  func(path []*model.Term)
+	)
+
+	walk = func(path []*model.Term) {
+		var last = path[len(path)-1]
+
+		var supers = make([]*model.Term, 0, len(last.Data.SuperID))
+
+		for _, superID := range last.Data.SuperID {
+			if super, ok := found[superID]; ok && !slices.Contains(path, super) {
+				supers = append(supers, super)
+			}
+		}
+
+		if len(supers) == 0 {
+			paths = append(paths, slices.Clone(path))
+
+			return
+		}
+
+		for _, super := range supers {
+			walk(append(path, super))
+		}
+	}
+
+	walk([]*model.Term{term})
+
+	for _, path := range paths {
+		slices.Reverse(path)
+	}
+
+	slices.SortFunc(paths, func(a, b []*model.Term) int {
+		return slices.CompareFunc(a, b, func(x, y *model.Term) int {
+			return cmp.Compare(x.ID, y.ID)
+		})
+	})
+
+	return paths, nil
+}
@@ -0,0 +1,236 @@
+package term_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/term"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// graph mocks terms repository with terms linked by edges broader -> narrower.
+func graph(edges [][2]uint64, ids ...uint64) repository.Term {
+	terms := make(map[uint64]*model.Term)
+	for _, id := range ids {
+		terms[id] = &model.Term{ID: id}
+	}
+
+	for _, edge := range edges {
+		terms[edge[0]].Data.SubID = append(terms[edge[0]].Data.SubID, edge[1])
+		terms[edge[1]].Data.SuperID = append(terms[edge[1]].Data.SuperID, edge[0])
+	}
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Get(mock.Any[context.Context](), mock.Any[*repository.TermFilter]())).
+		ThenAnswer(func(args []any) []any {
+			filter := args[1].(*repository.TermFilter)
+			found := make([]*model.Term, 0)
+
+			for _, id := range ids {
+				t := terms[id]
+				if len(filter.ID) > 0 && !slices.Contains(filter.ID, id) {
+					continue
+				}
+
+				if len(filter.SuperID) > 0 && !slices.ContainsFunc(t.Data.SuperID, func(id uint64) bool {
+					return slices.Contains(filter.SuperID, id)
+				}) {
+					continue
+				}
+
+				if len(filter.SubID) > 0 && !slices.ContainsFunc(t.Data.SubID, func(id uint64) bool {
+					return slices.Contains(filter.SubID, id)
+				}) {
+					continue
+				}
+
+				found = append(found, t)
+			}
+
+			return []any{found, nil}
+		})
+
+	return termrepo
+}
+
+func TestTermService_LinkHierarchy(t *testing.T) {
+	// 1 -> 2 -> 3 -> 4, 1 -> 5
+	var edges = [][2]uint64{{1, 2}, {2, 3}, {3, 4}, {1, 5}}
+
+	tests := []struct {
+		name     string
+		superID  uint64
+		subID    uint64
+		maxDepth uint
+		linked   bool
+		path     []uint64
+		err      error
+	}{
+		{
+			name:    `linked`,
+			superID: 5,
+			subID:   3,
+			linked:  true,
+		},
+		{
+			name:    `already linked`,
+			superID: 2,
+			subID:   3,
+		},
+		{
+			name:    `cycle`,
+			superID: 4,
+			subID:   1,
+			path:    []uint64{4, 1, 2, 3, 4},
+			err:     taxonomy.ErrHierarchyCycle,
+		},
+		{
+			name:    `short cycle`,
+			superID: 2,
+			subID:   1,
+			path:    []uint64{2, 1, 2},
+			err:     taxonomy.ErrHierarchyCycle,
+		},
+		{
+			name:     `too deep`,
+			superID:  4,
+			subID:    5,
+			maxDepth: 4,
+			err:      taxonomy.ErrHierarchyTooDeep,
+		},
+		{
+			name:     `deep enough`,
+			superID:  3,
+			subID:    5,
+			maxDepth: 4,
+			linked:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termrepo := graph(edges, 1, 2, 3, 4, 5)
+			if tt.linked {
+				mock.When(termrepo.Link(mock.Any[context.Context](), mock.Equal(tt.superID), mock.Equal(tt.subID))).
+					ThenReturn(nil)
+			}
+
+			err := term.New(&term.Config{
+				TermRepository: termrepo,
+				Logger:         zap.NewNop(),
+				MaxDepth:       tt.maxDepth,
+			}).Link(context.Background(), tt.superID, tt.subID)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+
+			if tt.path != nil {
+				var hierarchyErr *taxonomy.HierarchyError
+				require.ErrorAs(t, err, &hierarchyErr)
+				assert.Equal(t, tt.path, hierarchyErr.Path)
+			}
+		})
+	}
+}
+
+func TestTermService_CreateHierarchy(t *testing.T) {
+	mock.SetUp(t)
+
+	// New term can't be narrower than 3 and broader than 1 at the same time
+	_, err := term.New(&term.Config{
+		TermRepository: graph([][2]uint64{{1, 2}, {2, 3}}, 1, 2, 3),
+		Logger:         zap.NewNop(),
+	}).Create(context.Background(), &model.TermData{Name: `loop`, SuperID: []uint64{3}, SubID: []uint64{1}})
+
+	var hierarchyErr *taxonomy.HierarchyError
+	require.ErrorAs(t, err, &hierarchyErr)
+	assert.ErrorIs(t, err, taxonomy.ErrHierarchyCycle)
+	assert.Equal(t, []uint64{3, 0, 1, 2, 3}, hierarchyErr.Path)
+}
+
+func TestTermService_UpdateHierarchy(t *testing.T) {
+	// 1 -> 2, 3 -> 1
+	var edges = [][2]uint64{{1, 2}, {3, 1}}
+
+	tests := []struct {
+		name    string
+		data    *model.TermData
+		updated bool
+		path    []uint64
+	}{
+		{
+			// Old link 1 -> 2 is replaced, so reaching 2 from 1 isn't a cycle
+			name:    `swap broader and narrower`,
+			data:    &model.TermData{Name: `two`, SuperID: []uint64{}, SubID: []uint64{1}},
+			updated: true,
+		},
+		{
+			name: `cycle`,
+			data: &model.TermData{Name: `two`, SuperID: []uint64{1}, SubID: []uint64{3}},
+			path: []uint64{1, 2, 3, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termrepo := graph(edges, 1, 2, 3)
+			if tt.updated {
+				mock.When(termrepo.Update(mock.Any[context.Context](), mock.Equal[uint64](2),
+					mock.Any[*model.TermData]())).
+					ThenReturn(&model.Term{ID: 2}, nil)
+			}
+
+			_, err := term.New(&term.Config{
+				TermRepository: termrepo,
+				Logger:         zap.NewNop(),
+			}).Update(context.Background(), 2, tt.data)
+			if tt.path == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var hierarchyErr *taxonomy.HierarchyError
+			require.ErrorAs(t, err, &hierarchyErr)
+			assert.ErrorIs(t, err, taxonomy.ErrHierarchyCycle)
+			assert.Equal(t, tt.path, hierarchyErr.Path)
+		})
+	}
+}
+
+func TestTermService_Paths(t *testing.T) {
+	mock.SetUp(t)
+	var ctx = context.Background()
+
+	// 1 -> 2 -> 4 -> 5, 1 -> 3 -> 4
+	termrepo := graph([][2]uint64{{1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}}, 1, 2, 3, 4, 5)
+	ancestors, err := termrepo.Get(ctx, &repository.TermFilter{ID: []uint64{1, 2, 3, 4}})
+	require.NoError(t, err)
+	mock.When(termrepo.Ancestors(mock.Exact[context.Context](ctx), mock.Equal[uint64](5), mock.Equal[uint](term.DefaultMaxDepth))).
+		ThenReturn(ancestors, nil)
+
+	paths, err := term.New(&term.Config{
+		TermRepository: termrepo,
+		Logger:         zap.NewNop(),
+	}).Paths(ctx, 5)
+	require.NoError(t, err)
+
+	ids := make([][]uint64, len(paths))
+	for i, path := range paths {
+		for _, trm := range path {
+			ids[i] = append(ids[i], trm.ID)
+		}
+	}
+
+	assert.Equal(t, [][]uint64{{1, 2, 4, 5}, {1, 3, 4, 5}}, ids)
+}
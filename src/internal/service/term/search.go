@@ -0,0 +1,290 @@
+package term
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultSearchLimit is used when limit of search isn't set.
+	DefaultSearchLimit = 20
+	// searchCandidates limits count of terms ranked by one search. Candidates are the most relevant terms by full-text
+	// index or terms with shorter names without it, so results are approximate when more terms match.
+	searchCandidates = 1000
+	// fuzzyPrefix is a count of first letters of words which are looked up without typos.
+	fuzzyPrefix = 2
+)
+
+// Weights of fields, the same kind of match in names is more relevant than in descriptions.
+var searchWeights = map[model.SearchField]float64{
+	model.SearchName:        1,
+	model.SearchSynonym:     0.9,
+	model.SearchTitle:       0.8,
+	model.SearchDescription: 0.4,
+}
+
+// Scores of kinds of matches.
+const (
+	exactScore      = 1
+	prefixScore     = 0.9
+	wordsScore      = 0.75
+	substringScore  = 0.5
+	fuzzyScore      = 0.5
+	typoPenalty     = 0.1
+	hiddenPenalty   = 0.1
+	minSearchScore  = 0.1
+	maxTyposInShort = 1
+	maxTyposInLong  = 2
+	longWord        = 8
+	fuzzyWord       = 4
+)
+
+// Search gets candidates from the repository and ranks them: a field equal to the query goes first, then fields
+// starting with the query, fields with words starting with words of the query, fields containing the query and
+// fields with typos. Names are more relevant than synonyms, titles and descriptions.
+func (t *TermService) Search(ctx context.Context, search *model.TermSearch) ([]*model.TermMatch, error) {
+	logger := t.log.With(zap.String(`method`, `Search`), zap.Any(`search`, search))
+
+	var q = newQuery(search.Query, search.Mode)
+	if len(q.words) == 0 {
+		return []*model.TermMatch{}, nil
+	}
+
+	var filter = &repository.TermSearch{VocabularyID: search.VocabularyID, Limit: searchCandidates}
+
+	switch search.Mode {
+	case model.SearchSubstring:
+		filter.Substring = q.text
+	case model.SearchFuzzy:
+		// Short words can't have typos, so they are looked up as prefixes
+		for _, word := range q.words {
+			if len(word) >= fuzzyWord {
+				word = word[:fuzzyPrefix]
+			}
+
+			filter.Prefixes = append(filter.Prefixes, string(word))
+		}
+	default:
+		filter.Prefixes = q.strings()
+	}
+
+	candidates, err := t.termRepository.Search(ctx, filter)
+	logger.Debug(`got candidates`, zap.Int(`count`, len(candidates)), zap.Error(err))
+
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	var matches = make([]*model.TermMatch, 0, len(candidates))
+
+	for _, term := range candidates {
+		if match := q.match(term); match != nil {
+			matches = append(matches, match)
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b *model.TermMatch) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(len(a.Term.Data.Name), len(b.Term.Data.Name)),
+			cmp.Compare(a.Term.ID, b.Term.ID),
+		)
+	})
+
+	var limit = int(search.Limit)
+	if limit == 0 {
+		limit = DefaultSearchLimit
+	}
+
+	return matches[:min(limit, len(matches))], nil
+}
+
+// query is a lowercase search query split into words of letters and digits.
+type query struct {
+	text  string
+	words [][]rune
+	mode  model.SearchMode
+}
+
+func newQuery(text string, mode model.SearchMode) *query {
+	var q = &query{text: strings.ToLower(strings.TrimSpace(text)), mode: mode}
+
+	for _, word := range words(q.text) {
+		q.words = append(q.words, []rune(word))
+	}
+
+	return q
+}
+
+func (q *query) strings() []string {
+	var s = make([]string, len(q.words))
+	for i, word := range q.words {
+		s[i] = string(word)
+	}
+
+	return s
+}
+
+// match returns the best match among fields of the term, nil is returned when no field matches.
+func (q *query) match(term *model.Term) *model.TermMatch {
+	var best *model.TermMatch
+
+	try := func(field model.SearchField, text string, penalty float64) {
+		score := q.score(strings.ToLower(text))*searchWeights[field] - penalty
+		if score >= minSearchScore && (best == nil || score > best.Score) {
+			best = &model.TermMatch{Term: term, Score: score, Field: field}
+		}
+	}
+
+	try(model.SearchName, term.Data.Name, 0)
+
+	for _, synonym := range term.Data.Synonyms {
+		if synonym.Hidden {
+			try(model.SearchSynonym, synonym.Name, hiddenPenalty)
+		} else {
+			try(model.SearchSynonym, synonym.Name, 0)
+		}
+	}
+
+	try(model.SearchTitle, term.Data.Title, 0)
+	try(model.SearchDescription, term.Data.Description, 0)
+
+	return best
+}
+
+// score returns relevance of the lowercase text, zero means the text doesn't match.
+func (q *query) score(text string) float64 {
+	switch {
+	case text == ``:
+		return 0
+	case text == q.text:
+		return exactScore
+	case strings.HasPrefix(text, q.text):
+		return prefixScore
+	}
+
+	var tokens = words(text)
+
+	if q.mode == model.SearchSubstring {
+		if !strings.Contains(text, q.text) {
+			return 0
+		}
+
+		// Query starting at the beginning of a word is closer than a part of a word
+		for i := range tokens {
+			if strings.HasPrefix(strings.Join(tokens[i:], ` `), strings.Join(q.strings(), ` `)) {
+				return wordsScore
+			}
+		}
+
+		return substringScore
+	}
+
+	var typos int
+
+	for _, word := range q.words {
+		found := -1
+
+		for _, token := range tokens {
+			if strings.HasPrefix(token, string(word)) {
+				found = 0
+
+				break
+			}
+
+			if q.mode == model.SearchFuzzy {
+				if d := typo(word, []rune(token)); d > 0 && (found < 0 || d < found) {
+					found = d
+				}
+			}
+		}
+
+		if found < 0 {
+			return 0
+		}
+
+		typos += found
+	}
+
+	if typos == 0 {
+		return wordsScore
+	}
+
+	return fuzzyScore - typoPenalty*float64(typos)
+}
+
+// typo returns count of typos when the word differs from the token or from the beginning of the token, the first
+// letters must be the same. Zero is returned when the word has too many typos.
+func typo(word, token []rune) int {
+	if len(word) < fuzzyWord || len(token) < fuzzyPrefix ||
+		!slices.Equal(word[:fuzzyPrefix], token[:fuzzyPrefix]) {
+		return 0
+	}
+
+	var allowed = maxTyposInShort
+	if len(word) >= longWord {
+		allowed = maxTyposInLong
+	}
+
+	d := distance(word, token)
+	// The word could be a beginning of the token with typos
+	if len(token) > len(word) {
+		d = min(d, distance(word, token[:len(word)]))
+	}
+
+	if d > allowed {
+		return 0
+	}
+
+	return d
+}
+
+// distance returns count of insertions, deletions, substitutions and transpositions of adjacent letters making b
+// from a.
+func distance(a, b []rune) int {
+	var (
+		prev2 = make([]int, len(b)+1)
+		prev  = make([]int, len(b)+1)
+		curr  = make([]int, len(b)+1)
+	)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			var cost = 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(b)]
+}
+
+// words splits the text into words of letters and digits.
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
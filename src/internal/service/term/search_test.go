@@ -0,0 +1,164 @@
+package term_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/internal/service/term"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// dictionary returns candidates for every search, ranking is up to the service.
+var dictionary = []*model.Term{
+	{ID: 1, Data: model.TermData{Name: `red`, Title: `Red`, Synonyms: []model.Synonym{{Name: `scarlet`}}}},
+	{ID: 2, Data: model.TermData{Name: `dark-red`, Title: `Dark red`}},
+	{ID: 3, Data: model.TermData{Name: `reddish`, Description: `Color of a red brick`}},
+	{ID: 4, Data: model.TermData{Name: `ultramarine`, Title: `Ultramarine blue`,
+		Synonyms: []model.Synonym{{Name: `marine`, Hidden: true}}}},
+	{ID: 5, Data: model.TermData{Name: `bordeaux`, Description: `Dark shade of red`}},
+}
+
+func TestTermService_Search(t *testing.T) {
+	type match struct {
+		id    uint64
+		field model.SearchField
+	}
+
+	tests := []struct {
+		name    string
+		search  *model.TermSearch
+		filter  *repository.TermSearch
+		matches []match
+	}{
+		{
+			name:   `prefix`,
+			search: &model.TermSearch{Query: ` Red `, VocabularyID: []uint64{7}},
+			filter: &repository.TermSearch{Prefixes: []string{`red`}, VocabularyID: []uint64{7}, Limit: 1000},
+			matches: []match{
+				{1, model.SearchName}, {3, model.SearchName}, {2, model.SearchName}, {5, model.SearchDescription},
+			},
+		},
+		{
+			name:    `words`,
+			search:  &model.TermSearch{Query: `dark r`},
+			filter:  &repository.TermSearch{Prefixes: []string{`dark`, `r`}, Limit: 1000},
+			matches: []match{{2, model.SearchName}, {5, model.SearchDescription}},
+		},
+		{
+			name:    `synonym`,
+			search:  &model.TermSearch{Query: `scar`},
+			filter:  &repository.TermSearch{Prefixes: []string{`scar`}, Limit: 1000},
+			matches: []match{{1, model.SearchSynonym}},
+		},
+		{
+			name:    `limit`,
+			search:  &model.TermSearch{Query: `red`, Limit: 2},
+			filter:  &repository.TermSearch{Prefixes: []string{`red`}, Limit: 1000},
+			matches: []match{{1, model.SearchName}, {3, model.SearchName}},
+		},
+		{
+			name:    `substring`,
+			search:  &model.TermSearch{Query: `arine`, Mode: model.SearchSubstring},
+			filter:  &repository.TermSearch{Substring: `arine`, Limit: 1000},
+			matches: []match{{4, model.SearchName}},
+		},
+		{
+			name:    `substring from beginning of word`,
+			search:  &model.TermSearch{Query: `marine`, Mode: model.SearchSubstring},
+			filter:  &repository.TermSearch{Substring: `marine`, Limit: 1000},
+			matches: []match{{4, model.SearchSynonym}},
+		},
+		{
+			name:    `typo`,
+			search:  &model.TermSearch{Query: `redish`, Mode: model.SearchFuzzy},
+			filter:  &repository.TermSearch{Prefixes: []string{`re`}, Limit: 1000},
+			matches: []match{{3, model.SearchName}},
+		},
+		{
+			name:    `transposition`,
+			search:  &model.TermSearch{Query: `bordaeux`, Mode: model.SearchFuzzy},
+			filter:  &repository.TermSearch{Prefixes: []string{`bo`}, Limit: 1000},
+			matches: []match{{5, model.SearchName}},
+		},
+		{
+			name:    `two typos in long word`,
+			search:  &model.TermSearch{Query: `ultrmarin`, Mode: model.SearchFuzzy},
+			filter:  &repository.TermSearch{Prefixes: []string{`ul`}, Limit: 1000},
+			matches: []match{{4, model.SearchName}},
+		},
+		{
+			name:   `too many typos`,
+			search: &model.TermSearch{Query: `skarlt`, Mode: model.SearchFuzzy},
+			filter: &repository.TermSearch{Prefixes: []string{`sk`}, Limit: 1000},
+		},
+		{
+			name:   `typo in the first letters`,
+			search: &model.TermSearch{Query: `rde`, Mode: model.SearchFuzzy},
+			filter: &repository.TermSearch{Prefixes: []string{`rde`}, Limit: 1000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+
+			termrepo := mock.Mock[repository.Term]()
+			mock.When(termrepo.Search(mock.Any[context.Context](), mock.Any[*repository.TermSearch]())).
+				ThenAnswer(func(args []any) []any {
+					assert.Equal(t, tt.filter, args[1])
+
+					return []any{dictionary, nil}
+				})
+
+			found, err := term.New(&term.Config{
+				TermRepository: termrepo,
+				Logger:         zap.NewNop(),
+			}).Search(context.Background(), tt.search)
+			require.NoError(t, err)
+
+			var matches = make([]match, 0)
+			for i, m := range found {
+				matches = append(matches, match{m.Term.ID, m.Field})
+
+				if i > 0 {
+					assert.LessOrEqual(t, m.Score, found[i-1].Score)
+				}
+			}
+
+			assert.Equal(t, append(make([]match, 0), tt.matches...), matches)
+		})
+	}
+}
+
+func TestTermService_SearchEmpty(t *testing.T) {
+	mock.SetUp(t)
+
+	// Repository isn't called for query without words
+	found, err := term.New(&term.Config{
+		TermRepository: mock.Mock[repository.Term](),
+		Logger:         zap.NewNop(),
+	}).Search(context.Background(), &model.TermSearch{Query: ` -*- `})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestTermService_SearchError(t *testing.T) {
+	mock.SetUp(t)
+
+	var expected = errors.New(`connection refused`)
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Search(mock.Any[context.Context](), mock.Any[*repository.TermSearch]())).
+		ThenReturn(nil, expected)
+
+	_, err := term.New(&term.Config{
+		TermRepository: termrepo,
+		Logger:         zap.NewNop(),
+	}).Search(context.Background(), &model.TermSearch{Query: `red`})
+	assert.ErrorIs(t, err, expected)
+}
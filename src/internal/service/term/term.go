@@ -8,39 +8,71 @@ import (
 	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
 	"go.uber.org/zap"
+	"slices"
 )
 
 type Config struct {
-	ReferenceService  taxonomy.Reference
-	TermRepository    repository.Term
-	VocabularyService taxonomy.Vocabulary
-	Logger            *zap.Logger
+	// Transaction wraps changes of terms, repository.NoTransaction is used when it's nil.
+	Transaction          repository.Transaction
+	ReferenceRepository  repository.Reference
+	TermRepository       repository.Term
+	VocabularyRepository repository.Vocabulary
+	Logger               *zap.Logger
+	// MaxDepth limits count of levels in terms hierarchy, DefaultMaxDepth is used when it's zero.
+	MaxDepth uint
 }
 
 func New(config *Config) taxonomy.Term {
+	var maxDepth = config.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxDepth
+	}
+
+	var transaction = config.Transaction
+	if transaction == nil {
+		transaction = repository.NoTransaction{}
+	}
+
 	return &TermService{
-		referenceService:  config.ReferenceService,
-		vocabularyService: config.VocabularyService,
-		termRepository:    config.TermRepository,
-		log:               config.Logger,
+		transaction:          transaction,
+		referenceRepository:  config.ReferenceRepository,
+		vocabularyRepository: config.VocabularyRepository,
+		termRepository:       config.TermRepository,
+		log:                  config.Logger,
+		maxDepth:             maxDepth,
 	}
 }
 
 type TermService struct {
-	referenceService  taxonomy.Reference
-	vocabularyService taxonomy.Vocabulary
-	namespaceService  taxonomy.Namespace
-	termRepository    repository.Term
-	log               *zap.Logger
+	transaction          repository.Transaction
+	referenceRepository  repository.Reference
+	vocabularyRepository repository.Vocabulary
+	termRepository       repository.Term
+	log                  *zap.Logger
+	maxDepth             uint
 }
 
 func (t *TermService) Create(ctx context.Context, data *model.TermData) (*model.Term, error) {
+	return repository.InTransaction(ctx, t.transaction, func(ctx context.Context) (*model.Term, error) {
+		return t.create(ctx, data)
+	})
+}
+
+func (t *TermService) create(ctx context.Context, data *model.TermData) (*model.Term, error) {
 	logger := t.log.With(zap.String(`method`, `Create`), zap.Any(`data`, *data))
 
 	if err := t.checkVocabularies(ctx, data.VocabularyID); err != nil {
 		return nil, err
 	}
 
+	if err := t.checkLinks(ctx, 0, data); err != nil {
+		return nil, err
+	}
+
+	if err := t.checkNames(ctx, 0, data); err != nil {
+		return nil, err
+	}
+
 	term, err := t.termRepository.Create(ctx, data)
 	logger.Debug(`term created`, zap.Any(`term`, term), zap.Error(err))
 
@@ -54,19 +86,55 @@ func (t *TermService) Create(ctx context.Context, data *model.TermData) (*model.
 func (t *TermService) checkVocabularies(ctx context.Context, vocabulariesID []uint64) error {
 	// Check vocabularies exists
 	for _, id := range vocabulariesID {
-		if _, err := t.vocabularyService.GetByID(ctx, id); err != nil {
+		vocabularies, err := t.vocabularyRepository.Get(ctx, &repository.VocabularyFilter{ID: []uint64{id}})
+		if err != nil {
 			if errors.Is(err, repository.ErrFindVocabulary) {
 				return fmt.Errorf(`%w %d`, taxonomy.ErrVocabularyNotFound, id)
 			}
 
 			return fmt.Errorf(`unknown vocabulary error %w`, err)
 		}
+
+		if len(vocabularies) != 1 {
+			return fmt.Errorf(`%w %d`, taxonomy.ErrVocabularyNotFound, id)
+		}
+	}
+
+	return nil
+}
+
+// checkTerms checks that all linked terms exist.
+func (t *TermService) checkTerms(ctx context.Context, termsID []uint64) error {
+	if len(termsID) == 0 {
+		return nil
+	}
+
+	terms, err := t.termRepository.Get(ctx, &repository.TermFilter{ID: termsID})
+	if err != nil {
+		return fmt.Errorf(`unknown term error %w`, err)
+	}
+
+	found := make(map[uint64]struct{}, len(terms))
+	for _, term := range terms {
+		found[term.ID] = struct{}{}
+	}
+
+	for _, id := range termsID {
+		if _, ok := found[id]; !ok {
+			return fmt.Errorf(`%w %d`, taxonomy.ErrTermNotFound, id)
+		}
 	}
 
 	return nil
 }
 
 func (t *TermService) Update(ctx context.Context, id uint64, data *model.TermData) (*model.Term, error) {
+	return repository.InTransaction(ctx, t.transaction, func(ctx context.Context) (*model.Term, error) {
+		return t.update(ctx, id, data)
+	})
+}
+
+func (t *TermService) update(ctx context.Context, id uint64, data *model.TermData) (*model.Term, error) {
 	logger := t.log.With(zap.String(`method`, `Update`), zap.Uint64("id", id),
 		zap.Any(`data`, *data))
 
@@ -88,26 +156,21 @@ func (t *TermService) Update(ctx context.Context, id uint64, data *model.TermDat
 
 	var term = terms[0]
 
-	// Avoid empty values
-	if data.Name == `` {
-		data.Name = term.Data.Name
+	// Check vocabulary exists
+	if len(data.VocabularyID) > 0 {
+		if err := t.checkVocabularies(ctx, data.VocabularyID); err != nil {
+			return nil, err
+		}
 	}
 
-	if data.Title == `` {
-		data.Title = term.Data.Title
-	}
+	keepCurrent(data, &term.Data)
 
-	if data.Description == `` {
-		data.Description = term.Data.Description
+	if err := t.checkLinks(ctx, term.ID, data); err != nil {
+		return nil, err
 	}
 
-	if len(data.VocabularyID) == 0 {
-		data.VocabularyID = term.Data.VocabularyID
-	} else {
-		// Check vocabulary exists
-		if err := t.checkVocabularies(ctx, data.VocabularyID); err != nil {
-			return nil, err
-		}
+	if err := t.checkNames(ctx, term.ID, data); err != nil {
+		return nil, err
 	}
 
 	// Update term
@@ -121,7 +184,122 @@ func (t *TermService) Update(ctx context.Context, id uint64, data *model.TermDat
 	return updated, nil
 }
 
+// checkNames checks synonyms of the term and that neither its name nor its synonyms are used by other terms of its
+// vocabularies. Zero id stands for a term which is being created.
+func (t *TermService) checkNames(ctx context.Context, id uint64, data *model.TermData) error {
+	if err := checkSynonyms(data); err != nil {
+		return err
+	}
+
+	// Empty filter would return terms of all vocabularies
+	if len(data.VocabularyID) == 0 {
+		return nil
+	}
+
+	var names = termNames(data)
+
+	terms, err := t.termRepository.Get(ctx, &repository.TermFilter{
+		VocabularyID: data.VocabularyID,
+		NameIn:       names,
+		WithSynonyms: true,
+	})
+	if err != nil && !errors.Is(err, repository.ErrFindTerm) {
+		return fmt.Errorf(`unknown term error %w`, err)
+	}
+
+	for _, term := range terms {
+		if term.ID == id {
+			continue
+		}
+
+		used := termNames(&term.Data)
+		for _, name := range names {
+			if slices.Contains(used, name) {
+				return fmt.Errorf(`%w: %s is used by term %d`, taxonomy.ErrTermNotUnique, name, term.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSynonyms checks that synonyms have names, differ from the term's name and aren't repeated in one locale.
+func checkSynonyms(data *model.TermData) error {
+	var seen = make(map[model.Synonym]struct{}, len(data.Synonyms))
+
+	for _, synonym := range data.Synonyms {
+		if synonym.Name == `` {
+			return taxonomy.ErrSynonymEmpty
+		}
+
+		if synonym.Name == data.Name {
+			return fmt.Errorf(`%w: synonym %s is the term's name`, taxonomy.ErrTermNotUnique, synonym.Name)
+		}
+
+		key := model.Synonym{Name: synonym.Name, Locale: model.NormalizeLocale(synonym.Locale)}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf(`%w: synonym %s is repeated`, taxonomy.ErrTermNotUnique, synonym.Name)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// termNames returns the name and names of synonyms of the term.
+func termNames(data *model.TermData) []string {
+	var names = []string{data.Name}
+	for _, synonym := range data.Synonyms {
+		if !slices.Contains(names, synonym.Name) {
+			names = append(names, synonym.Name)
+		}
+	}
+
+	return names
+}
+
+// keepCurrent avoids empty values: omitted fields of data get current values of the term. Nil links and synonyms
+// keep existing ones, empty slice removes them. Labels are merged by locales.
+func keepCurrent(data *model.TermData, current *model.TermData) {
+	if data.Name == `` {
+		data.Name = current.Name
+	}
+
+	if data.Title == `` {
+		data.Title = current.Title
+	}
+
+	if data.Description == `` {
+		data.Description = current.Description
+	}
+
+	data.Labels = data.Labels.Merge(current.Labels)
+
+	if len(data.VocabularyID) == 0 {
+		data.VocabularyID = current.VocabularyID
+	}
+
+	if data.SuperID == nil {
+		data.SuperID = current.SuperID
+	}
+
+	if data.SubID == nil {
+		data.SubID = current.SubID
+	}
+
+	if data.Synonyms == nil {
+		data.Synonyms = current.Synonyms
+	}
+}
+
 func (t *TermService) Delete(ctx context.Context, id uint64) error {
+	return t.transaction.Run(ctx, func(ctx context.Context) error {
+		return t.delete(ctx, id)
+	})
+}
+
+func (t *TermService) delete(ctx context.Context, id uint64) error {
 	logger := t.log.With(zap.String(`method`, `Delete`), zap.Uint64("id", id))
 
 	// Check term exists
@@ -136,20 +314,25 @@ func (t *TermService) Delete(ctx context.Context, id uint64) error {
 		return fmt.Errorf(`unknown error %w`, err)
 	}
 
+	if len(terms) != 1 {
+		return fmt.Errorf(`%w, got %d results`, taxonomy.ErrTermNotFound, len(terms))
+	}
+
 	var term = terms[0]
 
 	// Reference exists check
 	logger.Debug(`check references`, zap.Uint64(`id`, term.ID))
 
-	ref, err := t.referenceService.Get(ctx, &model.ReferenceFilter{TermID: [][]uint64{{term.ID}}})
+	count, err := t.referenceRepository.Count(ctx, &repository.ReferenceFilter{TermID: [][]uint64{{term.ID}}})
 	if err != nil {
-		logger.Error(`get references by term_id`, zap.Uint64(`term_id`, term.ID), zap.Error(err))
+		logger.Error(`count references by term_id`, zap.Uint64(`term_id`, term.ID), zap.Error(err))
 
 		return fmt.Errorf(`get references by term error: %w`, err)
 	}
 
-	if len(ref) > 0 {
-		return fmt.Errorf(`can't remove term %q: %d %w`, term.ID, len(ref), taxonomy.ErrReferenceExists)
+	if count > 0 {
+		return fmt.Errorf(`can't remove term %d: %w`, term.ID,
+			&taxonomy.BlockedError{Err: taxonomy.ErrReferenceExists, Count: count})
 	}
 
 	// Delete term
@@ -164,6 +347,71 @@ func (t *TermService) Delete(ctx context.Context, id uint64) error {
 	return nil
 }
 
+func (t *TermService) Link(ctx context.Context, superID, subID uint64) error {
+	return t.transaction.Run(ctx, func(ctx context.Context) error {
+		return t.link(ctx, superID, subID)
+	})
+}
+
+func (t *TermService) link(ctx context.Context, superID, subID uint64) error {
+	logger := t.log.With(zap.String(`method`, `Link`), zap.Uint64(`super_id`, superID), zap.Uint64(`sub_id`, subID))
+
+	if superID == subID {
+		return &taxonomy.HierarchyError{Err: taxonomy.ErrHierarchyCycle, Path: []uint64{subID, subID}}
+	}
+
+	terms, err := t.termRepository.Get(ctx, &repository.TermFilter{ID: []uint64{superID, subID}})
+	if err != nil {
+		return fmt.Errorf(`unknown term error %w`, err)
+	}
+
+	var sub *model.Term
+
+	for _, id := range []uint64{superID, subID} {
+		i := slices.IndexFunc(terms, func(term *model.Term) bool {
+			return term.ID == id
+		})
+		if i < 0 {
+			return fmt.Errorf(`%w %d`, taxonomy.ErrTermNotFound, id)
+		}
+
+		sub = terms[i]
+	}
+
+	// Already linked terms make no changes in hierarchy
+	if slices.Contains(sub.Data.SuperID, superID) {
+		return nil
+	}
+
+	if err := t.checkHierarchy(ctx, subID, []uint64{superID}, sub.Data.SubID); err != nil {
+		return err
+	}
+
+	if err := t.termRepository.Link(ctx, superID, subID); err != nil {
+		logger.Error(`link terms`, zap.Error(err))
+
+		return errors.Join(taxonomy.ErrTermNotUpdated, err)
+	}
+
+	return nil
+}
+
+func (t *TermService) Unlink(ctx context.Context, superID, subID uint64) error {
+	logger := t.log.With(zap.String(`method`, `Unlink`), zap.Uint64(`super_id`, superID), zap.Uint64(`sub_id`, subID))
+
+	if err := t.checkTerms(ctx, []uint64{superID, subID}); err != nil {
+		return err
+	}
+
+	if err := t.termRepository.Unlink(ctx, superID, subID); err != nil {
+		logger.Error(`unlink terms`, zap.Error(err))
+
+		return errors.Join(taxonomy.ErrTermNotUpdated, err)
+	}
+
+	return nil
+}
+
 //	if ok, err := t.exists(ctx, id); !ok || err != nil {
 //		if err != nil {
 //			return nil, fmt.Errorf(`%w, get parent id  %d error: %w`,
@@ -204,7 +452,7 @@ func (t *TermService) GetByID(ctx context.Context, id uint64) (*model.Term, erro
 	}
 
 	if len(terms) != 1 {
-		return nil, fmt.Errorf(`%w, got %d results`, taxonomy.ErrVocabularyNotFound, len(terms))
+		return nil, fmt.Errorf(`%w, got %d results`, taxonomy.ErrTermNotFound, len(terms))
 	}
 
 	logger.Debug(`term is got`, zap.Any(`term`, terms[0]))
@@ -216,10 +464,12 @@ func (t *TermService) Get(ctx context.Context, filter *model.TermFilter) ([]*mod
 	logger := t.log.With(zap.String(`method`, `Get`), zap.Any(`filter`, filter))
 
 	terms, err := t.termRepository.Get(ctx, &repository.TermFilter{
+		ID:           filter.ID,
 		VocabularyID: filter.VocabularyID,
 		SuperID:      filter.SuperID,
 		SubID:        filter.SubID,
 		Name:         filter.Name,
+		WithSynonyms: filter.WithSynonyms,
 		AfterID:      filter.AfterID,
 		Limit:        filter.Limit,
 		Offset:       filter.Offset,
@@ -232,3 +482,20 @@ func (t *TermService) Get(ctx context.Context, filter *model.TermFilter) ([]*mod
 
 	return terms, nil
 }
+
+func (t *TermService) Count(ctx context.Context, filter *model.TermFilter) (uint64, error) {
+	count, err := t.termRepository.Count(ctx, &repository.TermFilter{
+		ID:           filter.ID,
+		VocabularyID: filter.VocabularyID,
+		SuperID:      filter.SuperID,
+		SubID:        filter.SubID,
+		Name:         filter.Name,
+		WithSynonyms: filter.WithSynonyms,
+		AfterID:      filter.AfterID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return count, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/dmalykh/taxonomy/internal/service/term"
 	"github.com/ovechkin-dm/mockio/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTermService_Delete(t *testing.T) {
@@ -33,12 +34,12 @@ func TestTermService_Delete(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn(nil, repository.ErrFindTerm)
 
-				ref := mock.Mock[taxonomy.Reference]()
+				ref := mock.Mock[repository.Reference]()
 
 				return term.New(&term.Config{
-					ReferenceService: ref,
-					TermRepository:   termrepo,
-					Logger:           zap.NewNop(),
+					ReferenceRepository: ref,
+					TermRepository:      termrepo,
+					Logger:              zap.NewNop(),
 				})
 			},
 			err: taxonomy.ErrTermNotFound,
@@ -50,12 +51,12 @@ func TestTermService_Delete(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn(nil, errunknown)
 
-				ref := mock.Mock[taxonomy.Reference]()
+				ref := mock.Mock[repository.Reference]()
 
 				return term.New(&term.Config{
-					ReferenceService: ref,
-					TermRepository:   termrepo,
-					Logger:           zap.NewNop(),
+					ReferenceRepository: ref,
+					TermRepository:      termrepo,
+					Logger:              zap.NewNop(),
 				})
 			},
 			err: errunknown,
@@ -67,14 +68,14 @@ func TestTermService_Delete(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn([]*model.Term{{ID: 33}}, nil)
 
-				ref := mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn(nil, errunknown)
+				ref := mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(0), errunknown)
 
 				return term.New(&term.Config{
-					ReferenceService: ref,
-					TermRepository:   termrepo,
-					Logger:           zap.NewNop(),
+					ReferenceRepository: ref,
+					TermRepository:      termrepo,
+					Logger:              zap.NewNop(),
 				})
 			},
 			err: errunknown,
@@ -86,14 +87,14 @@ func TestTermService_Delete(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn([]*model.Term{{ID: 33}}, nil)
 
-				ref := mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn([]*model.Reference{{ID: 9}}, nil)
+				ref := mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(1), nil)
 
 				return term.New(&term.Config{
-					ReferenceService: ref,
-					TermRepository:   termrepo,
-					Logger:           zap.NewNop(),
+					ReferenceRepository: ref,
+					TermRepository:      termrepo,
+					Logger:              zap.NewNop(),
 				})
 			},
 			err: taxonomy.ErrReferenceExists,
@@ -105,17 +106,17 @@ func TestTermService_Delete(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn([]*model.Term{{ID: 33}}, nil)
 
-				ref := mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn(nil, nil)
+				ref := mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(0), nil)
 
 				mock.When(termrepo.Delete(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn(io.EOF)
 
 				return term.New(&term.Config{
-					ReferenceService: ref,
-					TermRepository:   termrepo,
-					Logger:           zap.NewNop(),
+					ReferenceRepository: ref,
+					TermRepository:      termrepo,
+					Logger:              zap.NewNop(),
 				})
 			},
 			err: io.EOF,
@@ -127,17 +128,17 @@ func TestTermService_Delete(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn([]*model.Term{{ID: 33}}, nil)
 
-				ref := mock.Mock[taxonomy.Reference]()
-				mock.When(ref.Get(mock.Exact[context.Context](ctx), mock.Any[*model.ReferenceFilter]())).
-					ThenReturn(nil, nil)
+				ref := mock.Mock[repository.Reference]()
+				mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+					ThenReturn(uint64(0), nil)
 
 				mock.When(termrepo.Delete(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn(nil)
 
 				return term.New(&term.Config{
-					ReferenceService: ref,
-					TermRepository:   termrepo,
-					Logger:           zap.NewNop(),
+					ReferenceRepository: ref,
+					TermRepository:      termrepo,
+					Logger:              zap.NewNop(),
 				})
 			},
 			err: nil,
@@ -165,6 +166,84 @@ func TestTermService_Delete(t *testing.T) {
 	}
 }
 
+func TestTermService_DeleteBlocked(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
+		ThenReturn([]*model.Term{{ID: 33}}, nil)
+
+	ref := mock.Mock[repository.Reference]()
+	mock.When(ref.Count(mock.Exact[context.Context](ctx), mock.Any[*repository.ReferenceFilter]())).
+		ThenReturn(uint64(12), nil)
+
+	err := term.New(&term.Config{
+		ReferenceRepository: ref,
+		TermRepository:      termrepo,
+		Logger:              zap.NewNop(),
+	}).Delete(ctx, 33)
+
+	var blocked *taxonomy.BlockedError
+	assert.ErrorAs(t, err, &blocked)
+	assert.Equal(t, uint64(12), blocked.Count)
+	assert.ErrorIs(t, err, taxonomy.ErrReferenceExists)
+	mock.Verify(termrepo, mock.Never()).Delete(mock.Any[context.Context](), mock.Any[*repository.TermFilter]())
+}
+
+type txKey struct{}
+
+// transaction puts marker into context, so tests could check repositories are called inside transaction.
+type transaction struct {
+	runs int
+	err  error
+}
+
+func (tx *transaction) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx.runs++
+	tx.err = fn(context.WithValue(ctx, txKey{}, tx))
+
+	return tx.err
+}
+
+func TestTermService_DeleteInTransaction(t *testing.T) {
+	mock.SetUp(t)
+
+	var (
+		ctx = context.Background()
+		tx  = new(transaction)
+	)
+
+	inTx := func() context.Context {
+		return mock.Match(mock.CreateMatcher(`in transaction`, func(_ []any, ctx context.Context) bool {
+			return ctx.Value(txKey{}) == tx
+		}))
+	}
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Get(inTx(), mock.Any[*repository.TermFilter]())).
+		ThenReturn([]*model.Term{{ID: 33}}, nil)
+	mock.When(termrepo.Delete(inTx(), mock.Any[*repository.TermFilter]())).
+		ThenReturn(io.EOF)
+
+	ref := mock.Mock[repository.Reference]()
+	mock.When(ref.Count(inTx(), mock.Any[*repository.ReferenceFilter]())).
+		ThenReturn(uint64(0), nil)
+
+	err := term.New(&term.Config{
+		Transaction:         tx,
+		ReferenceRepository: ref,
+		TermRepository:      termrepo,
+		Logger:              zap.NewNop(),
+	}).Delete(ctx, 33)
+
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, 1, tx.runs)
+	// Error is returned from the transaction to roll it back
+	assert.ErrorIs(t, tx.err, io.EOF)
+}
+
 func TestTermService_Update(t *testing.T) {
 
 	var defaultTermData = model.TermData{
@@ -190,14 +269,14 @@ func TestTermService_Update(t *testing.T) {
 				mock.When(termrepo.Update(mock.Exact[context.Context](ctx), mock.Any[uint64](), mock.Any[*model.TermData]())).
 					ThenReturn(&model.Term{ID: 22, Data: defaultTermData}, nil)
 
-				voc := mock.Mock[taxonomy.Vocabulary]()
-				mock.When(voc.GetByID(mock.Exact[context.Context](ctx), mock.Any[uint64]())).
-					ThenReturn(&model.Vocabulary{}, nil)
+				voc := mock.Mock[repository.Vocabulary]()
+				mock.When(voc.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.VocabularyFilter]())).
+					ThenReturn([]*model.Vocabulary{{}}, nil)
 
 				return term.New(&term.Config{
-					TermRepository:    termrepo,
-					VocabularyService: voc,
-					Logger:            zap.NewNop(),
+					TermRepository:       termrepo,
+					VocabularyRepository: voc,
+					Logger:               zap.NewNop(),
 				})
 			},
 			update: &defaultTermData,
@@ -279,16 +358,20 @@ func TestTermService_Update(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn([]*model.Term{{ID: 22}}, nil)
 
-				voc := mock.Mock[taxonomy.Vocabulary]()
-				mock.When(voc.GetByID(mock.Exact[context.Context](ctx), mock.NotEqual[uint64](99))).
-					ThenReturn(&model.Vocabulary{}, nil)
-				mock.When(voc.GetByID(mock.Exact[context.Context](ctx), mock.Equal[uint64](99))).
-					ThenReturn(&model.Vocabulary{}, repository.ErrFindVocabulary)
+				voc := mock.Mock[repository.Vocabulary]()
+				mock.When(voc.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.VocabularyFilter]())).
+					ThenAnswer(func(args []any) []any {
+						if args[1].(*repository.VocabularyFilter).ID[0] == 99 {
+							return []any{nil, repository.ErrFindVocabulary}
+						}
+
+						return []any{[]*model.Vocabulary{{}}, nil}
+					})
 
 				return term.New(&term.Config{
-					TermRepository:    termrepo,
-					VocabularyService: voc,
-					Logger:            zap.NewNop(),
+					TermRepository:       termrepo,
+					VocabularyRepository: voc,
+					Logger:               zap.NewNop(),
 				})
 			},
 			update: &model.TermData{
@@ -304,16 +387,20 @@ func TestTermService_Update(t *testing.T) {
 				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
 					ThenReturn([]*model.Term{{ID: 33}}, nil)
 
-				voc := mock.Mock[taxonomy.Vocabulary]()
-				mock.When(voc.GetByID(mock.Exact[context.Context](ctx), mock.NotEqual[uint64](99))).
-					ThenReturn(&model.Vocabulary{}, nil)
-				mock.When(voc.GetByID(mock.Exact[context.Context](ctx), mock.Equal[uint64](99))).
-					ThenReturn(nil, io.EOF)
+				voc := mock.Mock[repository.Vocabulary]()
+				mock.When(voc.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.VocabularyFilter]())).
+					ThenAnswer(func(args []any) []any {
+						if args[1].(*repository.VocabularyFilter).ID[0] == 99 {
+							return []any{nil, io.EOF}
+						}
+
+						return []any{[]*model.Vocabulary{{}}, nil}
+					})
 
 				return term.New(&term.Config{
-					TermRepository:    termrepo,
-					VocabularyService: voc,
-					Logger:            zap.NewNop(),
+					TermRepository:       termrepo,
+					VocabularyRepository: voc,
+					Logger:               zap.NewNop(),
 				})
 			},
 			update: &model.TermData{
@@ -340,3 +427,148 @@ func TestTermService_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestTermService_Link(t *testing.T) {
+	tests := []struct {
+		name    string
+		superID uint64
+		subID   uint64
+		found   []*model.Term
+		linkErr error
+		err     error
+	}{
+		{
+			name:    `linked`,
+			superID: 1,
+			subID:   2,
+			found:   []*model.Term{{ID: 1}, {ID: 2}},
+		},
+		{
+			name:    `self link`,
+			superID: 3,
+			subID:   3,
+			err:     taxonomy.ErrHierarchyCycle,
+		},
+		{
+			name:    `sub term not found`,
+			superID: 1,
+			subID:   2,
+			found:   []*model.Term{{ID: 1}},
+			err:     taxonomy.ErrTermNotFound,
+		},
+		{
+			name:    `repository error`,
+			superID: 1,
+			subID:   2,
+			found:   []*model.Term{{ID: 1}, {ID: 2}},
+			linkErr: io.EOF,
+			err:     taxonomy.ErrTermNotUpdated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+			var ctx = context.Background()
+
+			termrepo := mock.Mock[repository.Term]()
+			if tt.found != nil {
+				mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
+					ThenReturn(tt.found, nil)
+			}
+			if len(tt.found) == 2 {
+				mock.When(termrepo.Link(mock.Exact[context.Context](ctx), mock.Equal(tt.superID), mock.Equal(tt.subID))).
+					ThenReturn(tt.linkErr)
+			}
+
+			err := term.New(&term.Config{
+				TermRepository: termrepo,
+				Logger:         zap.NewNop(),
+			}).Link(ctx, tt.superID, tt.subID)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				mock.Verify(termrepo, mock.Once()).Link(mock.Any[context.Context](), mock.Any[uint64](), mock.Any[uint64]())
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestTermService_Unlink(t *testing.T) {
+	mock.SetUp(t)
+	var ctx = context.Background()
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
+		ThenReturn([]*model.Term{{ID: 5}, {ID: 6}}, nil)
+	mock.When(termrepo.Unlink(mock.Exact[context.Context](ctx), mock.Equal[uint64](5), mock.Equal[uint64](6))).
+		ThenReturn(nil)
+
+	err := term.New(&term.Config{
+		TermRepository: termrepo,
+		Logger:         zap.NewNop(),
+	}).Unlink(ctx, 5, 6)
+	assert.NoError(t, err)
+	mock.Verify(termrepo, mock.Once()).Unlink(mock.Any[context.Context](), mock.Any[uint64](), mock.Any[uint64]())
+}
+
+func TestTermService_UpdateSelfLink(t *testing.T) {
+	mock.SetUp(t)
+	var ctx = context.Background()
+
+	termrepo := mock.Mock[repository.Term]()
+	mock.When(termrepo.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.TermFilter]())).
+		ThenReturn([]*model.Term{{ID: 7}}, nil)
+
+	_, err := term.New(&term.Config{
+		TermRepository: termrepo,
+		Logger:         zap.NewNop(),
+	}).Update(ctx, 7, &model.TermData{SuperID: []uint64{7}})
+	assert.ErrorIs(t, err, taxonomy.ErrHierarchyCycle)
+	mock.Verify(termrepo, mock.Never()).Update(mock.Any[context.Context](), mock.Any[uint64](), mock.Any[*model.TermData]())
+}
+
+func TestTermService_Synonyms(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		data *model.TermData
+		err  error
+	}{
+		{`synonym is a name of other term`, &model.TermData{Synonyms: []model.Synonym{{Name: `red`}}},
+			taxonomy.ErrTermNotUnique},
+		{`name is a synonym of other term`, &model.TermData{Name: `crimson`}, taxonomy.ErrTermNotUnique},
+		{`synonym is the term's name`, &model.TermData{Synonyms: []model.Synonym{{Name: `green`}}},
+			taxonomy.ErrTermNotUnique},
+		{`repeated synonym`, &model.TermData{Synonyms: []model.Synonym{{Name: `lime`}, {Name: `lime`, Hidden: true}}},
+			taxonomy.ErrTermNotUnique},
+		{`empty synonym`, &model.TermData{Synonyms: []model.Synonym{{Name: ``}}}, taxonomy.ErrSynonymEmpty},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+			var ctx = context.Background()
+			s, termrepo := colors(ctx)
+
+			tt.data.VocabularyID = []uint64{1}
+			_, err := s.Update(ctx, 2, tt.data)
+			assert.ErrorIs(t, err, tt.err)
+			mock.Verify(termrepo, mock.Never()).Update(mock.Any[context.Context](), mock.Any[uint64](), mock.Any[*model.TermData]())
+		})
+	}
+
+	t.Run(`synonyms in other locales`, func(t *testing.T) {
+		mock.SetUp(t)
+		var ctx = context.Background()
+		s, termrepo := colors(ctx)
+
+		mock.When(termrepo.Update(mock.Exact[context.Context](ctx), mock.Any[uint64](), mock.Any[*model.TermData]())).
+			ThenAnswer(func(args []any) []any {
+				return []any{&model.Term{ID: args[1].(uint64), Data: *args[2].(*model.TermData)}, nil}
+			})
+
+		updated, err := s.Update(ctx, 2, &model.TermData{VocabularyID: []uint64{1}, Synonyms: []model.Synonym{{Name: `lime`},
+			{Name: `lime`, Locale: `en-GB`}}})
+		require.NoError(t, err)
+		assert.Equal(t, `green`, updated.Data.Name)
+		assert.Len(t, updated.Data.Synonyms, 2)
+	})
+}
@@ -0,0 +1,125 @@
+package vocabulary
+
+import (
+	"context"
+	"fmt"
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"slices"
+)
+
+// DefaultMaxDepth is used when maximum depth of vocabularies hierarchy isn't set in Config.
+const DefaultMaxDepth = 32
+
+// checkHierarchy checks that vocabulary with given id placed under parentID vocabulary doesn't make a cycle and the
+// hierarchy depth doesn't exceed the maximum. Zero id is used for a vocabulary which is being created.
+func (c *VocabularyService) checkHierarchy(ctx context.Context, id uint64, parentID uint64) error {
+	if parentID == id {
+		return &taxonomy.HierarchyError{Err: taxonomy.ErrHierarchyCycle, Path: []uint64{id, id}}
+	}
+
+	ancestors, err := c.vocabularyRepository.Ancestors(ctx, parentID, c.maxDepth)
+	if err != nil {
+		return fmt.Errorf(`unknown parent id error %w`, err)
+	}
+
+	// Path is ordered from the root to the parent
+	var path = make([]uint64, 0, len(ancestors)+1)
+	for _, ancestor := range ancestors {
+		path = append(path, ancestor.ID)
+	}
+
+	path = append(path, parentID)
+
+	if i := slices.Index(path, id); i >= 0 && id != 0 {
+		return &taxonomy.HierarchyError{Err: taxonomy.ErrHierarchyCycle, Path: append(path[i:], id)}
+	}
+
+	down, err := c.height(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if depth := uint(len(path)) + 1 + down; depth > c.maxDepth {
+		return fmt.Errorf(`%w: %d levels, maximum is %d`, taxonomy.ErrHierarchyTooDeep, depth, c.maxDepth)
+	}
+
+	return nil
+}
+
+// height returns count of levels of children below the vocabulary.
+func (c *VocabularyService) height(ctx context.Context, id uint64) (uint, error) {
+	if id == 0 {
+		return 0, nil
+	}
+
+	var (
+		count   uint
+		current = []uint64{id}
+	)
+
+	for count < c.maxDepth {
+		children, err := c.vocabularyRepository.Get(ctx, &repository.VocabularyFilter{ParentID: current})
+		if err != nil {
+			return 0, fmt.Errorf(`unknown error %w`, err)
+		}
+
+		if len(children) == 0 {
+			break
+		}
+
+		count++
+
+		current = make([]uint64, len(children))
+		for i, child := range children {
+			current[i] = child.ID
+		}
+	}
+
+	return count, nil
+}
+
+func (c *VocabularyService) Ancestors(ctx context.Context, id uint64) ([]*model.Vocabulary, error) {
+	if _, err := c.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	ancestors, err := c.vocabularyRepository.Ancestors(ctx, id, c.maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return ancestors, nil
+}
+
+func (c *VocabularyService) Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Vocabulary, error) {
+	if _, err := c.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if depth == 0 || depth > c.maxDepth {
+		depth = c.maxDepth
+	}
+
+	descendants, err := c.vocabularyRepository.Descendants(ctx, id, depth)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return descendants, nil
+}
+
+func (c *VocabularyService) Path(ctx context.Context, id uint64) ([]*model.Vocabulary, error) {
+	vocabulary, err := c.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors, err := c.vocabularyRepository.Ancestors(ctx, id, c.maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf(`unknown error %w`, err)
+	}
+
+	return append(ancestors, vocabulary), nil
+}
@@ -0,0 +1,130 @@
+package vocabulary
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/dmalykh/taxonomy/taxonomy"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/dmalykh/taxonomy/taxonomy/repository"
+	"github.com/ovechkin-dm/mockio/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestVocabularyService_UpdateHierarchy(t *testing.T) {
+	// 1 <- 2 <- 3 <- 4, 1 <- 5
+	var parents = map[uint64]uint64{2: 1, 3: 2, 4: 3, 5: 1}
+
+	tests := []struct {
+		name     string
+		id       uint64
+		parentID uint64
+		maxDepth uint
+		path     []uint64
+		err      error
+	}{
+		{
+			name:     `moved`,
+			id:       5,
+			parentID: 3,
+		},
+		{
+			name:     `cycle`,
+			id:       2,
+			parentID: 4,
+			path:     []uint64{2, 3, 4, 2},
+			err:      taxonomy.ErrHierarchyCycle,
+		},
+		{
+			name:     `parent is itself`,
+			id:       3,
+			parentID: 3,
+			path:     []uint64{3, 3},
+			err:      taxonomy.ErrHierarchyCycle,
+		},
+		{
+			name:     `too deep with children`,
+			id:       2,
+			parentID: 5,
+			maxDepth: 4,
+			err:      taxonomy.ErrHierarchyTooDeep,
+		},
+		{
+			name:     `deep enough`,
+			id:       5,
+			parentID: 3,
+			maxDepth: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.SetUp(t)
+			var ctx = context.Background()
+
+			vocabularyRepository := mock.Mock[repository.Vocabulary]()
+			mock.When(vocabularyRepository.Get(mock.Exact[context.Context](ctx), mock.Any[*repository.VocabularyFilter]())).
+				ThenAnswer(func(args []any) []any {
+					filter := args[1].(*repository.VocabularyFilter)
+					found := make([]*model.Vocabulary, 0)
+
+					for id := uint64(1); id <= 5; id++ {
+						parentID, ok := parents[id]
+						if len(filter.ID) > 0 && !slices.Contains(filter.ID, id) {
+							continue
+						}
+
+						if len(filter.ParentID) > 0 && (!ok || !slices.Contains(filter.ParentID, parentID)) {
+							continue
+						}
+
+						vocabulary := &model.Vocabulary{ID: id}
+						if ok {
+							vocabulary.Data.ParentID = &parentID
+						}
+
+						found = append(found, vocabulary)
+					}
+
+					return []any{found, nil}
+				})
+			if tt.id != tt.parentID {
+				mock.When(vocabularyRepository.Ancestors(mock.Exact[context.Context](ctx), mock.Equal(tt.parentID), mock.Any[uint]())).
+					ThenAnswer(func(args []any) []any {
+						ancestors := make([]*model.Vocabulary, 0)
+						for id, ok := parents[args[1].(uint64)]; ok; id, ok = parents[id] {
+							ancestors = append([]*model.Vocabulary{{ID: id}}, ancestors...)
+						}
+
+						return []any{ancestors, nil}
+					})
+			}
+			if tt.err == nil {
+				mock.When(vocabularyRepository.Update(mock.Exact[context.Context](ctx), mock.Equal(tt.id), mock.Any[*model.VocabularyData]())).
+					ThenReturn(&model.Vocabulary{ID: tt.id}, nil)
+			}
+
+			service := New(&Config{
+				VocabularyRepository: vocabularyRepository,
+				Logger:               zap.NewNop(),
+				MaxDepth:             tt.maxDepth,
+			})
+
+			_, err := service.Update(ctx, tt.id, &model.VocabularyData{ParentID: &tt.parentID})
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+
+			if tt.path != nil {
+				var hierarchyErr *taxonomy.HierarchyError
+				require.ErrorAs(t, err, &hierarchyErr)
+				assert.Equal(t, tt.path, hierarchyErr.Path)
+			}
+		})
+	}
+}
@@ -13,26 +13,50 @@ import (
 )
 
 type Config struct {
+	// Transaction wraps changes of vocabularies, repository.NoTransaction is used when it's nil.
+	Transaction          repository.Transaction
 	VocabularyRepository repository.Vocabulary
 	TermService          taxonomy.Term
 	Logger               *zap.Logger
+	// MaxDepth limits count of levels in vocabularies hierarchy, DefaultMaxDepth is used when it's zero.
+	MaxDepth uint
 }
 
 func New(config *Config) taxonomy.Vocabulary {
+	var maxDepth = config.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxDepth
+	}
+
+	var transaction = config.Transaction
+	if transaction == nil {
+		transaction = repository.NoTransaction{}
+	}
+
 	return &VocabularyService{
+		transaction:          transaction,
 		termService:          config.TermService,
 		vocabularyRepository: config.VocabularyRepository,
 		log:                  config.Logger,
+		maxDepth:             maxDepth,
 	}
 }
 
 type VocabularyService struct {
+	transaction          repository.Transaction
 	termService          taxonomy.Term
 	vocabularyRepository repository.Vocabulary
 	log                  *zap.Logger
+	maxDepth             uint
 }
 
 func (c *VocabularyService) Create(ctx context.Context, data *model.VocabularyData) (*model.Vocabulary, error) {
+	return repository.InTransaction(ctx, c.transaction, func(ctx context.Context) (*model.Vocabulary, error) {
+		return c.create(ctx, data)
+	})
+}
+
+func (c *VocabularyService) create(ctx context.Context, data *model.VocabularyData) (*model.Vocabulary, error) {
 	logger := c.log.With(zap.String(`method`, `Create`), zap.Any(`data`, *data))
 	// Check parent's vocabulary exists
 	if data.ParentID != nil {
@@ -44,6 +68,10 @@ func (c *VocabularyService) Create(ctx context.Context, data *model.VocabularyDa
 
 			return nil, fmt.Errorf(`id %d %w`, *data.ParentID, taxonomy.ErrVocabularyNotFound)
 		}
+
+		if err := c.checkHierarchy(ctx, 0, *data.ParentID); err != nil {
+			return nil, err
+		}
 	}
 	// Create vocabulary
 	vocabulary, err := c.vocabularyRepository.Create(ctx, data)
@@ -57,6 +85,12 @@ func (c *VocabularyService) Create(ctx context.Context, data *model.VocabularyDa
 }
 
 func (c *VocabularyService) Update(ctx context.Context, id uint64, data *model.VocabularyData) (*model.Vocabulary, error) {
+	return repository.InTransaction(ctx, c.transaction, func(ctx context.Context) (*model.Vocabulary, error) {
+		return c.update(ctx, id, data)
+	})
+}
+
+func (c *VocabularyService) update(ctx context.Context, id uint64, data *model.VocabularyData) (*model.Vocabulary, error) {
 	logger := c.log.With(zap.String(`method`, `Update`), zap.Uint64("id", id))
 
 	vocabularies, err := c.vocabularyRepository.Get(ctx, &repository.VocabularyFilter{ID: []uint64{id}})
@@ -101,6 +135,9 @@ func (c *VocabularyService) Update(ctx context.Context, id uint64, data *model.V
 		data.Description = vocabulary.Data.Description
 	}
 
+	// Labels of omitted locales are kept
+	data.Labels = data.Labels.Merge(vocabulary.Data.Labels)
+
 	if data.ParentID == nil {
 		data.ParentID = vocabulary.Data.ParentID
 	} else if *data.ParentID == 0 {
@@ -108,8 +145,10 @@ func (c *VocabularyService) Update(ctx context.Context, id uint64, data *model.V
 	}
 
 	// Avoid loops with ParentID
-	if data.ParentID != nil && *data.ParentID == id {
-		return nil, fmt.Errorf(`parentid (%d) can't equals id (%d)`, *data.ParentID, id)
+	if data.ParentID != nil {
+		if err := c.checkHierarchy(ctx, id, *data.ParentID); err != nil {
+			return nil, err
+		}
 	}
 
 	vocabulary, err = c.vocabularyRepository.Update(ctx, vocabulary.ID, data)
@@ -124,6 +163,12 @@ func (c *VocabularyService) Update(ctx context.Context, id uint64, data *model.V
 
 // Delete vocabulary and it's dependencies.
 func (c *VocabularyService) Delete(ctx context.Context, id uint64) error {
+	return c.transaction.Run(ctx, func(ctx context.Context) error {
+		return c.delete(ctx, id)
+	})
+}
+
+func (c *VocabularyService) delete(ctx context.Context, id uint64) error {
 	logger := c.log.With(zap.String(`method`, `Delete`), zap.Uint64("id", id))
 	// Check vocabulary exists
 	if _, err := c.GetByID(ctx, id); err != nil {
@@ -131,15 +176,16 @@ func (c *VocabularyService) Delete(ctx context.Context, id uint64) error {
 	}
 
 	// Check terms. Vocabulary should be empty before deletion
-	terms, err := c.termService.Get(ctx, &model.TermFilter{VocabularyID: []uint64{id}})
-	logger.Debug(`get terms of vocabulary`, zap.Error(err))
+	count, err := c.termService.Count(ctx, &model.TermFilter{VocabularyID: []uint64{id}})
+	logger.Debug(`count terms of vocabulary`, zap.Uint64(`count`, count), zap.Error(err))
 
 	if err != nil {
 		return fmt.Errorf(`unknown error %w`, err)
 	}
 
-	if len(terms) > 0 {
-		return taxonomy.ErrVocabularyHasTerms
+	if count > 0 {
+		return fmt.Errorf(`can't remove vocabulary %d: %w`, id,
+			&taxonomy.BlockedError{Err: taxonomy.ErrVocabularyHasTerms, Count: count})
 	}
 
 	// Delete vocabulary
@@ -186,7 +232,7 @@ func (c *VocabularyService) GetByID(ctx context.Context, id uint64) (*model.Voca
 		return nil, fmt.Errorf(`%w, got %d results`, taxonomy.ErrVocabularyNotFound, len(vocabularies))
 	}
 
-	return nil, nil
+	return vocabularies[0], nil
 }
 
 func (c *VocabularyService) exists(ctx context.Context, id uint64) (bool, error) {
@@ -2,10 +2,12 @@ package vocabulary
 
 import (
 	"context"
+	"github.com/dmalykh/taxonomy/taxonomy"
 	"github.com/dmalykh/taxonomy/taxonomy/model"
 	"github.com/dmalykh/taxonomy/taxonomy/repository"
 	"github.com/ovechkin-dm/mockio/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"testing"
 )
@@ -40,6 +42,7 @@ func TestVocabularyService_Create(t *testing.T) {
 			var ctx = context.Background()
 			vocabularyRepository := mock.Mock[repository.Vocabulary]()
 			service := VocabularyService{
+				transaction:          repository.NoTransaction{},
 				log:                  zap.NewNop(),
 				vocabularyRepository: vocabularyRepository,
 			}
@@ -55,3 +58,32 @@ func TestVocabularyService_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestVocabularyService_DeleteBlocked(t *testing.T) {
+	mock.SetUp(t)
+
+	var ctx = context.Background()
+
+	vocabularyRepository := mock.Mock[repository.Vocabulary]()
+	mock.When(vocabularyRepository.Get(mock.Any[context.Context](), mock.Any[*repository.VocabularyFilter]())).
+		ThenReturn([]*model.Vocabulary{{ID: 3}}, nil)
+
+	// Terms are counted by the database rather than loaded
+	termService := mock.Mock[taxonomy.Term]()
+	mock.When(termService.Count(mock.Any[context.Context](), mock.Equal(&model.TermFilter{VocabularyID: []uint64{3}}))).
+		ThenReturn(uint64(7), nil)
+
+	service := VocabularyService{
+		transaction:          repository.NoTransaction{},
+		log:                  zap.NewNop(),
+		termService:          termService,
+		vocabularyRepository: vocabularyRepository,
+	}
+
+	err := service.Delete(ctx, 3)
+	assert.ErrorIs(t, err, taxonomy.ErrVocabularyHasTerms)
+
+	var blocked *taxonomy.BlockedError
+	require.ErrorAs(t, err, &blocked)
+	assert.Equal(t, uint64(7), blocked.Count)
+}
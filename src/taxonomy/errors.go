@@ -0,0 +1,81 @@
+package taxonomy
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// BlockedError is returned when object can't be removed because of dependent objects, i.e. term with references or
+// vocabulary with terms. It wraps ErrReferenceExists or ErrVocabularyHasTerms and contains count of objects which
+// blocked deletion.
+type BlockedError struct {
+	Err   error
+	Count uint64
+}
+
+func (e *BlockedError) Error() string {
+	return fmt.Sprintf(`%s: %d`, e.Err.Error(), e.Count)
+}
+
+func (e *BlockedError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	ErrHierarchyCycle   = errors.New(`hierarchy cycle`)
+	ErrHierarchyTooDeep = errors.New(`hierarchy is too deep`)
+)
+
+// HierarchyError is returned when vocabulary parent or term broader-narrower link would make a cycle. Path contains
+// ids of objects which form the cycle ordered from broader to narrower one, the first and the last ids are equal.
+// Zero id stands for the term which is being created.
+type HierarchyError struct {
+	Err  error
+	Path []uint64
+}
+
+func (e *HierarchyError) Error() string {
+	path := make([]string, len(e.Path))
+	for i, id := range e.Path {
+		path[i] = strconv.FormatUint(id, 10)
+	}
+
+	return fmt.Sprintf(`%s: %s`, e.Err.Error(), strings.Join(path, ` -> `))
+}
+
+func (e *HierarchyError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError is returned by bulk operations when some of items are invalid, nothing is changed in this case. Errors
+// keeps error of every failed item by item's index.
+type BulkError struct {
+	Errors map[int]error
+}
+
+// Indexes returns indexes of failed items in ascending order.
+func (e *BulkError) Indexes() []int {
+	return slices.Sorted(maps.Keys(e.Errors))
+}
+
+func (e *BulkError) Error() string {
+	items := make([]string, 0, len(e.Errors))
+	for _, i := range e.Indexes() {
+		items = append(items, fmt.Sprintf(`#%d: %s`, i, e.Errors[i].Error()))
+	}
+
+	return fmt.Sprintf(`%d items failed: %s`, len(e.Errors), strings.Join(items, `; `))
+}
+
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, i := range e.Indexes() {
+		errs = append(errs, e.Errors[i])
+	}
+
+	return errs
+}
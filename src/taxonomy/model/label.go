@@ -0,0 +1,163 @@
+package model
+
+import (
+	"slices"
+	"strings"
+)
+
+// Label is a title and a description of a term or a vocabulary in some locale.
+type Label struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// IsZero returns true when label has neither title nor description.
+func (l Label) IsZero() bool {
+	return l.Title == `` && l.Description == ``
+}
+
+// Labels are translations keyed by locales like "de" or "pt-BR". Title and Description of data are used when
+// no label is found for requested locales.
+type Labels map[string]Label
+
+// Merge returns current labels replaced with labels of l by their locales, empty label removes the locale.
+func (l Labels) Merge(current Labels) Labels {
+	if len(l) == 0 {
+		return current
+	}
+
+	var merged = make(Labels, len(current)+len(l))
+
+	for locale, label := range current {
+		merged[NormalizeLocale(locale)] = label
+	}
+
+	for locale, label := range l {
+		if label.IsZero() {
+			delete(merged, NormalizeLocale(locale))
+
+			continue
+		}
+
+		merged[NormalizeLocale(locale)] = label
+	}
+
+	if len(merged) == 0 {
+		return nil
+	}
+
+	return merged
+}
+
+// Title returns the first non-empty title for locales of the chain, otherwise the default title is returned.
+func (l Labels) Title(chain []string, def string) string {
+	for _, locale := range chain {
+		if title := l[locale].Title; title != `` {
+			return title
+		}
+	}
+
+	return def
+}
+
+// Description returns the first non-empty description for locales of the chain, otherwise the default one is
+// returned.
+func (l Labels) Description(chain []string, def string) string {
+	for _, locale := range chain {
+		if description := l[locale].Description; description != `` {
+			return description
+		}
+	}
+
+	return def
+}
+
+// Locales returns sorted locales of labels.
+func (l Labels) Locales() []string {
+	var locales = make([]string, 0, len(l))
+	for locale := range l {
+		locales = append(locales, locale)
+	}
+
+	slices.Sort(locales)
+
+	return locales
+}
+
+// Fallback describes which locales are tried when a label isn't translated to the requested one.
+type Fallback struct {
+	// Chains contains locales tried after the locale, e.g. "de-AT": ["de-DE"]
+	Chains map[string][]string
+	// Default locales are tried after all others
+	Default []string
+}
+
+// Chain returns locales to look labels up in order of preference. Every locale is followed by its configured chain
+// and then by parents of them, i.e. "de-AT" by "de", default locales go last.
+func (f *Fallback) Chain(locales ...string) []string {
+	var chain = make([]string, 0, len(locales)*2)
+
+	add := func(locale string) {
+		if locale = NormalizeLocale(locale); locale != `` && !slices.Contains(chain, locale) {
+			chain = append(chain, locale)
+		}
+	}
+
+	for _, locale := range locales {
+		var configured = append([]string{locale}, f.chain(locale)...)
+
+		for _, l := range configured {
+			add(l)
+		}
+
+		for _, l := range configured {
+			for parent := NormalizeLocale(l); strings.Contains(parent, `-`); {
+				parent = parent[:strings.LastIndex(parent, `-`)]
+				add(parent)
+			}
+		}
+	}
+
+	if f != nil {
+		for _, locale := range f.Default {
+			add(locale)
+		}
+	}
+
+	return chain
+}
+
+func (f *Fallback) chain(locale string) []string {
+	if f == nil {
+		return nil
+	}
+
+	for l, chain := range f.Chains {
+		if NormalizeLocale(l) == NormalizeLocale(locale) {
+			return chain
+		}
+	}
+
+	return nil
+}
+
+// NormalizeLocale returns locale in the BCP 47 letter case: language is lowercased, script is titled and region is
+// uppercased, underscores are replaced with hyphens, i.e. "pt_br" becomes "pt-BR".
+func NormalizeLocale(locale string) string {
+	var subtags = strings.FieldsFunc(strings.TrimSpace(locale), func(r rune) bool { return r == '-' || r == '_' })
+
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 2:
+			subtags[i] = strings.ToUpper(subtag)
+		case len(subtag) == 4:
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+
+	return strings.Join(subtags, `-`)
+}
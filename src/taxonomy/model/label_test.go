@@ -0,0 +1,39 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFallback_Chain(t *testing.T) {
+	var fallback = &model.Fallback{
+		Chains:  map[string][]string{`de_at`: {`de-DE`}, `pt`: {`pt-BR`}},
+		Default: []string{`en`},
+	}
+
+	assert.Equal(t, []string{`de-AT`, `de-DE`, `de`, `en`}, fallback.Chain(`de-at`))
+	assert.Equal(t, []string{`pt-PT`, `pt`, `fr`, `en`}, fallback.Chain(`pt-PT`, `fr`))
+	assert.Equal(t, []string{`zh-Hant-TW`, `zh-Hant`, `zh`, `en`}, fallback.Chain(`zh-hant-tw`))
+	assert.Equal(t, []string{`en`}, fallback.Chain(``))
+	assert.Equal(t, []string{`de-CH`, `de`}, (*model.Fallback)(nil).Chain(`de-CH`))
+}
+
+func TestLabels(t *testing.T) {
+	var labels = model.Labels{
+		`de`:    {Title: `Rot`, Description: `Farbe`},
+		`de-CH`: {Title: `Rot (CH)`},
+	}
+
+	assert.Equal(t, `Rot (CH)`, labels.Title([]string{`de-CH`, `de`}, `Red`))
+	assert.Equal(t, `Farbe`, labels.Description([]string{`de-CH`, `de`}, `Color`))
+	assert.Equal(t, `Red`, labels.Title([]string{`fr`}, `Red`))
+
+	assert.Equal(t, model.Labels{
+		`de`: {Title: `Rot`, Description: `Farbe`},
+		`fr`: {Title: `Rouge`},
+	}, model.Labels{`fr`: {Title: `Rouge`}, `de-ch`: {}}.Merge(labels))
+	assert.Equal(t, labels, model.Labels(nil).Merge(labels))
+	assert.Nil(t, model.Labels{`de`: {}}.Merge(model.Labels{`de`: {Title: `Rot`}}))
+}
@@ -15,4 +15,49 @@ type ReferenceFilter struct {
 	EntityID  []EntityID // OR operand if used
 	AfterID   *uint64
 	Limit     *uint
+	// ExcludeTermID removes entities related with any term of any group, so {{A}} in TermID with {{B}} in
+	// ExcludeTermID means "A AND NOT B". Groups are expanded the same way as TermID groups.
+	ExcludeTermID [][]uint64
+	// WithSubterms expands every group of TermID with all narrower terms, so filtering by "Laptops" also matches
+	// entities tagged with "Gaming laptops".
+	WithSubterms bool
+	// WithVocabularyDescendants expands every group of TermID with terms of vocabularies nested into vocabularies of
+	// its terms.
+	WithVocabularyDescendants bool
+}
+
+// Facets keeps counts of entities which match the filter and would match it with one more term or vocabulary.
+type Facets struct {
+	Terms        []*TermFacet
+	Vocabularies []*VocabularyFacet
+}
+
+// TermFacet is a count of matched entities which are related with the term.
+type TermFacet struct {
+	TermID uint64
+	Count  uint64
+}
+
+// VocabularyFacet is a count of matched entities which are related with any term of the vocabulary.
+type VocabularyFacet struct {
+	VocabularyID uint64
+	Count        uint64
+}
+
+// EntityTerms keeps terms of the entity grouped by their vocabularies.
+type EntityTerms struct {
+	EntityID     EntityID
+	Vocabularies []*VocabularyTerms
+}
+
+// VocabularyTerms is a group of entity's terms from the vocabulary.
+type VocabularyTerms struct {
+	VocabularyID uint64
+	Terms        []*Term
+}
+
+// ReferenceChanges keeps ids of terms which references with the entity were added and removed.
+type ReferenceChanges struct {
+	Added   []uint64
+	Removed []uint64
 }
@@ -0,0 +1,40 @@
+package model
+
+// SearchMode defines how a search query matches terms.
+type SearchMode uint8
+
+const (
+	// SearchPrefix matches terms having words which start with every word of the query, i.e. "dar re" matches
+	// "Dark red".
+	SearchPrefix SearchMode = iota
+	// SearchSubstring matches terms containing the query in any case.
+	SearchSubstring
+	// SearchFuzzy matches words like SearchPrefix does, but words of four letters and longer may have a typo after
+	// their first two letters, words of eight letters and longer may have two typos.
+	SearchFuzzy
+)
+
+// SearchField is a field of a term where the query is found.
+type SearchField string
+
+const (
+	SearchName        SearchField = `name`
+	SearchSynonym     SearchField = `synonym`
+	SearchTitle       SearchField = `title`
+	SearchDescription SearchField = `description`
+)
+
+// TermSearch looks terms up by names, synonyms, titles and descriptions.
+type TermSearch struct {
+	Query        string
+	Mode         SearchMode
+	VocabularyID []uint64 // anyOf
+	Limit        uint
+}
+
+// TermMatch is a found term with its relevance, score of the best matches is close to 1.
+type TermMatch struct {
+	Term  *Term
+	Score float64
+	Field SearchField // field with the best match
+}
@@ -0,0 +1,20 @@
+package model
+
+// TermSuggest asks for terms completing the prefix typed by a user.
+type TermSuggest struct {
+	Prefix       string
+	VocabularyID []uint64 // anyOf
+	// Namespace orders suggestions by count of entities of the namespace related with terms, i.e. terms used by
+	// products go first when products are tagged.
+	Namespace string
+	Limit     uint
+}
+
+// TermSuggestion is a term with its name, synonym or title completing the prefix.
+type TermSuggestion struct {
+	Term  *Term
+	Text  string
+	Field SearchField
+	// Popularity is a count of entities of the namespace related with the term.
+	Popularity uint64
+}
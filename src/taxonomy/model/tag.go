@@ -0,0 +1,28 @@
+package model
+
+// TextTagging asks for terms which names or synonyms are mentioned in the text.
+type TextTagging struct {
+	Text         string
+	VocabularyID []uint64 // anyOf
+	// Overlapping returns mentions inside longer ones too, i.e. "red" inside "dark red". Only the longest mention is
+	// returned by default.
+	Overlapping bool
+	// Reference relates the entity with all mentioned terms when it's set.
+	Reference *TagReference
+}
+
+// TagReference is an entity which is related with terms mentioned in its text.
+type TagReference struct {
+	Namespace string
+	EntityID  EntityID
+}
+
+// TextTag is a mention of the term in the text. Start and End count characters (runes) of the text, End is
+// exclusive.
+type TextTag struct {
+	Term  *Term
+	Text  string // the mention as it's written in the text
+	Start int
+	End   int
+	Field SearchField // name or synonym
+}
@@ -9,16 +9,28 @@ type TermData struct {
 	Name         string
 	Title        string
 	Description  string
+	Labels       Labels // translations of title and description by locales
+	Synonyms     []Synonym
 	VocabularyID []uint64
 	SuperID      []uint64
 	SubID        []uint64
 }
 
+// Synonym is an alternative name of the term, names and synonyms of terms are unique in every vocabulary. Hidden
+// synonyms are used for lookups only, i.e. misspellings.
+type Synonym struct {
+	Name   string `json:"name"`
+	Locale string `json:"locale,omitempty"` // empty for synonyms of any locale
+	Hidden bool   `json:"hidden,omitempty"`
+}
+
 type TermFilter struct {
+	ID           []uint64 // anyOf
 	VocabularyID []uint64 // anyOf
 	SuperID      []uint64 // anyOf
 	SubID        []uint64 // anyOf
 	Name         *string
+	WithSynonyms bool // Name matches synonyms too
 	AfterID      *uint64
 	Limit        uint
 	Offset       uint
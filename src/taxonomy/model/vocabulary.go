@@ -9,6 +9,7 @@ type VocabularyData struct {
 	Name        string
 	Title       string
 	Description *string
+	Labels      Labels // translations of title and description by locales
 	ParentID    *uint64
 }
 
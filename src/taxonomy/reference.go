@@ -10,6 +10,7 @@ var (
 	ErrReferenceExists     = errors.New(`references exists`)
 	ErrReferenceNotCreated = errors.New(`term's reference had not created`)
 	ErrReferenceNotRemoved = errors.New(`term's reference had not removed`)
+	ErrTermNotInVocabulary = errors.New(`term doesn't belong to vocabulary`)
 )
 
 type Reference interface {
@@ -38,4 +39,33 @@ type Reference interface {
 	//				}
 	//			}
 	Get(ctx context.Context, filter *model.ReferenceFilter) ([]*model.Reference, error)
+
+	// Count returns count of references matching the filter. Unlike Get, namespace isn't required, so it's suitable
+	// to check whether term is used anywhere.
+	Count(ctx context.Context, filter *model.ReferenceFilter) (uint64, error)
+
+	// Iterate calls fn for every reference matching the filter in ID order. References are read from repository by
+	// batches of batchSize, so it's suitable to export all references of namespace. filter.AfterID is used as start
+	// cursor to resume iteration from the last handled reference, filter.Limit is ignored.
+	Iterate(ctx context.Context, filter *model.ReferenceFilter, batchSize uint, fn func(reference *model.Reference) error) error
+
+	// Facets returns counts of entities matching the filter for every term and vocabulary related with them. Count
+	// of term's facet is a count of entities which would match the filter with one more group of the term, i.e.
+	// how many entities would be found if the term is selected. Vocabulary's facet counts entities having any of
+	// vocabulary's terms. Namespace is required, filter.AfterID and filter.Limit are ignored.
+	Facets(ctx context.Context, filter *model.ReferenceFilter) (*model.Facets, error)
+
+	// GetTerms returns terms of every entity from the namespace grouped by vocabularies. Entities are returned in
+	// the order they were given, entity without terms has no groups. Term from several vocabularies is placed into
+	// every group.
+	GetTerms(ctx context.Context, namespace string, entitiesID ...model.EntityID) ([]*model.EntityTerms, error)
+
+	// Replace makes termsID the only terms of the entity: missing references are created and references with other
+	// terms are removed. Returned changes keep ids of added and removed terms.
+	Replace(ctx context.Context, namespace string, entityID model.EntityID, termsID []uint64) (*model.ReferenceChanges, error)
+
+	// ReplaceInVocabulary works like Replace, but only terms of the vocabulary are replaced, so entity keeps terms
+	// of other vocabularies. Every term of termsID should belong to the vocabulary.
+	ReplaceInVocabulary(ctx context.Context, namespace string, entityID model.EntityID, vocabularyID uint64,
+		termsID []uint64) (*model.ReferenceChanges, error)
 }
@@ -11,12 +11,25 @@ var (
 	ErrGetReference     = errors.New(`failed to get reference`)
 	ErrWithoutNamespace = errors.New(`namespace required`)
 	ErrDeleteReferences = errors.New(`failed to delete reference`)
+	ErrZeroBatchSize    = errors.New(`batch size should be greater than zero`)
 )
 
 type Reference interface {
 	Set(ctx context.Context, reference ...*ReferenceModel) error
 	Delete(ctx context.Context, filter *ReferenceFilter) error
 	Get(ctx context.Context, filter *ReferenceFilter) ([]*ReferenceModel, error)
+	// Count returns count of references matching the filter, namespace isn't required.
+	Count(ctx context.Context, filter *ReferenceFilter) (uint64, error)
+	// Iterate calls fn for every reference matching the filter in ID order. References are fetched by batches of
+	// batchSize, next batch is fetched only when fn returned for every reference of the previous one. filter.AfterID
+	// is used as start cursor, filter.Limit is ignored. Iteration stops on the first error returned by fn.
+	Iterate(ctx context.Context, filter *ReferenceFilter, batchSize uint, fn func(reference *ReferenceModel) error) error
+	// Facets returns counts of distinct entities matching the filter for every term and vocabulary they are related
+	// with, filter.AfterID and filter.Limit are ignored.
+	Facets(ctx context.Context, filter *ReferenceFilter) (*model.Facets, error)
+	// Terms returns terms of entities from the namespace ordered by id. Every term keeps ids of all its vocabularies,
+	// links between terms aren't loaded.
+	Terms(ctx context.Context, namespaceID uint64, entityID ...model.EntityID) (map[model.EntityID][]*model.Term, error)
 }
 
 // ReferenceFilter used for requests to repository.
@@ -27,6 +40,13 @@ type ReferenceFilter struct {
 	EntityID    []model.EntityID
 	AfterID     *uint64
 	Limit       *uint
+	// ExcludeTermID removes entities having any term of any group: NOT {X OR X} AND NOT {X}.
+	ExcludeTermID [][]uint64
+	// WithSubterms expands every group of TermID with all narrower terms of its terms.
+	WithSubterms bool
+	// WithVocabularyDescendants expands every group of TermID with terms of vocabularies nested into vocabularies of
+	// its terms.
+	WithVocabularyDescendants bool
 }
 
 type ReferenceModel struct {
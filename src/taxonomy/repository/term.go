@@ -17,7 +17,24 @@ type Term interface {
 	Create(ctx context.Context, data *model.TermData) (*model.Term, error)
 	Update(ctx context.Context, id uint64, data *model.TermData) (*model.Term, error)
 	Delete(ctx context.Context, filter *TermFilter) error
+	// CreateBulk creates terms with a few queries and returns them in the same order.
+	CreateBulk(ctx context.Context, data ...*model.TermData) ([]*model.Term, error)
+	// UpdateBulk updates terms by their ids and returns them in the same order.
+	UpdateBulk(ctx context.Context, terms ...*model.Term) ([]*model.Term, error)
 	Get(ctx context.Context, filter *TermFilter) ([]*model.Term, error)
+	// Count returns count of terms matching the filter, filter.Limit and filter.Offset are ignored.
+	Count(ctx context.Context, filter *TermFilter) (uint64, error)
+	// Link makes superID a broader term of subID.
+	Link(ctx context.Context, superID, subID uint64) error
+	// Unlink removes the broader-narrower relation between superID and subID.
+	Unlink(ctx context.Context, superID, subID uint64) error
+	// Ancestors returns all broader terms of the term up to depth levels, terms farther from the term go first.
+	Ancestors(ctx context.Context, id uint64, depth uint) ([]*model.Term, error)
+	// Descendants returns all narrower terms of the term up to depth levels ordered by distance from the term.
+	Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Term, error)
+	// Search returns candidates of search, shorter names go first. Full-text index of the database is used when it
+	// exists.
+	Search(ctx context.Context, search *TermSearch) ([]*model.Term, error)
 }
 
 type TermFilter struct {
@@ -26,7 +43,20 @@ type TermFilter struct {
 	SuperID      []uint64 // anyOf
 	SubID        []uint64 // anyOf
 	Name         *string
+	NameIn       []string // anyOf
+	WithSynonyms bool     // Name and NameIn match synonyms too
 	AfterID      *uint64
 	Limit        uint
 	Offset       uint
 }
+
+// TermSearch selects terms by names, titles, descriptions and names of synonyms. Either Prefixes or Substring is used.
+type TermSearch struct {
+	// Prefixes match terms having words which start with every prefix, prefixes consist of lowercase letters and
+	// digits
+	Prefixes []string
+	// Substring matches terms containing it in any case
+	Substring    string
+	VocabularyID []uint64 // anyOf
+	Limit        uint
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrTransaction = errors.New(`transaction failed`)
+
+// Transaction is a unit of work: all repositories' calls made with context passed to fn are applied atomically.
+type Transaction interface {
+	// Run calls fn in transaction. Transaction is committed when fn returns nil and rolled back otherwise. Run
+	// called with context of another transaction joins it, so services could call each other inside transactions.
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// NoTransaction calls functions as is, it's used by services when Transaction isn't configured.
+type NoTransaction struct{}
+
+func (NoTransaction) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// InTransaction runs fn in transaction and returns its result.
+func InTransaction[T any](ctx context.Context, transaction Transaction, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+
+	err := transaction.Run(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+
+		return err
+	})
+
+	return result, err
+}
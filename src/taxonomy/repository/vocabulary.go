@@ -19,6 +19,10 @@ type Vocabulary interface {
 	Update(ctx context.Context, id uint64, data *model.VocabularyData) (*model.Vocabulary, error)
 	Delete(ctx context.Context, filter *VocabularyFilter) error
 	Get(ctx context.Context, filter *VocabularyFilter) ([]*model.Vocabulary, error)
+	// Ancestors returns parents of the vocabulary up to depth levels ordered from the root.
+	Ancestors(ctx context.Context, id uint64, depth uint) ([]*model.Vocabulary, error)
+	// Descendants returns children of the vocabulary up to depth levels ordered by distance from the vocabulary.
+	Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Vocabulary, error)
 }
 
 type VocabularyFilter struct {
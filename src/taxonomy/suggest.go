@@ -0,0 +1,17 @@
+package taxonomy
+
+import (
+	"context"
+	"github.com/dmalykh/taxonomy/taxonomy/model"
+)
+
+type Suggester interface {
+	// Suggest returns terms which names, synonyms or titles have words starting with the prefix. Exact matches go
+	// first, then popular in the namespace terms, then shorter completions. Suggestions are served from memory, so
+	// they are fast enough to be requested on every keystroke.
+	Suggest(ctx context.Context, suggest *model.TermSuggest) ([]*model.TermSuggestion, error)
+	// Tag returns mentions of names and synonyms of terms in the text ordered by their offsets. Whole words are
+	// compared ignoring case and diacritics, so "Creme brulee" mentions "crème brûlée". Mentioned terms are related
+	// with the entity of tagging.Reference when it's set.
+	Tag(ctx context.Context, tagging *model.TextTagging) ([]*model.TextTag, error)
+}
@@ -10,14 +10,40 @@ var (
 	ErrTermNotFound   = errors.New(`term not found`)
 	ErrTermNotCreated = errors.New(`term had not created`)
 	ErrTermNotUpdated = errors.New(`term have not updated`)
+	ErrTermNotUnique  = errors.New(`term's name must be unique in vocabulary`)
+	ErrSynonymEmpty   = errors.New(`synonym's name is empty`)
 )
 
 type Term interface {
 	Create(ctx context.Context, data *model.TermData) (*model.Term, error)
 	Update(ctx context.Context, id uint64, data *model.TermData) (*model.Term, error)
 	Delete(ctx context.Context, id uint64) error
+	// CreateBulk creates all terms at once and returns them in the same order. Nothing is created when any term is
+	// invalid, BulkError contains errors of all invalid terms.
+	CreateBulk(ctx context.Context, data ...*model.TermData) ([]*model.Term, error)
+	// UpdateBulk updates all terms at once like Update does and returns them in the same order. Nothing is updated
+	// when any term is invalid, BulkError contains errors of all invalid terms.
+	UpdateBulk(ctx context.Context, terms ...*model.Term) ([]*model.Term, error)
 	GetByID(ctx context.Context, id uint64) (*model.Term, error)
 
+	// Link makes term superID broader than term subID. Linking already linked terms is not an error.
+	Link(ctx context.Context, superID, subID uint64) error
+	// Unlink removes broader-narrower relation between terms.
+	Unlink(ctx context.Context, superID, subID uint64) error
+
+	// Ancestors returns all broader terms, terms farther from the term go first.
+	Ancestors(ctx context.Context, id uint64) ([]*model.Term, error)
+	// Descendants returns all narrower terms ordered by distance from the term. Zero depth means maximum depth.
+	Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Term, error)
+	// Paths returns every chain of terms from a root term to the term, i.e. breadcrumbs. Term may have many paths
+	// because it may have many broader terms.
+	Paths(ctx context.Context, id uint64) ([][]*model.Term, error)
+
 	// Get returns slice with terms that proper for conditions. Set nil vocabulary_id to receive terms from all categories.
 	Get(ctx context.Context, filter *model.TermFilter) ([]*model.Term, error)
+	// Count returns count of terms matching the filter, filter.Limit and filter.Offset are ignored.
+	Count(ctx context.Context, filter *model.TermFilter) (uint64, error)
+	// Search returns terms matching the query by names, synonyms, titles or descriptions, the most relevant terms go
+	// first.
+	Search(ctx context.Context, search *model.TermSearch) ([]*model.TermMatch, error)
 }
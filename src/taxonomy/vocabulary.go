@@ -19,4 +19,11 @@ type Vocabulary interface {
 	Delete(ctx context.Context, id uint64) error
 	GetByID(ctx context.Context, id uint64) (*model.Vocabulary, error)
 	Get(ctx context.Context, filter *model.VocabularyFilter) ([]*model.Vocabulary, error)
+
+	// Ancestors returns parents of the vocabulary ordered from the root.
+	Ancestors(ctx context.Context, id uint64) ([]*model.Vocabulary, error)
+	// Descendants returns children of the vocabulary ordered by distance from it. Zero depth means maximum depth.
+	Descendants(ctx context.Context, id uint64, depth uint) ([]*model.Vocabulary, error)
+	// Path returns vocabulary with its ancestors ordered from the root, i.e. breadcrumbs.
+	Path(ctx context.Context, id uint64) ([]*model.Vocabulary, error)
 }